@@ -23,4 +23,7 @@ type AggregateAttestationOpts struct {
 	Slot phase0.Slot
 	// AttestationDataRoot is the root for which the data is obtained.
 	AttestationDataRoot phase0.Root
+	// CommitteeIndex is the committee index for which the data is obtained.
+	// This is required from Electra onwards, and ignored prior to Electra.
+	CommitteeIndex phase0.CommitteeIndex
 }
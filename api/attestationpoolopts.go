@@ -21,4 +21,7 @@ type AttestationPoolOpts struct {
 
 	// Slot is the slot for which the data is obtained.
 	Slot phase0.Slot
+	// CommitteeIndex is the committee index for which the data is obtained.
+	// If not present then data for all committees is obtained.
+	CommitteeIndex *phase0.CommitteeIndex
 }
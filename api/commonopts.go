@@ -20,4 +20,7 @@ type CommonOpts struct {
 	// Timeout is a specific timeout for this call.
 	// If 0 then the default timeout is used.
 	Timeout time.Duration
+	// DisableCoalescing stops this call from sharing a request to the server
+	// with concurrent identical calls.
+	DisableCoalescing bool
 }
@@ -28,4 +28,14 @@ type ProposalOpts struct {
 	// SkipRandaoVerification is true if we do not want the server to verify our RANDAO reveal.
 	// If this is set then the RANDAO reveal should be passed as the point at infinity (0xc0…00)
 	SkipRandaoVerification bool
+	// AllowBlinded is true if the caller can handle a blinded proposal.
+	// If this is not set then the beacon node is asked for a proposal with a local payload,
+	// and an error is returned if it supplies a blinded proposal regardless.
+	// If this is set then the caller must check the Blinded field of the returned proposal.
+	AllowBlinded bool
+	// BuilderBoostFactor is the percentage multiplier applied to the builder's payload value
+	// when choosing between a builder and a local payload.
+	// This is optional; if not supplied the beacon node will use its default of 100.
+	// A non-zero value requires AllowBlinded to be set.
+	BuilderBoostFactor *uint64
 }
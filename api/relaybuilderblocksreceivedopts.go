@@ -0,0 +1,34 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import "github.com/attestantio/go-eth2-client/spec/phase0"
+
+// RelayBuilderBlocksReceivedOpts are the options for obtaining blocks received by a relay from builders.
+// At least one of Slot, BlockHash, BlockNumber or BuilderPubkey must be supplied.
+type RelayBuilderBlocksReceivedOpts struct {
+	Common CommonOpts
+
+	// Slot restricts the results to the given slot.
+	Slot *phase0.Slot
+	// BlockHash restricts the results to the given execution block hash.
+	BlockHash *phase0.Hash32
+	// BlockNumber restricts the results to the given execution block number.
+	BlockNumber *uint64
+	// BuilderPubkey restricts the results to the given builder.
+	BuilderPubkey *phase0.BLSPubKey
+	// Limit is the maximum number of results to return.
+	// If this is 0 the relay's default is used.
+	Limit uint64
+}
@@ -0,0 +1,28 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import "github.com/attestantio/go-eth2-client/spec/phase0"
+
+// SyncCommitteeRewardsOpts are the options for obtaining sync committee rewards.
+type SyncCommitteeRewardsOpts struct {
+	Common CommonOpts
+
+	// Block is the ID of the block for which the data is obtained.
+	Block string
+	// Indices is a list of validator indices to restrict the returned values.  If no indices or public keys are supplied then no filter will be applied.
+	Indices []phase0.ValidatorIndex
+	// PubKeys is a list of validator public keys to restrict the returned values.  If no indices or public keys are supplied then no filter will be applied.
+	PubKeys []phase0.BLSPubKey
+}
@@ -0,0 +1,58 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bellatrix
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/goccy/go-yaml"
+	"github.com/pkg/errors"
+)
+
+// signedBuilderBidYAML is the spec representation of the struct.
+type signedBuilderBidYAML struct {
+	Message   *BuilderBid         `yaml:"message"`
+	Signature phase0.BLSSignature `yaml:"signature"`
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (s *SignedBuilderBid) MarshalYAML() ([]byte, error) {
+	yamlBytes, err := yaml.MarshalWithOptions(&signedBuilderBidYAML{
+		Message:   s.Message,
+		Signature: s.Signature,
+	}, yaml.Flow(true))
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.ReplaceAll(yamlBytes, []byte(`"`), []byte(`'`)), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (s *SignedBuilderBid) UnmarshalYAML(input []byte) error {
+	// We unmarshal to the JSON struct to save on duplicate code.
+	var data signedBuilderBidJSON
+	if err := yaml.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "failed to unmarshal YAML")
+	}
+
+	bytes, err := json.Marshal(data)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal JSON")
+	}
+
+	return s.UnmarshalJSON(bytes)
+}
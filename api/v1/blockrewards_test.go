@@ -0,0 +1,96 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestBlockRewardsJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "ProposerIndexMissing",
+			input: []byte(`{"total":"2000","attestations":"1000","sync_aggregate":"1000","proposer_slashings":"0","attester_slashings":"0"}`),
+			err:   "proposer index missing",
+		},
+		{
+			name:  "ProposerIndexInvalid",
+			input: []byte(`{"proposer_index":"-1","total":"2000","attestations":"1000","sync_aggregate":"1000","proposer_slashings":"0","attester_slashings":"0"}`),
+			err:   "invalid value for proposer index: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "TotalMissing",
+			input: []byte(`{"proposer_index":"123","attestations":"1000","sync_aggregate":"1000","proposer_slashings":"0","attester_slashings":"0"}`),
+			err:   "total missing",
+		},
+		{
+			name:  "TotalInvalid",
+			input: []byte(`{"proposer_index":"123","total":"-1","attestations":"1000","sync_aggregate":"1000","proposer_slashings":"0","attester_slashings":"0"}`),
+			err:   "invalid value for total: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "AttestationsMissing",
+			input: []byte(`{"proposer_index":"123","total":"2000","sync_aggregate":"1000","proposer_slashings":"0","attester_slashings":"0"}`),
+			err:   "attestations missing",
+		},
+		{
+			name:  "SyncAggregateMissing",
+			input: []byte(`{"proposer_index":"123","total":"2000","attestations":"1000","proposer_slashings":"0","attester_slashings":"0"}`),
+			err:   "sync aggregate missing",
+		},
+		{
+			name:  "ProposerSlashingsMissing",
+			input: []byte(`{"proposer_index":"123","total":"2000","attestations":"1000","sync_aggregate":"1000","attester_slashings":"0"}`),
+			err:   "proposer slashings missing",
+		},
+		{
+			name:  "AttesterSlashingsMissing",
+			input: []byte(`{"proposer_index":"123","total":"2000","attestations":"1000","sync_aggregate":"1000","proposer_slashings":"0"}`),
+			err:   "attester slashings missing",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"proposer_index":"123","total":"2000","attestations":"1000","sync_aggregate":"1000","proposer_slashings":"0","attester_slashings":"0"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.BlockRewards
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
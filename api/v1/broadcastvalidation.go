@@ -0,0 +1,55 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import "fmt"
+
+// BroadcastValidation defines the validation carried out by a beacon node
+// before it broadcasts a block.
+type BroadcastValidation int
+
+const (
+	// BroadcastValidationGossip means the block is validated against gossip rules only.
+	BroadcastValidationGossip BroadcastValidation = iota
+	// BroadcastValidationConsensus means the block is fully validated against consensus rules.
+	BroadcastValidationConsensus
+	// BroadcastValidationConsensusAndEquivocation means the block is fully validated against
+	// consensus rules, and checked for equivocation.
+	BroadcastValidationConsensusAndEquivocation
+)
+
+var broadcastValidationStrings = [...]string{
+	"gossip",
+	"consensus",
+	"consensus_and_equivocation",
+}
+
+// Validate returns an error if the broadcast validation is not a known value.
+func (b BroadcastValidation) Validate() error {
+	if b < 0 || int(b) >= len(broadcastValidationStrings) {
+		return fmt.Errorf("unknown broadcast validation %d", b)
+	}
+
+	return nil
+}
+
+// String returns the name of the broadcast validation, as used by the beacon API,
+// or "unknown" if the broadcast validation is not a known value.
+func (b BroadcastValidation) String() string {
+	if b.Validate() != nil {
+		return "unknown"
+	}
+
+	return broadcastValidationStrings[b]
+}
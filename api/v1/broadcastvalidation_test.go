@@ -0,0 +1,70 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"testing"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBroadcastValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		validation apiv1.BroadcastValidation
+		str        string
+		err        string
+	}{
+		{
+			name:       "Gossip",
+			validation: apiv1.BroadcastValidationGossip,
+			str:        "gossip",
+		},
+		{
+			name:       "Consensus",
+			validation: apiv1.BroadcastValidationConsensus,
+			str:        "consensus",
+		},
+		{
+			name:       "ConsensusAndEquivocation",
+			validation: apiv1.BroadcastValidationConsensusAndEquivocation,
+			str:        "consensus_and_equivocation",
+		},
+		{
+			name:       "Negative",
+			validation: apiv1.BroadcastValidation(-1),
+			str:        "unknown",
+			err:        "unknown broadcast validation -1",
+		},
+		{
+			name:       "OutOfRange",
+			validation: apiv1.BroadcastValidation(3),
+			str:        "unknown",
+			err:        "unknown broadcast validation 3",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.str, test.validation.String())
+			err := test.validation.Validate()
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capella
+
+import (
+	"encoding/json"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// signedBuilderBidJSON is the spec representation of the struct.
+type signedBuilderBidJSON struct {
+	Message   *BuilderBid         `json:"message"`
+	Signature phase0.BLSSignature `json:"signature"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s *SignedBuilderBid) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&signedBuilderBidJSON{
+		Message:   s.Message,
+		Signature: s.Signature,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *SignedBuilderBid) UnmarshalJSON(input []byte) error {
+	raw, err := codecs.RawJSON(&signedBuilderBidJSON{}, input)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(raw["message"], &s.Message); err != nil {
+		return errors.Wrap(err, "message")
+	}
+	if s.Message == nil {
+		return errors.New("message missing")
+	}
+
+	if err := json.Unmarshal(raw["signature"], &s.Signature); err != nil {
+		return errors.Wrap(err, "signature")
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// DepositSnapshot is an EIP-4881 snapshot of the finalized portion of the deposit tree.
+type DepositSnapshot struct {
+	Finalized            []phase0.Root `ssz-max:"32" ssz-size:"?,32"`
+	DepositRoot          phase0.Root   `ssz-size:"32"`
+	DepositCount         uint64
+	ExecutionBlockHash   phase0.Hash32 `ssz-size:"32"`
+	ExecutionBlockHeight uint64
+}
+
+// depositSnapshotJSON is the standard API representation of the struct.
+type depositSnapshotJSON struct {
+	Finalized            []string `json:"finalized"`
+	DepositRoot          string   `json:"deposit_root"`
+	DepositCount         string   `json:"deposit_count"`
+	ExecutionBlockHash   string   `json:"execution_block_hash"`
+	ExecutionBlockHeight string   `json:"execution_block_height"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d *DepositSnapshot) MarshalJSON() ([]byte, error) {
+	finalized := make([]string, len(d.Finalized))
+	for i := range d.Finalized {
+		finalized[i] = d.Finalized[i].String()
+	}
+
+	return json.Marshal(&depositSnapshotJSON{
+		Finalized:            finalized,
+		DepositRoot:          d.DepositRoot.String(),
+		DepositCount:         strconv.FormatUint(d.DepositCount, 10),
+		ExecutionBlockHash:   d.ExecutionBlockHash.String(),
+		ExecutionBlockHeight: strconv.FormatUint(d.ExecutionBlockHeight, 10),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *DepositSnapshot) UnmarshalJSON(input []byte) error {
+	var data depositSnapshotJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	return d.unpack(&data)
+}
+
+func (d *DepositSnapshot) unpack(data *depositSnapshotJSON) error {
+	var err error
+
+	if data.Finalized == nil {
+		return errors.New("finalized missing")
+	}
+	d.Finalized = make([]phase0.Root, len(data.Finalized))
+	for i := range data.Finalized {
+		if err := d.Finalized[i].UnmarshalJSON([]byte(fmt.Sprintf(`"%s"`, data.Finalized[i]))); err != nil {
+			return errors.Wrapf(err, "invalid value for finalized %d", i)
+		}
+	}
+	if data.DepositRoot == "" {
+		return errors.New("deposit root missing")
+	}
+	if err := d.DepositRoot.UnmarshalJSON([]byte(fmt.Sprintf(`"%s"`, data.DepositRoot))); err != nil {
+		return errors.Wrap(err, "invalid value for deposit root")
+	}
+	if data.DepositCount == "" {
+		return errors.New("deposit count missing")
+	}
+	if d.DepositCount, err = strconv.ParseUint(data.DepositCount, 10, 64); err != nil {
+		return errors.Wrap(err, "invalid value for deposit count")
+	}
+	if data.ExecutionBlockHash == "" {
+		return errors.New("execution block hash missing")
+	}
+	if err := d.ExecutionBlockHash.UnmarshalJSON([]byte(fmt.Sprintf(`"%s"`, data.ExecutionBlockHash))); err != nil {
+		return errors.Wrap(err, "invalid value for execution block hash")
+	}
+	if data.ExecutionBlockHeight == "" {
+		return errors.New("execution block height missing")
+	}
+	if d.ExecutionBlockHeight, err = strconv.ParseUint(data.ExecutionBlockHeight, 10, 64); err != nil {
+		return errors.Wrap(err, "invalid value for execution block height")
+	}
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (d *DepositSnapshot) String() string {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
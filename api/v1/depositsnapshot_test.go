@@ -0,0 +1,140 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestDepositSnapshotJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte(`[]`),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.depositSnapshotJSON",
+		},
+		{
+			name:  "FinalizedMissing",
+			input: []byte(`{"deposit_root":"0x0101010101010101010101010101010101010101010101010101010101010101","deposit_count":"12345","execution_block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","execution_block_height":"67890"}`),
+			err:   "finalized missing",
+		},
+		{
+			name:  "FinalizedInvalid",
+			input: []byte(`{"finalized":["true"],"deposit_root":"0x0101010101010101010101010101010101010101010101010101010101010101","deposit_count":"12345","execution_block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","execution_block_height":"67890"}`),
+			err:   "invalid value for finalized 0: invalid prefix",
+		},
+		{
+			name:  "FinalizedEmpty",
+			input: []byte(`{"finalized":[],"deposit_root":"0x0101010101010101010101010101010101010101010101010101010101010101","deposit_count":"12345","execution_block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","execution_block_height":"67890"}`),
+		},
+		{
+			name:  "DepositRootMissing",
+			input: []byte(`{"finalized":["0x0101010101010101010101010101010101010101010101010101010101010101","0x0202020202020202020202020202020202020202020202020202020202020202"],"deposit_count":"12345","execution_block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","execution_block_height":"67890"}`),
+			err:   "deposit root missing",
+		},
+		{
+			name:  "DepositRootInvalid",
+			input: []byte(`{"finalized":["0x0101010101010101010101010101010101010101010101010101010101010101","0x0202020202020202020202020202020202020202020202020202020202020202"],"deposit_root":"true","deposit_count":"12345","execution_block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","execution_block_height":"67890"}`),
+			err:   "invalid value for deposit root: invalid prefix",
+		},
+		{
+			name:  "DepositRootShort",
+			input: []byte(`{"finalized":["0x0101010101010101010101010101010101010101010101010101010101010101","0x0202020202020202020202020202020202020202020202020202020202020202"],"deposit_root":"0x01","deposit_count":"12345","execution_block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","execution_block_height":"67890"}`),
+			err:   "invalid value for deposit root: incorrect length",
+		},
+		{
+			name:  "DepositCountMissing",
+			input: []byte(`{"finalized":["0x0101010101010101010101010101010101010101010101010101010101010101","0x0202020202020202020202020202020202020202020202020202020202020202"],"deposit_root":"0x0101010101010101010101010101010101010101010101010101010101010101","execution_block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","execution_block_height":"67890"}`),
+			err:   "deposit count missing",
+		},
+		{
+			name:  "DepositCountInvalid",
+			input: []byte(`{"finalized":["0x0101010101010101010101010101010101010101010101010101010101010101","0x0202020202020202020202020202020202020202020202020202020202020202"],"deposit_root":"0x0101010101010101010101010101010101010101010101010101010101010101","deposit_count":"-1","execution_block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","execution_block_height":"67890"}`),
+			err:   "invalid value for deposit count: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "ExecutionBlockHashMissing",
+			input: []byte(`{"finalized":["0x0101010101010101010101010101010101010101010101010101010101010101","0x0202020202020202020202020202020202020202020202020202020202020202"],"deposit_root":"0x0101010101010101010101010101010101010101010101010101010101010101","deposit_count":"12345","execution_block_height":"67890"}`),
+			err:   "execution block hash missing",
+		},
+		{
+			name:  "ExecutionBlockHashInvalid",
+			input: []byte(`{"finalized":["0x0101010101010101010101010101010101010101010101010101010101010101","0x0202020202020202020202020202020202020202020202020202020202020202"],"deposit_root":"0x0101010101010101010101010101010101010101010101010101010101010101","deposit_count":"12345","execution_block_hash":"true","execution_block_height":"67890"}`),
+			err:   "invalid value for execution block hash: invalid prefix",
+		},
+		{
+			name:  "ExecutionBlockHashShort",
+			input: []byte(`{"finalized":["0x0101010101010101010101010101010101010101010101010101010101010101","0x0202020202020202020202020202020202020202020202020202020202020202"],"deposit_root":"0x0101010101010101010101010101010101010101010101010101010101010101","deposit_count":"12345","execution_block_hash":"0x01","execution_block_height":"67890"}`),
+			err:   "invalid value for execution block hash: incorrect length",
+		},
+		{
+			name:  "ExecutionBlockHeightMissing",
+			input: []byte(`{"finalized":["0x0101010101010101010101010101010101010101010101010101010101010101","0x0202020202020202020202020202020202020202020202020202020202020202"],"deposit_root":"0x0101010101010101010101010101010101010101010101010101010101010101","deposit_count":"12345","execution_block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202"}`),
+			err:   "execution block height missing",
+		},
+		{
+			name:  "ExecutionBlockHeightInvalid",
+			input: []byte(`{"finalized":["0x0101010101010101010101010101010101010101010101010101010101010101","0x0202020202020202020202020202020202020202020202020202020202020202"],"deposit_root":"0x0101010101010101010101010101010101010101010101010101010101010101","deposit_count":"12345","execution_block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","execution_block_height":"-1"}`),
+			err:   "invalid value for execution block height: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"finalized":["0x0101010101010101010101010101010101010101010101010101010101010101","0x0202020202020202020202020202020202020202020202020202020202020202"],"deposit_root":"0x0101010101010101010101010101010101010101010101010101010101010101","deposit_count":"12345","execution_block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","execution_block_height":"67890"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.DepositSnapshot
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
+
+func TestDepositSnapshotSSZ(t *testing.T) {
+	input := []byte(`{"finalized":["0x0101010101010101010101010101010101010101010101010101010101010101","0x0202020202020202020202020202020202020202020202020202020202020202"],"deposit_root":"0x0101010101010101010101010101010101010101010101010101010101010101","deposit_count":"12345","execution_block_hash":"0x0202020202020202020202020202020202020202020202020202020202020202","execution_block_height":"67890"}`)
+
+	var snapshot api.DepositSnapshot
+	require.NoError(t, json.Unmarshal(input, &snapshot))
+
+	data, err := snapshot.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, snapshot.SizeSSZ())
+
+	var res api.DepositSnapshot
+	require.NoError(t, res.UnmarshalSSZ(data))
+	require.Equal(t, snapshot, res)
+}
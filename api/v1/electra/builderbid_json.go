@@ -0,0 +1,83 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	"github.com/pkg/errors"
+)
+
+// builderBidJSON is the spec representation of the struct.
+type builderBidJSON struct {
+	Header             *electra.ExecutionPayloadHeader `json:"header"`
+	BlobKZGCommitments []deneb.KZGCommitment           `json:"blob_kzg_commitments"`
+	Value              string                          `json:"value"`
+	Pubkey             phase0.BLSPubKey                `json:"pubkey"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b *BuilderBid) MarshalJSON() ([]byte, error) {
+	value := ""
+	if b.Value != nil {
+		value = b.Value.Dec()
+	}
+
+	return json.Marshal(&builderBidJSON{
+		Header:             b.Header,
+		BlobKZGCommitments: b.BlobKZGCommitments,
+		Value:              value,
+		Pubkey:             b.Pubkey,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *BuilderBid) UnmarshalJSON(input []byte) error {
+	raw, err := codecs.RawJSON(&builderBidJSON{}, input)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(raw["header"], &b.Header); err != nil {
+		return errors.Wrap(err, "header")
+	}
+	if b.Header == nil {
+		return errors.New("header missing")
+	}
+
+	if err := json.Unmarshal(raw["blob_kzg_commitments"], &b.BlobKZGCommitments); err != nil {
+		return errors.Wrap(err, "blob_kzg_commitments")
+	}
+
+	value := strings.Trim(string(raw["value"]), `"`)
+	if value == "" {
+		return errors.New("value missing")
+	}
+	b.Value, err = uint256.FromDecimal(value)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for value")
+	}
+
+	if err := json.Unmarshal(raw["pubkey"], &b.Pubkey); err != nil {
+		return errors.Wrap(err, "pubkey")
+	}
+
+	return nil
+}
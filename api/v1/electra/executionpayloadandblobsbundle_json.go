@@ -0,0 +1,61 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra
+
+import (
+	"encoding/json"
+
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/pkg/errors"
+)
+
+// executionPayloadAndBlobsBundleJSON is the spec representation of the struct.
+type executionPayloadAndBlobsBundleJSON struct {
+	ExecutionPayload *electra.ExecutionPayload `json:"execution_payload"`
+	BlobsBundle      *apiv1deneb.BlobsBundle   `json:"blobs_bundle"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e *ExecutionPayloadAndBlobsBundle) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&executionPayloadAndBlobsBundleJSON{
+		ExecutionPayload: e.ExecutionPayload,
+		BlobsBundle:      e.BlobsBundle,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *ExecutionPayloadAndBlobsBundle) UnmarshalJSON(input []byte) error {
+	raw, err := codecs.RawJSON(&executionPayloadAndBlobsBundleJSON{}, input)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(raw["execution_payload"], &e.ExecutionPayload); err != nil {
+		return errors.Wrap(err, "execution_payload")
+	}
+	if e.ExecutionPayload == nil {
+		return errors.New("execution payload missing")
+	}
+
+	if err := json.Unmarshal(raw["blobs_bundle"], &e.BlobsBundle); err != nil {
+		return errors.Wrap(err, "blobs_bundle")
+	}
+	if e.BlobsBundle == nil {
+		return errors.New("blobs bundle missing")
+	}
+
+	return nil
+}
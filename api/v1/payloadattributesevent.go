@@ -315,7 +315,7 @@ func (e *PayloadAttributesEvent) MarshalJSON() ([]byte, error) {
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to marshal payload attributes v2")
 		}
-	case spec.DataVersionDeneb:
+	case spec.DataVersionDeneb, spec.DataVersionElectra:
 		if e.Data.V3 == nil {
 			return nil, errors.New("no payload attributes v3 data")
 		}
@@ -436,7 +436,7 @@ func (e *PayloadAttributesEvent) unpack(data *payloadAttributesEventJSON) error
 			return err
 		}
 		e.Data.V2 = &payloadAttributes
-	case spec.DataVersionDeneb:
+	case spec.DataVersionDeneb, spec.DataVersionElectra:
 		var payloadAttributes PayloadAttributesV3
 		err = json.Unmarshal(data.Data.PayloadAttributes, &payloadAttributes)
 		if err != nil {
@@ -0,0 +1,111 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/goccy/go-yaml"
+	"github.com/pkg/errors"
+)
+
+// ReceivedBidTrace is a relay's record of a bid received from a builder.
+type ReceivedBidTrace struct {
+	BidTrace
+	Timestamp            time.Time
+	OptimisticSubmission bool
+}
+
+// receivedBidTraceJSON is the spec representation of the struct.
+type receivedBidTraceJSON struct {
+	bidTraceJSON
+	Timestamp            string `json:"timestamp"`
+	TimestampMs          string `json:"timestamp_ms"`
+	OptimisticSubmission bool   `json:"optimistic_submission"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (r *ReceivedBidTrace) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.pack())
+}
+
+func (r *ReceivedBidTrace) pack() *receivedBidTraceJSON {
+	return &receivedBidTraceJSON{
+		bidTraceJSON:         *r.BidTrace.pack(),
+		Timestamp:            strconv.FormatInt(r.Timestamp.Unix(), 10),
+		TimestampMs:          strconv.FormatInt(r.Timestamp.UnixMilli(), 10),
+		OptimisticSubmission: r.OptimisticSubmission,
+	}
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (r *ReceivedBidTrace) UnmarshalJSON(input []byte) error {
+	var data receivedBidTraceJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	return r.unpack(&data)
+}
+
+func (r *ReceivedBidTrace) unpack(data *receivedBidTraceJSON) error {
+	if err := r.BidTrace.unpack(&data.bidTraceJSON); err != nil {
+		return err
+	}
+
+	switch {
+	case data.TimestampMs != "":
+		timestampMs, err := strconv.ParseInt(data.TimestampMs, 10, 64)
+		if err != nil {
+			return errors.Wrap(err, "invalid value for timestamp ms")
+		}
+		r.Timestamp = time.UnixMilli(timestampMs)
+	case data.Timestamp != "":
+		timestamp, err := strconv.ParseInt(data.Timestamp, 10, 64)
+		if err != nil {
+			return errors.Wrap(err, "invalid value for timestamp")
+		}
+		r.Timestamp = time.Unix(timestamp, 0)
+	default:
+		return errors.New("timestamp missing")
+	}
+
+	r.OptimisticSubmission = data.OptimisticSubmission
+
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (r *ReceivedBidTrace) MarshalYAML() ([]byte, error) {
+	yamlBytes, err := yaml.MarshalWithOptions(r.pack(), yaml.Flow(true))
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.ReplaceAll(yamlBytes, []byte(`"`), []byte(`'`)), nil
+}
+
+// String returns a string version of the structure.
+func (r *ReceivedBidTrace) String() string {
+	data, err := yaml.Marshal(r)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
@@ -0,0 +1,74 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/goccy/go-yaml"
+	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestReceivedBidTraceJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "Empty",
+			err:   "unexpected end of JSON input",
+		},
+		{
+			name:  "BidTraceInvalid",
+			input: []byte(`{"parent_hash":"0x17f4eeae822cc81533016678413443b95e34517e67f12b4a3a92ff6b66f972ef","block_hash":"0x42c294e902bfc9884c1ce5fef156d4661bb8f0ff488bface37f18c3e7be64b0f","builder_pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","proposer_pubkey":"0x8b1e9a4a8fcd4c4b4a4a39f7b25b18b50e4ea9e4c1b3f5b8c8f2a8f4b0a6d3d7b9a3f7e6b6e2c8d1a2b4c5d6e7f8091a","proposer_fee_recipient":"0x58E809C71e4885cB7B3f1D5c793AB04eD239d779","gas_limit":"30000000","gas_used":"12345678","value":"41234567890123456","block_number":"18000000","num_tx":"123","timestamp":"1700000000","timestamp_ms":"1700000000123","optimistic_submission":true}`),
+			err:   "slot missing",
+		},
+		{
+			name:  "TimestampMissing",
+			input: []byte(`{"slot":"1000","parent_hash":"0x17f4eeae822cc81533016678413443b95e34517e67f12b4a3a92ff6b66f972ef","block_hash":"0x42c294e902bfc9884c1ce5fef156d4661bb8f0ff488bface37f18c3e7be64b0f","builder_pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","proposer_pubkey":"0x8b1e9a4a8fcd4c4b4a4a39f7b25b18b50e4ea9e4c1b3f5b8c8f2a8f4b0a6d3d7b9a3f7e6b6e2c8d1a2b4c5d6e7f8091a","proposer_fee_recipient":"0x58E809C71e4885cB7B3f1D5c793AB04eD239d779","gas_limit":"30000000","gas_used":"12345678","value":"41234567890123456","block_number":"18000000","num_tx":"123","optimistic_submission":true}`),
+			err:   "timestamp missing",
+		},
+		{
+			name:  "TimestampMsInvalid",
+			input: []byte(`{"slot":"1000","parent_hash":"0x17f4eeae822cc81533016678413443b95e34517e67f12b4a3a92ff6b66f972ef","block_hash":"0x42c294e902bfc9884c1ce5fef156d4661bb8f0ff488bface37f18c3e7be64b0f","builder_pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","proposer_pubkey":"0x8b1e9a4a8fcd4c4b4a4a39f7b25b18b50e4ea9e4c1b3f5b8c8f2a8f4b0a6d3d7b9a3f7e6b6e2c8d1a2b4c5d6e7f8091a","proposer_fee_recipient":"0x58E809C71e4885cB7B3f1D5c793AB04eD239d779","gas_limit":"30000000","gas_used":"12345678","value":"41234567890123456","block_number":"18000000","num_tx":"123","timestamp":"1700000000","timestamp_ms":"-","optimistic_submission":true}`),
+			err:   "invalid value for timestamp ms: strconv.ParseInt: parsing \"-\": invalid syntax",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"slot":"1000","parent_hash":"0x17f4eeae822cc81533016678413443b95e34517e67f12b4a3a92ff6b66f972ef","block_hash":"0x42c294e902bfc9884c1ce5fef156d4661bb8f0ff488bface37f18c3e7be64b0f","builder_pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","proposer_pubkey":"0x8b1e9a4a8fcd4c4b4a4a39f7b25b18b50e4ea9e4c1b3f5b8c8f2a8f4b0a6d3d7b9a3f7e6b6e2c8d1a2b4c5d6e7f8091a","proposer_fee_recipient":"0x58E809C71e4885cB7B3f1D5c793AB04eD239d779","gas_limit":"30000000","gas_used":"12345678","value":"41234567890123456","block_number":"18000000","num_tx":"123","timestamp":"1700000000","timestamp_ms":"1700000000123","optimistic_submission":true}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.ReceivedBidTrace
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				_, err = yaml.Marshal(&res)
+				require.NoError(t, err)
+				assert.NotEmpty(t, res.String())
+			}
+		})
+	}
+}
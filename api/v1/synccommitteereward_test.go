@@ -0,0 +1,80 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestSyncCommitteeRewardJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "ValidatorIndexMissing",
+			input: []byte(`{"reward":"2000"}`),
+			err:   "validator index missing",
+		},
+		{
+			name:  "ValidatorIndexInvalid",
+			input: []byte(`{"validator_index":"-1","reward":"2000"}`),
+			err:   "invalid value for validator index: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "RewardMissing",
+			input: []byte(`{"validator_index":"1"}`),
+			err:   "reward missing",
+		},
+		{
+			name:  "RewardInvalid",
+			input: []byte(`{"validator_index":"1","reward":"abc"}`),
+			err:   "invalid value for reward: strconv.ParseInt: parsing \"abc\": invalid syntax",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"validator_index":"1","reward":"2000"}`),
+		},
+		{
+			name:  "GoodNegative",
+			input: []byte(`{"validator_index":"1","reward":"-2000"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.SyncCommitteeReward
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
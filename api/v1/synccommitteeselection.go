@@ -0,0 +1,109 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// SyncCommitteeSelection is the data required for a sync committee selection.
+type SyncCommitteeSelection struct {
+	// ValidatorIdex is the index of the validator making the selection request.
+	ValidatorIndex phase0.ValidatorIndex
+	// Slot is the slot for which the validator is a member of the sync committee.
+	Slot phase0.Slot
+	// SubcommitteeIndex is the index of the sync subcommittee.
+	SubcommitteeIndex uint64
+	// SelectionProof is the signature required to calculate whether the validator is an aggregator.
+	SelectionProof phase0.BLSSignature
+}
+
+// syncCommitteeSelectionJSON is the spec representation of the struct.
+type syncCommitteeSelectionJSON struct {
+	ValidatorIndex    string `json:"validator_index"`
+	Slot              string `json:"slot"`
+	SubcommitteeIndex string `json:"subcommittee_index"`
+	SelectionProof    string `json:"selection_proof"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s *SyncCommitteeSelection) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&syncCommitteeSelectionJSON{
+		ValidatorIndex:    fmt.Sprintf("%d", s.ValidatorIndex),
+		Slot:              fmt.Sprintf("%d", s.Slot),
+		SubcommitteeIndex: strconv.FormatUint(s.SubcommitteeIndex, 10),
+		SelectionProof:    fmt.Sprintf("%#x", s.SelectionProof),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *SyncCommitteeSelection) UnmarshalJSON(input []byte) error {
+	var data syncCommitteeSelectionJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.ValidatorIndex == "" {
+		return errors.New("validator index missing")
+	}
+	validatorIndex, err := strconv.ParseUint(data.ValidatorIndex, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for validator index")
+	}
+	s.ValidatorIndex = phase0.ValidatorIndex(validatorIndex)
+	if data.Slot == "" {
+		return errors.New("slot missing")
+	}
+	slot, err := strconv.ParseUint(data.Slot, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for slot")
+	}
+	s.Slot = phase0.Slot(slot)
+	if data.SubcommitteeIndex == "" {
+		return errors.New("subcommittee index missing")
+	}
+	if s.SubcommitteeIndex, err = strconv.ParseUint(data.SubcommitteeIndex, 10, 64); err != nil {
+		return errors.Wrap(err, "invalid value for subcommittee index")
+	}
+	if data.SelectionProof == "" {
+		return errors.New("selection proof missing")
+	}
+	selectionProof, err := hex.DecodeString(strings.TrimPrefix(data.SelectionProof, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for selection proof")
+	}
+	if len(selectionProof) != phase0.SignatureLength {
+		return fmt.Errorf("incorrect length %d for selection proof", len(selectionProof))
+	}
+	copy(s.SelectionProof[:], selectionProof)
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (s *SyncCommitteeSelection) String() string {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
@@ -24,4 +24,8 @@ type ValidatorBalancesOpts struct {
 	State string
 	// Indices is a list of validator indices to restrict the returned values.  If no indices are supplied then no filter will be applied.
 	Indices []phase0.ValidatorIndex
+	// BalanceFunc, if supplied, is called for each balance as it is decoded rather than the
+	// balance being returned in the response data.  This avoids holding all balances in memory
+	// when they can be processed one at a time.
+	BalanceFunc func(index phase0.ValidatorIndex, balance phase0.Gwei) error
 }
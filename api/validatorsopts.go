@@ -13,7 +13,10 @@
 
 package api
 
-import "github.com/attestantio/go-eth2-client/spec/phase0"
+import (
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
 
 // ValidatorsOpts are the options for obtaining validators.
 type ValidatorsOpts struct {
@@ -26,4 +29,10 @@ type ValidatorsOpts struct {
 	Indices []phase0.ValidatorIndex
 	// PubKeys is a list of validator public keys to restrict the returned values.  If no public keys are supplied then no filter will be applied.
 	PubKeys []phase0.BLSPubKey
+	// Statuses is a list of validator states to restrict the returned values.  If no states are supplied then no filter will be applied.
+	Statuses []apiv1.ValidatorState
+	// ValidatorFunc, if supplied, is called for each validator as it is decoded rather than the
+	// validator being returned in the response data.  This avoids holding all validators in memory
+	// when they can be processed one at a time.
+	ValidatorFunc func(validator *apiv1.Validator) error
 }
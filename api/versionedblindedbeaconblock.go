@@ -17,6 +17,7 @@ import (
 	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
 	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
 	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
@@ -28,11 +29,12 @@ type VersionedBlindedBeaconBlock struct {
 	Bellatrix *apiv1bellatrix.BlindedBeaconBlock
 	Capella   *apiv1capella.BlindedBeaconBlock
 	Deneb     *apiv1deneb.BlindedBeaconBlock
+	Electra   *apiv1electra.BlindedBeaconBlock
 }
 
 // IsEmpty returns true if there is no block.
 func (v *VersionedBlindedBeaconBlock) IsEmpty() bool {
-	return v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil
+	return v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil && v.Electra == nil
 }
 
 // Slot returns the slot of the blinded beacon block.
@@ -56,6 +58,12 @@ func (v *VersionedBlindedBeaconBlock) Slot() (phase0.Slot, error) {
 		}
 
 		return v.Deneb.Slot, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return 0, ErrDataMissing
+		}
+
+		return v.Electra.Slot, nil
 	default:
 		return 0, ErrUnsupportedVersion
 	}
@@ -82,6 +90,12 @@ func (v *VersionedBlindedBeaconBlock) ProposerIndex() (phase0.ValidatorIndex, er
 		}
 
 		return v.Deneb.ProposerIndex, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return 0, ErrDataMissing
+		}
+
+		return v.Electra.ProposerIndex, nil
 	default:
 		return 0, ErrUnsupportedVersion
 	}
@@ -111,6 +125,13 @@ func (v *VersionedBlindedBeaconBlock) RandaoReveal() (phase0.BLSSignature, error
 		}
 
 		return v.Deneb.Body.RANDAOReveal, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Body == nil {
+			return phase0.BLSSignature{}, ErrDataMissing
+		}
+
+		return v.Electra.Body.RANDAOReveal, nil
 	default:
 		return phase0.BLSSignature{}, ErrUnsupportedVersion
 	}
@@ -140,12 +161,20 @@ func (v *VersionedBlindedBeaconBlock) Graffiti() ([32]byte, error) {
 		}
 
 		return v.Deneb.Body.Graffiti, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Body == nil {
+			return [32]byte{}, ErrDataMissing
+		}
+
+		return v.Electra.Body.Graffiti, nil
 	default:
 		return [32]byte{}, ErrUnsupportedVersion
 	}
 }
 
 // Attestations returns the attestations of the blinded beacon block.
+// Electra attestations are not available through this function; use VersionedAttestations() instead.
 func (v *VersionedBlindedBeaconBlock) Attestations() ([]*phase0.Attestation, error) {
 	switch v.Version {
 	case spec.DataVersionBellatrix:
@@ -174,6 +203,42 @@ func (v *VersionedBlindedBeaconBlock) Attestations() ([]*phase0.Attestation, err
 	}
 }
 
+// VersionedAttestations returns the versioned attestations of the blinded beacon block.
+func (v *VersionedBlindedBeaconBlock) VersionedAttestations() ([]*spec.VersionedAttestation, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil ||
+			v.Bellatrix.Body == nil {
+			return nil, ErrDataMissing
+		}
+
+		return spec.NewVersionedAttestations(spec.DataVersionBellatrix, v.Bellatrix.Body.Attestations), nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil ||
+			v.Capella.Body == nil {
+			return nil, ErrDataMissing
+		}
+
+		return spec.NewVersionedAttestations(spec.DataVersionCapella, v.Capella.Body.Attestations), nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil ||
+			v.Deneb.Body == nil {
+			return nil, ErrDataMissing
+		}
+
+		return spec.NewVersionedAttestations(spec.DataVersionDeneb, v.Deneb.Body.Attestations), nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Body == nil {
+			return nil, ErrDataMissing
+		}
+
+		return spec.NewVersionedElectraAttestations(v.Electra.Body.Attestations), nil
+	default:
+		return nil, ErrUnsupportedVersion
+	}
+}
+
 // Root returns the root of the blinded beacon block.
 func (v *VersionedBlindedBeaconBlock) Root() (phase0.Root, error) {
 	switch v.Version {
@@ -195,6 +260,12 @@ func (v *VersionedBlindedBeaconBlock) Root() (phase0.Root, error) {
 		}
 
 		return v.Deneb.HashTreeRoot()
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Electra.HashTreeRoot()
 	default:
 		return phase0.Root{}, ErrUnsupportedVersion
 	}
@@ -221,6 +292,12 @@ func (v *VersionedBlindedBeaconBlock) BodyRoot() (phase0.Root, error) {
 		}
 
 		return v.Deneb.Body.HashTreeRoot()
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Electra.Body.HashTreeRoot()
 	default:
 		return phase0.Root{}, ErrUnsupportedVersion
 	}
@@ -247,6 +324,12 @@ func (v *VersionedBlindedBeaconBlock) ParentRoot() (phase0.Root, error) {
 		}
 
 		return v.Deneb.ParentRoot, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Electra.ParentRoot, nil
 	default:
 		return phase0.Root{}, ErrUnsupportedVersion
 	}
@@ -273,6 +356,12 @@ func (v *VersionedBlindedBeaconBlock) StateRoot() (phase0.Root, error) {
 		}
 
 		return v.Deneb.StateRoot, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Electra.StateRoot, nil
 	default:
 		return phase0.Root{}, ErrUnsupportedVersion
 	}
@@ -305,6 +394,14 @@ func (v *VersionedBlindedBeaconBlock) TransactionsRoot() (phase0.Root, error) {
 		}
 
 		return v.Deneb.Body.ExecutionPayloadHeader.TransactionsRoot, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Body == nil ||
+			v.Electra.Body.ExecutionPayloadHeader == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Electra.Body.ExecutionPayloadHeader.TransactionsRoot, nil
 	default:
 		return phase0.Root{}, ErrUnsupportedVersion
 	}
@@ -337,6 +434,14 @@ func (v *VersionedBlindedBeaconBlock) FeeRecipient() (bellatrix.ExecutionAddress
 		}
 
 		return v.Deneb.Body.ExecutionPayloadHeader.FeeRecipient, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Body == nil ||
+			v.Electra.Body.ExecutionPayloadHeader == nil {
+			return bellatrix.ExecutionAddress{}, ErrDataMissing
+		}
+
+		return v.Electra.Body.ExecutionPayloadHeader.FeeRecipient, nil
 	default:
 		return bellatrix.ExecutionAddress{}, ErrUnsupportedVersion
 	}
@@ -369,6 +474,14 @@ func (v *VersionedBlindedBeaconBlock) Timestamp() (uint64, error) {
 		}
 
 		return v.Deneb.Body.ExecutionPayloadHeader.Timestamp, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Body == nil ||
+			v.Electra.Body.ExecutionPayloadHeader == nil {
+			return 0, ErrDataMissing
+		}
+
+		return v.Electra.Body.ExecutionPayloadHeader.Timestamp, nil
 	default:
 		return 0, ErrUnsupportedVersion
 	}
@@ -395,6 +508,12 @@ func (v *VersionedBlindedBeaconBlock) String() string {
 		}
 
 		return v.Deneb.String()
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return ""
+		}
+
+		return v.Electra.String()
 	default:
 		return "unknown version"
 	}
@@ -7,6 +7,7 @@ import (
 	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
 	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
 	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
 	"github.com/attestantio/go-eth2-client/spec"
 	ssz "github.com/ferranbt/fastssz"
 )
@@ -19,7 +20,7 @@ func (v *VersionedBlindedBeaconBlock) MarshalSSZ() ([]byte, error) {
 // MarshalSSZTo ssz marshals the VersionedBlindedBeaconBlock object to a target array
 func (v *VersionedBlindedBeaconBlock) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(20)
+	offset := int(24)
 
 	// Field (0) 'Version'
 	dst = ssz.MarshalUint64(dst, uint64(v.Version))
@@ -45,6 +46,13 @@ func (v *VersionedBlindedBeaconBlock) MarshalSSZTo(buf []byte) (dst []byte, err
 	}
 	offset += v.Deneb.SizeSSZ()
 
+	// Offset (4) 'Electra'
+	dst = ssz.WriteOffset(dst, offset)
+	if v.Electra == nil {
+		v.Electra = new(apiv1electra.BlindedBeaconBlock)
+	}
+	offset += v.Electra.SizeSSZ()
+
 	// Field (1) 'Bellatrix'
 	if dst, err = v.Bellatrix.MarshalSSZTo(dst); err != nil {
 		return
@@ -60,6 +68,11 @@ func (v *VersionedBlindedBeaconBlock) MarshalSSZTo(buf []byte) (dst []byte, err
 		return
 	}
 
+	// Field (4) 'Electra'
+	if dst, err = v.Electra.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
 	return
 }
 
@@ -67,12 +80,12 @@ func (v *VersionedBlindedBeaconBlock) MarshalSSZTo(buf []byte) (dst []byte, err
 func (v *VersionedBlindedBeaconBlock) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 20 {
+	if size < 24 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
-	var o1, o2, o3 uint64
+	var o1, o2, o3, o4 uint64
 
 	// Field (0) 'Version'
 	v.Version = spec.DataVersion(ssz.UnmarshallUint64(buf[0:8]))
@@ -82,7 +95,7 @@ func (v *VersionedBlindedBeaconBlock) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o1 < 20 {
+	if o1 < 24 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -96,6 +109,11 @@ func (v *VersionedBlindedBeaconBlock) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
+	// Offset (4) 'Electra'
+	if o4 = ssz.ReadOffset(buf[20:24]); o4 > size || o3 > o4 {
+		return ssz.ErrOffset
+	}
+
 	// Field (1) 'Bellatrix'
 	{
 		buf = tail[o1:o2]
@@ -120,7 +138,7 @@ func (v *VersionedBlindedBeaconBlock) UnmarshalSSZ(buf []byte) error {
 
 	// Field (3) 'Deneb'
 	{
-		buf = tail[o3:]
+		buf = tail[o3:o4]
 		if v.Deneb == nil {
 			v.Deneb = new(apiv1deneb.BlindedBeaconBlock)
 		}
@@ -128,12 +146,23 @@ func (v *VersionedBlindedBeaconBlock) UnmarshalSSZ(buf []byte) error {
 			return err
 		}
 	}
+
+	// Field (4) 'Electra'
+	{
+		buf = tail[o4:]
+		if v.Electra == nil {
+			v.Electra = new(apiv1electra.BlindedBeaconBlock)
+		}
+		if err = v.Electra.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
 	return err
 }
 
 // SizeSSZ returns the ssz encoded size in bytes for the VersionedBlindedBeaconBlock object
 func (v *VersionedBlindedBeaconBlock) SizeSSZ() (size int) {
-	size = 20
+	size = 24
 
 	// Field (1) 'Bellatrix'
 	if v.Bellatrix == nil {
@@ -153,6 +182,12 @@ func (v *VersionedBlindedBeaconBlock) SizeSSZ() (size int) {
 	}
 	size += v.Deneb.SizeSSZ()
 
+	// Field (4) 'Electra'
+	if v.Electra == nil {
+		v.Electra = new(apiv1electra.BlindedBeaconBlock)
+	}
+	size += v.Electra.SizeSSZ()
+
 	return
 }
 
@@ -183,6 +218,11 @@ func (v *VersionedBlindedBeaconBlock) HashTreeRootWith(hh ssz.HashWalker) (err e
 		return
 	}
 
+	// Field (4) 'Electra'
+	if err = v.Electra.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
 	hh.Merkleize(indx)
 	return
 }
@@ -19,6 +19,7 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
@@ -28,6 +29,7 @@ type VersionedBlockRequest struct {
 	Bellatrix *bellatrix.SignedBeaconBlock
 	Capella   *capella.SignedBeaconBlock
 	Deneb     *deneb.SignedBeaconBlock
+	Electra   *electra.SignedBeaconBlock
 }
 
 // Slot returns the slot of the signed beacon block.
@@ -54,6 +56,13 @@ func (v *VersionedBlockRequest) Slot() (phase0.Slot, error) {
 		}
 
 		return v.Deneb.Message.Slot, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Message == nil {
+			return 0, ErrDataMissing
+		}
+
+		return v.Electra.Message.Slot, nil
 	default:
 		return 0, ErrUnsupportedVersion
 	}
@@ -89,12 +98,22 @@ func (v *VersionedBlockRequest) ExecutionBlockHash() (phase0.Hash32, error) {
 		}
 
 		return v.Deneb.Message.Body.ExecutionPayload.BlockHash, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Message == nil ||
+			v.Electra.Message.Body == nil ||
+			v.Electra.Message.Body.ExecutionPayload == nil {
+			return phase0.Hash32{}, ErrDataMissing
+		}
+
+		return v.Electra.Message.Body.ExecutionPayload.BlockHash, nil
 	default:
 		return phase0.Hash32{}, ErrUnsupportedVersion
 	}
 }
 
 // Attestations returns the attestations of the beacon block.
+// Electra attestations are not available through this function; use VersionedAttestations() instead.
 func (v *VersionedBlockRequest) Attestations() ([]*phase0.Attestation, error) {
 	switch v.Version {
 	case spec.DataVersionBellatrix:
@@ -126,6 +145,46 @@ func (v *VersionedBlockRequest) Attestations() ([]*phase0.Attestation, error) {
 	}
 }
 
+// VersionedAttestations returns the versioned attestations of the beacon block.
+func (v *VersionedBlockRequest) VersionedAttestations() ([]*spec.VersionedAttestation, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil ||
+			v.Bellatrix.Message == nil ||
+			v.Bellatrix.Message.Body == nil {
+			return nil, ErrDataMissing
+		}
+
+		return spec.NewVersionedAttestations(spec.DataVersionBellatrix, v.Bellatrix.Message.Body.Attestations), nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil ||
+			v.Capella.Message == nil ||
+			v.Capella.Message.Body == nil {
+			return nil, ErrDataMissing
+		}
+
+		return spec.NewVersionedAttestations(spec.DataVersionCapella, v.Capella.Message.Body.Attestations), nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil ||
+			v.Deneb.Message == nil ||
+			v.Deneb.Message.Body == nil {
+			return nil, ErrDataMissing
+		}
+
+		return spec.NewVersionedAttestations(spec.DataVersionDeneb, v.Deneb.Message.Body.Attestations), nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Message == nil ||
+			v.Electra.Message.Body == nil {
+			return nil, ErrDataMissing
+		}
+
+		return spec.NewVersionedElectraAttestations(v.Electra.Message.Body.Attestations), nil
+	default:
+		return nil, ErrUnsupportedVersion
+	}
+}
+
 // Root returns the root of the beacon block.
 func (v *VersionedBlockRequest) Root() (phase0.Root, error) {
 	switch v.Version {
@@ -150,6 +209,13 @@ func (v *VersionedBlockRequest) Root() (phase0.Root, error) {
 		}
 
 		return v.Deneb.Message.HashTreeRoot()
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Message == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Electra.Message.HashTreeRoot()
 	default:
 		return phase0.Root{}, ErrUnsupportedVersion
 	}
@@ -182,6 +248,14 @@ func (v *VersionedBlockRequest) BodyRoot() (phase0.Root, error) {
 		}
 
 		return v.Deneb.Message.Body.HashTreeRoot()
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Message == nil ||
+			v.Electra.Message.Body == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Electra.Message.Body.HashTreeRoot()
 	default:
 		return phase0.Root{}, ErrUnsupportedVersion
 	}
@@ -211,6 +285,13 @@ func (v *VersionedBlockRequest) ParentRoot() (phase0.Root, error) {
 		}
 
 		return v.Deneb.Message.ParentRoot, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Message == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Electra.Message.ParentRoot, nil
 	default:
 		return phase0.Root{}, ErrUnsupportedVersion
 	}
@@ -240,12 +321,20 @@ func (v *VersionedBlockRequest) StateRoot() (phase0.Root, error) {
 		}
 
 		return v.Deneb.Message.StateRoot, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Message == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Electra.Message.StateRoot, nil
 	default:
 		return phase0.Root{}, ErrUnsupportedVersion
 	}
 }
 
 // AttesterSlashings returns the attester slashings of the beacon block.
+// Electra attester slashings are not available through this function; use VersionedAttesterSlashings() instead.
 func (v *VersionedBlockRequest) AttesterSlashings() ([]*phase0.AttesterSlashing, error) {
 	switch v.Version {
 	case spec.DataVersionBellatrix:
@@ -277,6 +366,46 @@ func (v *VersionedBlockRequest) AttesterSlashings() ([]*phase0.AttesterSlashing,
 	}
 }
 
+// VersionedAttesterSlashings returns the versioned attester slashings of the beacon block.
+func (v *VersionedBlockRequest) VersionedAttesterSlashings() ([]*spec.VersionedAttesterSlashing, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil ||
+			v.Bellatrix.Message == nil ||
+			v.Bellatrix.Message.Body == nil {
+			return nil, ErrDataMissing
+		}
+
+		return spec.NewVersionedAttesterSlashings(spec.DataVersionBellatrix, v.Bellatrix.Message.Body.AttesterSlashings), nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil ||
+			v.Capella.Message == nil ||
+			v.Capella.Message.Body == nil {
+			return nil, ErrDataMissing
+		}
+
+		return spec.NewVersionedAttesterSlashings(spec.DataVersionCapella, v.Capella.Message.Body.AttesterSlashings), nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil ||
+			v.Deneb.Message == nil ||
+			v.Deneb.Message.Body == nil {
+			return nil, ErrDataMissing
+		}
+
+		return spec.NewVersionedAttesterSlashings(spec.DataVersionDeneb, v.Deneb.Message.Body.AttesterSlashings), nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Message == nil ||
+			v.Electra.Message.Body == nil {
+			return nil, ErrDataMissing
+		}
+
+		return spec.NewVersionedElectraAttesterSlashings(v.Electra.Message.Body.AttesterSlashings), nil
+	default:
+		return nil, ErrUnsupportedVersion
+	}
+}
+
 // ProposerSlashings returns the proposer slashings of the beacon block.
 func (v *VersionedBlockRequest) ProposerSlashings() ([]*phase0.ProposerSlashing, error) {
 	switch v.Version {
@@ -304,6 +433,14 @@ func (v *VersionedBlockRequest) ProposerSlashings() ([]*phase0.ProposerSlashing,
 		}
 
 		return v.Deneb.Message.Body.ProposerSlashings, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Message == nil ||
+			v.Electra.Message.Body == nil {
+			return nil, ErrDataMissing
+		}
+
+		return v.Electra.Message.Body.ProposerSlashings, nil
 	default:
 		return nil, ErrUnsupportedVersion
 	}
@@ -336,6 +473,14 @@ func (v *VersionedBlockRequest) SyncAggregate() (*altair.SyncAggregate, error) {
 		}
 
 		return v.Deneb.Message.Body.SyncAggregate, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Message == nil ||
+			v.Electra.Message.Body == nil {
+			return nil, ErrDataMissing
+		}
+
+		return v.Electra.Message.Body.SyncAggregate, nil
 	default:
 		return nil, ErrUnsupportedVersion
 	}
@@ -362,6 +507,12 @@ func (v *VersionedBlockRequest) String() string {
 		}
 
 		return v.Deneb.String()
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return ""
+		}
+
+		return v.Electra.String()
 	default:
 		return "unsupported version"
 	}
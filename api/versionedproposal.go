@@ -14,7 +14,12 @@
 package api
 
 import (
+	"math/big"
+
+	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
 	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/altair"
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
@@ -25,25 +30,58 @@ import (
 
 // VersionedProposal contains a versioned proposal.
 type VersionedProposal struct {
-	Version   spec.DataVersion
-	Phase0    *phase0.BeaconBlock
-	Altair    *altair.BeaconBlock
-	Bellatrix *bellatrix.BeaconBlock
-	Capella   *capella.BeaconBlock
-	Deneb     *apiv1deneb.BlockContents
+	Version spec.DataVersion
+	// Blinded is true if the proposal is held in the relevant blinded field.
+	Blinded bool
+	// ExecutionValue is the value of the execution payload to the proposer, in Wei.
+	// This is only available if provided by the beacon node.
+	ExecutionValue *big.Int
+	// ConsensusValue is the value of the consensus rewards to the proposer, in Wei.
+	// This is only available if provided by the beacon node.
+	ConsensusValue   *big.Int
+	Phase0           *phase0.BeaconBlock
+	Altair           *altair.BeaconBlock
+	Bellatrix        *bellatrix.BeaconBlock
+	BellatrixBlinded *apiv1bellatrix.BlindedBeaconBlock
+	Capella          *capella.BeaconBlock
+	CapellaBlinded   *apiv1capella.BlindedBeaconBlock
+	Deneb            *apiv1deneb.BlockContents
+	DenebBlinded     *apiv1deneb.BlindedBeaconBlock
+	Electra          *apiv1electra.BlockContents
+	ElectraBlinded   *apiv1electra.BlindedBeaconBlock
+}
+
+// blinded returns the blinded proposal.
+func (v *VersionedProposal) blinded() *VersionedBlindedProposal {
+	return &VersionedBlindedProposal{
+		Version:   v.Version,
+		Bellatrix: v.BellatrixBlinded,
+		Capella:   v.CapellaBlinded,
+		Deneb:     v.DenebBlinded,
+		Electra:   v.ElectraBlinded,
+	}
 }
 
 // IsEmpty returns true if there is no proposal.
 func (v *VersionedProposal) IsEmpty() bool {
+	if v.Blinded {
+		return v.blinded().IsEmpty()
+	}
+
 	return v.Phase0 == nil &&
 		v.Altair == nil &&
 		v.Bellatrix == nil &&
 		v.Capella == nil &&
-		v.Deneb == nil
+		v.Deneb == nil &&
+		v.Electra == nil
 }
 
 // Slot returns the slot of the proposal.
 func (v *VersionedProposal) Slot() (phase0.Slot, error) {
+	if v.Blinded {
+		return v.blinded().Slot()
+	}
+
 	switch v.Version {
 	case spec.DataVersionPhase0:
 		if v.Phase0 == nil {
@@ -76,6 +114,13 @@ func (v *VersionedProposal) Slot() (phase0.Slot, error) {
 		}
 
 		return v.Deneb.Block.Slot, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Block == nil {
+			return 0, ErrDataMissing
+		}
+
+		return v.Electra.Block.Slot, nil
 	default:
 		return 0, ErrUnsupportedVersion
 	}
@@ -83,6 +128,10 @@ func (v *VersionedProposal) Slot() (phase0.Slot, error) {
 
 // ProposerIndex returns the proposer index of the proposal.
 func (v *VersionedProposal) ProposerIndex() (phase0.ValidatorIndex, error) {
+	if v.Blinded {
+		return v.blinded().ProposerIndex()
+	}
+
 	switch v.Version {
 	case spec.DataVersionPhase0:
 		if v.Phase0 == nil {
@@ -115,6 +164,13 @@ func (v *VersionedProposal) ProposerIndex() (phase0.ValidatorIndex, error) {
 		}
 
 		return v.Deneb.Block.ProposerIndex, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Block == nil {
+			return 0, ErrDataMissing
+		}
+
+		return v.Electra.Block.ProposerIndex, nil
 	default:
 		return 0, ErrUnsupportedVersion
 	}
@@ -122,6 +178,10 @@ func (v *VersionedProposal) ProposerIndex() (phase0.ValidatorIndex, error) {
 
 // RandaoReveal returns the RANDAO reveal of the proposal.
 func (v *VersionedProposal) RandaoReveal() (phase0.BLSSignature, error) {
+	if v.Blinded {
+		return v.blinded().RandaoReveal()
+	}
+
 	switch v.Version {
 	case spec.DataVersionPhase0:
 		if v.Phase0 == nil ||
@@ -159,6 +219,14 @@ func (v *VersionedProposal) RandaoReveal() (phase0.BLSSignature, error) {
 		}
 
 		return v.Deneb.Block.Body.RANDAOReveal, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Block == nil ||
+			v.Electra.Block.Body == nil {
+			return phase0.BLSSignature{}, ErrDataMissing
+		}
+
+		return v.Electra.Block.Body.RANDAOReveal, nil
 	default:
 		return phase0.BLSSignature{}, ErrUnsupportedVersion
 	}
@@ -166,6 +234,10 @@ func (v *VersionedProposal) RandaoReveal() (phase0.BLSSignature, error) {
 
 // Graffiti returns the graffiti of the proposal.
 func (v *VersionedProposal) Graffiti() ([32]byte, error) {
+	if v.Blinded {
+		return v.blinded().Graffiti()
+	}
+
 	switch v.Version {
 	case spec.DataVersionPhase0:
 		if v.Phase0 == nil ||
@@ -203,13 +275,26 @@ func (v *VersionedProposal) Graffiti() ([32]byte, error) {
 		}
 
 		return v.Deneb.Block.Body.Graffiti, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Block == nil ||
+			v.Electra.Block.Body == nil {
+			return [32]byte{}, ErrDataMissing
+		}
+
+		return v.Electra.Block.Body.Graffiti, nil
 	default:
 		return [32]byte{}, ErrUnsupportedVersion
 	}
 }
 
 // Attestations returns the attestations of the proposal.
+// Electra attestations are not available through this function; use VersionedAttestations() instead.
 func (v *VersionedProposal) Attestations() ([]*phase0.Attestation, error) {
+	if v.Blinded {
+		return v.blinded().Attestations()
+	}
+
 	switch v.Version {
 	case spec.DataVersionPhase0:
 		if v.Phase0 == nil ||
@@ -252,8 +337,68 @@ func (v *VersionedProposal) Attestations() ([]*phase0.Attestation, error) {
 	}
 }
 
+// VersionedAttestations returns the versioned attestations of the proposal.
+func (v *VersionedProposal) VersionedAttestations() ([]*spec.VersionedAttestation, error) {
+	if v.Blinded {
+		return v.blinded().VersionedAttestations()
+	}
+
+	switch v.Version {
+	case spec.DataVersionPhase0:
+		if v.Phase0 == nil ||
+			v.Phase0.Body == nil {
+			return nil, ErrDataMissing
+		}
+
+		return spec.NewVersionedAttestations(spec.DataVersionPhase0, v.Phase0.Body.Attestations), nil
+	case spec.DataVersionAltair:
+		if v.Altair == nil ||
+			v.Altair.Body == nil {
+			return nil, ErrDataMissing
+		}
+
+		return spec.NewVersionedAttestations(spec.DataVersionAltair, v.Altair.Body.Attestations), nil
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil ||
+			v.Bellatrix.Body == nil {
+			return nil, ErrDataMissing
+		}
+
+		return spec.NewVersionedAttestations(spec.DataVersionBellatrix, v.Bellatrix.Body.Attestations), nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil ||
+			v.Capella.Body == nil {
+			return nil, ErrDataMissing
+		}
+
+		return spec.NewVersionedAttestations(spec.DataVersionCapella, v.Capella.Body.Attestations), nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil ||
+			v.Deneb.Block == nil ||
+			v.Deneb.Block.Body == nil {
+			return nil, ErrDataMissing
+		}
+
+		return spec.NewVersionedAttestations(spec.DataVersionDeneb, v.Deneb.Block.Body.Attestations), nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Block == nil ||
+			v.Electra.Block.Body == nil {
+			return nil, ErrDataMissing
+		}
+
+		return spec.NewVersionedElectraAttestations(v.Electra.Block.Body.Attestations), nil
+	default:
+		return nil, ErrUnsupportedVersion
+	}
+}
+
 // Root returns the root of the proposal.
 func (v *VersionedProposal) Root() (phase0.Root, error) {
+	if v.Blinded {
+		return v.blinded().Root()
+	}
+
 	switch v.Version {
 	case spec.DataVersionPhase0:
 		if v.Phase0 == nil {
@@ -286,6 +431,13 @@ func (v *VersionedProposal) Root() (phase0.Root, error) {
 		}
 
 		return v.Deneb.Block.HashTreeRoot()
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Block == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Electra.Block.HashTreeRoot()
 	default:
 		return phase0.Root{}, ErrUnsupportedVersion
 	}
@@ -293,6 +445,10 @@ func (v *VersionedProposal) Root() (phase0.Root, error) {
 
 // BodyRoot returns the body root of the proposal.
 func (v *VersionedProposal) BodyRoot() (phase0.Root, error) {
+	if v.Blinded {
+		return v.blinded().BodyRoot()
+	}
+
 	switch v.Version {
 	case spec.DataVersionPhase0:
 		if v.Phase0 == nil {
@@ -326,6 +482,14 @@ func (v *VersionedProposal) BodyRoot() (phase0.Root, error) {
 		}
 
 		return v.Deneb.Block.Body.HashTreeRoot()
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Block == nil ||
+			v.Electra.Block.Body == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Electra.Block.Body.HashTreeRoot()
 	default:
 		return phase0.Root{}, ErrUnsupportedVersion
 	}
@@ -333,6 +497,10 @@ func (v *VersionedProposal) BodyRoot() (phase0.Root, error) {
 
 // ParentRoot returns the parent root of the proposal.
 func (v *VersionedProposal) ParentRoot() (phase0.Root, error) {
+	if v.Blinded {
+		return v.blinded().ParentRoot()
+	}
+
 	switch v.Version {
 	case spec.DataVersionPhase0:
 		if v.Phase0 == nil {
@@ -365,6 +533,13 @@ func (v *VersionedProposal) ParentRoot() (phase0.Root, error) {
 		}
 
 		return v.Deneb.Block.ParentRoot, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Block == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Electra.Block.ParentRoot, nil
 	default:
 		return phase0.Root{}, ErrUnsupportedVersion
 	}
@@ -372,6 +547,10 @@ func (v *VersionedProposal) ParentRoot() (phase0.Root, error) {
 
 // StateRoot returns the state root of the proposal.
 func (v *VersionedProposal) StateRoot() (phase0.Root, error) {
+	if v.Blinded {
+		return v.blinded().StateRoot()
+	}
+
 	switch v.Version {
 	case spec.DataVersionPhase0:
 		if v.Phase0 == nil {
@@ -404,6 +583,13 @@ func (v *VersionedProposal) StateRoot() (phase0.Root, error) {
 		}
 
 		return v.Deneb.Block.StateRoot, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Block == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Electra.Block.StateRoot, nil
 	default:
 		return phase0.Root{}, ErrUnsupportedVersion
 	}
@@ -411,6 +597,11 @@ func (v *VersionedProposal) StateRoot() (phase0.Root, error) {
 
 // Transactions returns the transactions of the proposal.
 func (v *VersionedProposal) Transactions() ([]bellatrix.Transaction, error) {
+	if v.Blinded {
+		// Not available in blinded proposals.
+		return nil, ErrDataMissing
+	}
+
 	switch v.Version {
 	case spec.DataVersionBellatrix:
 		if v.Bellatrix == nil ||
@@ -437,6 +628,15 @@ func (v *VersionedProposal) Transactions() ([]bellatrix.Transaction, error) {
 		}
 
 		return v.Deneb.Block.Body.ExecutionPayload.Transactions, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Block == nil ||
+			v.Electra.Block.Body == nil ||
+			v.Electra.Block.Body.ExecutionPayload == nil {
+			return nil, ErrDataMissing
+		}
+
+		return v.Electra.Block.Body.ExecutionPayload.Transactions, nil
 	default:
 		return nil, ErrUnsupportedVersion
 	}
@@ -444,6 +644,10 @@ func (v *VersionedProposal) Transactions() ([]bellatrix.Transaction, error) {
 
 // FeeRecipient returns the fee recipient of the proposal.
 func (v *VersionedProposal) FeeRecipient() (bellatrix.ExecutionAddress, error) {
+	if v.Blinded {
+		return v.blinded().FeeRecipient()
+	}
+
 	switch v.Version {
 	case spec.DataVersionBellatrix:
 		if v.Bellatrix == nil ||
@@ -470,6 +674,15 @@ func (v *VersionedProposal) FeeRecipient() (bellatrix.ExecutionAddress, error) {
 		}
 
 		return v.Deneb.Block.Body.ExecutionPayload.FeeRecipient, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Block == nil ||
+			v.Electra.Block.Body == nil ||
+			v.Electra.Block.Body.ExecutionPayload == nil {
+			return bellatrix.ExecutionAddress{}, ErrDataMissing
+		}
+
+		return v.Electra.Block.Body.ExecutionPayload.FeeRecipient, nil
 	default:
 		return bellatrix.ExecutionAddress{}, ErrUnsupportedVersion
 	}
@@ -477,6 +690,10 @@ func (v *VersionedProposal) FeeRecipient() (bellatrix.ExecutionAddress, error) {
 
 // Timestamp returns the timestamp of the proposal.
 func (v *VersionedProposal) Timestamp() (uint64, error) {
+	if v.Blinded {
+		return v.blinded().Timestamp()
+	}
+
 	switch v.Version {
 	case spec.DataVersionBellatrix:
 		if v.Bellatrix == nil ||
@@ -503,6 +720,15 @@ func (v *VersionedProposal) Timestamp() (uint64, error) {
 		}
 
 		return v.Deneb.Block.Body.ExecutionPayload.Timestamp, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Block == nil ||
+			v.Electra.Block.Body == nil ||
+			v.Electra.Block.Body.ExecutionPayload == nil {
+			return 0, ErrDataMissing
+		}
+
+		return v.Electra.Block.Body.ExecutionPayload.Timestamp, nil
 	default:
 		return 0, ErrUnsupportedVersion
 	}
@@ -510,6 +736,11 @@ func (v *VersionedProposal) Timestamp() (uint64, error) {
 
 // Blobs returns the blobs of the proposal.
 func (v *VersionedProposal) Blobs() ([]deneb.Blob, error) {
+	if v.Blinded {
+		// Not available in blinded proposals.
+		return nil, ErrDataMissing
+	}
+
 	switch v.Version {
 	case spec.DataVersionDeneb:
 		if v.Deneb == nil {
@@ -517,6 +748,12 @@ func (v *VersionedProposal) Blobs() ([]deneb.Blob, error) {
 		}
 
 		return v.Deneb.Blobs, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return nil, ErrDataMissing
+		}
+
+		return v.Electra.Blobs, nil
 	default:
 		return nil, ErrUnsupportedVersion
 	}
@@ -524,6 +761,11 @@ func (v *VersionedProposal) Blobs() ([]deneb.Blob, error) {
 
 // KZGProofs returns the KZG proofs of the proposal.
 func (v *VersionedProposal) KZGProofs() ([]deneb.KZGProof, error) {
+	if v.Blinded {
+		// Not available in blinded proposals.
+		return nil, ErrDataMissing
+	}
+
 	switch v.Version {
 	case spec.DataVersionDeneb:
 		if v.Deneb == nil {
@@ -531,6 +773,12 @@ func (v *VersionedProposal) KZGProofs() ([]deneb.KZGProof, error) {
 		}
 
 		return v.Deneb.KZGProofs, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return nil, ErrDataMissing
+		}
+
+		return v.Electra.KZGProofs, nil
 	default:
 		return nil, ErrUnsupportedVersion
 	}
@@ -538,6 +786,10 @@ func (v *VersionedProposal) KZGProofs() ([]deneb.KZGProof, error) {
 
 // String returns a string version of the structure.
 func (v *VersionedProposal) String() string {
+	if v.Blinded {
+		return v.blinded().String()
+	}
+
 	switch v.Version {
 	case spec.DataVersionPhase0:
 		if v.Phase0 == nil {
@@ -569,6 +821,12 @@ func (v *VersionedProposal) String() string {
 		}
 
 		return v.Deneb.String()
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return ""
+		}
+
+		return v.Electra.String()
 	default:
 		return "unknown version"
 	}
@@ -0,0 +1,66 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionedProposalAttestations(t *testing.T) {
+	denebAttestations := []*phase0.Attestation{{Data: &phase0.AttestationData{Slot: 1}}}
+	denebProposal := &api.VersionedProposal{
+		Version: spec.DataVersionDeneb,
+		Deneb: &apiv1deneb.BlockContents{
+			Block: &deneb.BeaconBlock{
+				Body: &deneb.BeaconBlockBody{
+					Attestations: denebAttestations,
+				},
+			},
+		},
+	}
+
+	attestations, err := denebProposal.Attestations()
+	require.NoError(t, err)
+	require.Equal(t, denebAttestations, attestations)
+	versionedAttestations, err := denebProposal.VersionedAttestations()
+	require.NoError(t, err)
+	require.Equal(t, spec.NewVersionedAttestations(spec.DataVersionDeneb, denebAttestations), versionedAttestations)
+
+	electraAttestations := []*electra.Attestation{{Data: &phase0.AttestationData{Slot: 2}}}
+	electraProposal := &api.VersionedProposal{
+		Version: spec.DataVersionElectra,
+		Electra: &apiv1electra.BlockContents{
+			Block: &electra.BeaconBlock{
+				Body: &electra.BeaconBlockBody{
+					Attestations: electraAttestations,
+				},
+			},
+		},
+	}
+
+	_, err = electraProposal.Attestations()
+	require.ErrorIs(t, err, api.ErrUnsupportedVersion)
+	versionedAttestations, err = electraProposal.VersionedAttestations()
+	require.NoError(t, err)
+	require.Equal(t, spec.NewVersionedElectraAttestations(electraAttestations), versionedAttestations)
+}
@@ -17,6 +17,7 @@ import (
 	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
 	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
 	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
@@ -27,6 +28,7 @@ type VersionedSignedBlindedBeaconBlock struct {
 	Bellatrix *apiv1bellatrix.SignedBlindedBeaconBlock
 	Capella   *apiv1capella.SignedBlindedBeaconBlock
 	Deneb     *apiv1deneb.SignedBlindedBeaconBlock
+	Electra   *apiv1electra.SignedBlindedBeaconBlock
 }
 
 // Slot returns the slot of the signed beacon block.
@@ -53,12 +55,20 @@ func (v *VersionedSignedBlindedBeaconBlock) Slot() (phase0.Slot, error) {
 		}
 
 		return v.Deneb.Message.Slot, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Message == nil {
+			return 0, ErrDataMissing
+		}
+
+		return v.Electra.Message.Slot, nil
 	default:
 		return 0, ErrUnsupportedVersion
 	}
 }
 
 // Attestations returns the attestations of the beacon block.
+// Electra attestations are not available through this function; use VersionedAttestations() instead.
 func (v *VersionedSignedBlindedBeaconBlock) Attestations() ([]*phase0.Attestation, error) {
 	switch v.Version {
 	case spec.DataVersionBellatrix:
@@ -90,6 +100,46 @@ func (v *VersionedSignedBlindedBeaconBlock) Attestations() ([]*phase0.Attestatio
 	}
 }
 
+// VersionedAttestations returns the versioned attestations of the beacon block.
+func (v *VersionedSignedBlindedBeaconBlock) VersionedAttestations() ([]*spec.VersionedAttestation, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil ||
+			v.Bellatrix.Message == nil ||
+			v.Bellatrix.Message.Body == nil {
+			return nil, ErrDataMissing
+		}
+
+		return spec.NewVersionedAttestations(spec.DataVersionBellatrix, v.Bellatrix.Message.Body.Attestations), nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil ||
+			v.Capella.Message == nil ||
+			v.Capella.Message.Body == nil {
+			return nil, ErrDataMissing
+		}
+
+		return spec.NewVersionedAttestations(spec.DataVersionCapella, v.Capella.Message.Body.Attestations), nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil ||
+			v.Deneb.Message == nil ||
+			v.Deneb.Message.Body == nil {
+			return nil, ErrDataMissing
+		}
+
+		return spec.NewVersionedAttestations(spec.DataVersionDeneb, v.Deneb.Message.Body.Attestations), nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Message == nil ||
+			v.Electra.Message.Body == nil {
+			return nil, ErrDataMissing
+		}
+
+		return spec.NewVersionedElectraAttestations(v.Electra.Message.Body.Attestations), nil
+	default:
+		return nil, ErrUnsupportedVersion
+	}
+}
+
 // Root returns the root of the beacon block.
 func (v *VersionedSignedBlindedBeaconBlock) Root() (phase0.Root, error) {
 	switch v.Version {
@@ -111,6 +161,12 @@ func (v *VersionedSignedBlindedBeaconBlock) Root() (phase0.Root, error) {
 		}
 
 		return v.Deneb.Message.HashTreeRoot()
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Electra.Message.HashTreeRoot()
 	default:
 		return phase0.Root{}, ErrUnsupportedVersion
 	}
@@ -137,6 +193,12 @@ func (v *VersionedSignedBlindedBeaconBlock) BodyRoot() (phase0.Root, error) {
 		}
 
 		return v.Deneb.Message.Body.HashTreeRoot()
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Electra.Message.Body.HashTreeRoot()
 	default:
 		return phase0.Root{}, ErrUnsupportedVersion
 	}
@@ -163,6 +225,12 @@ func (v *VersionedSignedBlindedBeaconBlock) ParentRoot() (phase0.Root, error) {
 		}
 
 		return v.Deneb.Message.ParentRoot, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Electra.Message.ParentRoot, nil
 	default:
 		return phase0.Root{}, ErrUnsupportedVersion
 	}
@@ -189,12 +257,19 @@ func (v *VersionedSignedBlindedBeaconBlock) StateRoot() (phase0.Root, error) {
 		}
 
 		return v.Deneb.Message.StateRoot, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, ErrDataMissing
+		}
+
+		return v.Electra.Message.StateRoot, nil
 	default:
 		return phase0.Root{}, ErrUnsupportedVersion
 	}
 }
 
 // AttesterSlashings returns the attester slashings of the beacon block.
+// Electra attester slashings are not available through this function; use VersionedAttesterSlashings() instead.
 func (v *VersionedSignedBlindedBeaconBlock) AttesterSlashings() ([]*phase0.AttesterSlashing, error) {
 	switch v.Version {
 	case spec.DataVersionBellatrix:
@@ -220,6 +295,38 @@ func (v *VersionedSignedBlindedBeaconBlock) AttesterSlashings() ([]*phase0.Attes
 	}
 }
 
+// VersionedAttesterSlashings returns the versioned attester slashings of the beacon block.
+func (v *VersionedSignedBlindedBeaconBlock) VersionedAttesterSlashings() ([]*spec.VersionedAttesterSlashing, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, ErrDataMissing
+		}
+
+		return spec.NewVersionedAttesterSlashings(spec.DataVersionBellatrix, v.Bellatrix.Message.Body.AttesterSlashings), nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return nil, ErrDataMissing
+		}
+
+		return spec.NewVersionedAttesterSlashings(spec.DataVersionCapella, v.Capella.Message.Body.AttesterSlashings), nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, ErrDataMissing
+		}
+
+		return spec.NewVersionedAttesterSlashings(spec.DataVersionDeneb, v.Deneb.Message.Body.AttesterSlashings), nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return nil, ErrDataMissing
+		}
+
+		return spec.NewVersionedElectraAttesterSlashings(v.Electra.Message.Body.AttesterSlashings), nil
+	default:
+		return nil, ErrUnsupportedVersion
+	}
+}
+
 // ProposerSlashings returns the proposer slashings of the beacon block.
 func (v *VersionedSignedBlindedBeaconBlock) ProposerSlashings() ([]*phase0.ProposerSlashing, error) {
 	switch v.Version {
@@ -241,6 +348,12 @@ func (v *VersionedSignedBlindedBeaconBlock) ProposerSlashings() ([]*phase0.Propo
 		}
 
 		return v.Deneb.Message.Body.ProposerSlashings, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return nil, ErrDataMissing
+		}
+
+		return v.Electra.Message.Body.ProposerSlashings, nil
 	default:
 		return nil, ErrUnsupportedVersion
 	}
@@ -270,6 +383,13 @@ func (v *VersionedSignedBlindedBeaconBlock) ProposerIndex() (phase0.ValidatorInd
 		}
 
 		return v.Deneb.Message.ProposerIndex, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Message == nil {
+			return 0, ErrDataMissing
+		}
+
+		return v.Electra.Message.ProposerIndex, nil
 	default:
 		return 0, ErrUnsupportedVersion
 	}
@@ -305,6 +425,15 @@ func (v *VersionedSignedBlindedBeaconBlock) ExecutionBlockHash() (phase0.Hash32,
 		}
 
 		return v.Deneb.Message.Body.ExecutionPayloadHeader.BlockHash, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Message == nil ||
+			v.Electra.Message.Body == nil ||
+			v.Electra.Message.Body.ExecutionPayloadHeader == nil {
+			return phase0.Hash32{}, ErrDataMissing
+		}
+
+		return v.Electra.Message.Body.ExecutionPayloadHeader.BlockHash, nil
 	default:
 		return phase0.Hash32{}, ErrUnsupportedVersion
 	}
@@ -340,6 +469,15 @@ func (v *VersionedSignedBlindedBeaconBlock) ExecutionBlockNumber() (uint64, erro
 		}
 
 		return v.Deneb.Message.Body.ExecutionPayloadHeader.BlockNumber, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.Message == nil ||
+			v.Electra.Message.Body == nil ||
+			v.Electra.Message.Body.ExecutionPayloadHeader == nil {
+			return 0, ErrDataMissing
+		}
+
+		return v.Electra.Message.Body.ExecutionPayloadHeader.BlockNumber, nil
 	default:
 		return 0, ErrUnsupportedVersion
 	}
@@ -366,6 +504,12 @@ func (v *VersionedSignedBlindedBeaconBlock) Signature() (phase0.BLSSignature, er
 		}
 
 		return v.Deneb.Signature, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.BLSSignature{}, ErrDataMissing
+		}
+
+		return v.Electra.Signature, nil
 	default:
 		return phase0.BLSSignature{}, ErrUnsupportedVersion
 	}
@@ -15,6 +15,7 @@ package api
 
 import (
 	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/altair"
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
@@ -30,6 +31,7 @@ type VersionedSignedProposal struct {
 	Bellatrix *bellatrix.SignedBeaconBlock
 	Capella   *capella.SignedBeaconBlock
 	Deneb     *apiv1deneb.SignedBlockContents
+	Electra   *apiv1electra.SignedBlockContents
 }
 
 // Slot returns the slot of the signed proposal.
@@ -71,11 +73,61 @@ func (v *VersionedSignedProposal) Slot() (phase0.Slot, error) {
 		}
 
 		return v.Deneb.SignedBlock.Message.Slot, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.SignedBlock == nil ||
+			v.Electra.SignedBlock.Message == nil {
+			return 0, ErrDataMissing
+		}
+
+		return v.Electra.SignedBlock.Message.Slot, nil
 	default:
 		return 0, ErrUnsupportedVersion
 	}
 }
 
+// AssertPresent returns an error if the signed proposal for the version is not present.
+func (v *VersionedSignedProposal) AssertPresent() error {
+	switch v.Version {
+	case spec.DataVersionPhase0:
+		if v.Phase0 == nil ||
+			v.Phase0.Message == nil {
+			return ErrDataMissing
+		}
+	case spec.DataVersionAltair:
+		if v.Altair == nil ||
+			v.Altair.Message == nil {
+			return ErrDataMissing
+		}
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil ||
+			v.Bellatrix.Message == nil {
+			return ErrDataMissing
+		}
+	case spec.DataVersionCapella:
+		if v.Capella == nil ||
+			v.Capella.Message == nil {
+			return ErrDataMissing
+		}
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil ||
+			v.Deneb.SignedBlock == nil ||
+			v.Deneb.SignedBlock.Message == nil {
+			return ErrDataMissing
+		}
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.SignedBlock == nil ||
+			v.Electra.SignedBlock.Message == nil {
+			return ErrDataMissing
+		}
+	default:
+		return ErrUnsupportedVersion
+	}
+
+	return nil
+}
+
 // ExecutionBlockHash returns the hash of the execution payload.
 func (v *VersionedSignedProposal) ExecutionBlockHash() (phase0.Hash32, error) {
 	switch v.Version {
@@ -107,6 +159,16 @@ func (v *VersionedSignedProposal) ExecutionBlockHash() (phase0.Hash32, error) {
 		}
 
 		return v.Deneb.SignedBlock.Message.Body.ExecutionPayload.BlockHash, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil ||
+			v.Electra.SignedBlock == nil ||
+			v.Electra.SignedBlock.Message == nil ||
+			v.Electra.SignedBlock.Message.Body == nil ||
+			v.Electra.SignedBlock.Message.Body.ExecutionPayload == nil {
+			return phase0.Hash32{}, ErrDataMissing
+		}
+
+		return v.Electra.SignedBlock.Message.Body.ExecutionPayload.BlockHash, nil
 	default:
 		return phase0.Hash32{}, ErrUnsupportedVersion
 	}
@@ -145,6 +207,12 @@ func (v *VersionedSignedProposal) String() string {
 		}
 
 		return v.Deneb.String()
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return ""
+		}
+
+		return v.Electra.String()
 	default:
 		return "unsupported version"
 	}
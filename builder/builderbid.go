@@ -0,0 +1,140 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/pkg/errors"
+)
+
+// BuilderBid fetches a signed builder bid for the given slot, parent hash and proposer.
+// If the builder does not have a bid then the response data will be nil.
+func (s *Service) BuilderBid(ctx context.Context,
+	opts *api.BuilderBidOpts,
+) (
+	*api.Response[*api.VersionedSignedBuilderBid],
+	error,
+) {
+	if opts == nil {
+		return nil, errors.New("no options specified")
+	}
+
+	endpoint := fmt.Sprintf("/eth/v1/builder/header/%d/%#x/%#x", opts.Slot, opts.ParentHash, opts.PubKey)
+	httpResponse, err := s.get(ctx, endpoint, &opts.Common)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request builder bid")
+	}
+
+	if httpResponse.statusCode == http.StatusNoContent || len(httpResponse.body) == 0 {
+		// No bid available.
+		return &api.Response[*api.VersionedSignedBuilderBid]{
+			Metadata: make(map[string]any),
+		}, nil
+	}
+
+	var response *api.Response[*api.VersionedSignedBuilderBid]
+	switch httpResponse.contentType {
+	case mediaTypeSSZ:
+		response, err = builderBidFromSSZ(httpResponse)
+	case mediaTypeJSON:
+		response, err = builderBidFromJSON(httpResponse)
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", httpResponse.contentType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func builderBidFromSSZ(res *httpResponse) (*api.Response[*api.VersionedSignedBuilderBid], error) {
+	response := &api.Response[*api.VersionedSignedBuilderBid]{
+		Data: &api.VersionedSignedBuilderBid{
+			Version: res.consensusVersion,
+		},
+		Metadata: map[string]any{
+			"version": res.consensusVersion,
+		},
+	}
+
+	var err error
+	switch res.consensusVersion {
+	case spec.DataVersionBellatrix:
+		response.Data.Bellatrix = &apiv1bellatrix.SignedBuilderBid{}
+		err = response.Data.Bellatrix.UnmarshalSSZ(res.body)
+	case spec.DataVersionCapella:
+		response.Data.Capella = &apiv1capella.SignedBuilderBid{}
+		err = response.Data.Capella.UnmarshalSSZ(res.body)
+	case spec.DataVersionDeneb:
+		response.Data.Deneb = &apiv1deneb.SignedBuilderBid{}
+		err = response.Data.Deneb.UnmarshalSSZ(res.body)
+	case spec.DataVersionElectra:
+		response.Data.Electra = &apiv1electra.SignedBuilderBid{}
+		err = response.Data.Electra.UnmarshalSSZ(res.body)
+	default:
+		return nil, fmt.Errorf("unhandled builder bid version %s", res.consensusVersion)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode builder bid")
+	}
+
+	return response, nil
+}
+
+func builderBidFromJSON(res *httpResponse) (*api.Response[*api.VersionedSignedBuilderBid], error) {
+	var versioned versionedJSON
+	if err := json.NewDecoder(bytes.NewReader(res.body)).Decode(&versioned); err != nil {
+		return nil, errors.Wrap(err, "failed to parse builder bid")
+	}
+
+	response := &api.Response[*api.VersionedSignedBuilderBid]{
+		Data: &api.VersionedSignedBuilderBid{
+			Version: versioned.Version,
+		},
+		Metadata: map[string]any{
+			"version": versioned.Version,
+		},
+	}
+
+	var err error
+	switch versioned.Version {
+	case spec.DataVersionBellatrix:
+		err = json.Unmarshal(versioned.Data, &response.Data.Bellatrix)
+	case spec.DataVersionCapella:
+		err = json.Unmarshal(versioned.Data, &response.Data.Capella)
+	case spec.DataVersionDeneb:
+		err = json.Unmarshal(versioned.Data, &response.Data.Deneb)
+	case spec.DataVersionElectra:
+		err = json.Unmarshal(versioned.Data, &response.Data.Electra)
+	default:
+		return nil, fmt.Errorf("unhandled builder bid version %s", versioned.Version)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode builder bid")
+	}
+
+	return response, nil
+}
@@ -0,0 +1,142 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+const testBuilderBid = `{"message":{"header":{"parent_hash":"0x17f4eeae822cc81533016678413443b95e34517e67f12b4a3a92ff6b66f972ef","fee_recipient":"0x58E809C71e4885cB7B3f1D5c793AB04eD239d779","state_root":"0x3d6e230e6eceb8f3db582777b1500b8b31b9d268339e7b32bba8d6f1311b211d","receipts_root":"0xea760203509bdde017a506b12c825976d12b04db7bce9eca9e1ed007056a3f36","logs_bloom":"0x0c803a8d3c6642adee3185bd914c599317d96487831dabda82461f65700b2528781bdadf785664f9d8b11c4ee1139dfeb056125d2abd67e379cabc6d58f1c3ea304b97cf17fcd8a4c53f4dedeaa041acce062fc8fbc88ffc111577db4a936378749f2fd82b4bfcb880821dd5cbefee984bc1ad116096a64a44a2aac8a1791a7ad3a53d91c584ac69a8973daed6daee4432a198c9935fa0e5c2a4a6ca78b821a5b046e571a5c0961f469d40e429066755fec611afe25b560db07f989933556ce0cea4070ca47677b007b4b9857fc092625f82c84526737dc98e173e34fe6e4d0f1a400fd994298b7c2fa8187331c333c415f0499836ff0eed5c762bf570e67b44","prev_randao":"0x76ff751467270668df463600d26dba58297a986e649bac84ea856712d4779c00","block_number":"2983837628677007840","gas_limit":"6738255228996962210","gas_used":"5573520557770513197","timestamp":"1744720080366521389","extra_data":"0xc648","base_fee_per_gas":"88770397543877639215846057887940126737648744594802753726778414602657613619599","block_hash":"0x42c294e902bfc9884c1ce5fef156d4661bb8f0ff488bface37f18c3e7be64b0f","transactions_root":"0x8457d0eb7611a621e7a094059f087415ffcfc91714fc184a1f3c48db06b4d08b","withdrawals_root":"0x5c1a7e3e0eab917a7c0d677c6b692ed55ce05f07d572e09b1c06d558f474ea7a"},"value":"1000000000000000000","pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c"},"signature":"0x606162636465666768696a6b6c6d6e6f707172737475767778797a7b7c7d7e7f808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e9fa0a1a2a3a4a5a6a7a8a9aaabacadaeafb0b1b2b3b4b5b6b7b8b9babbbcbdbebf"}`
+
+const testElectraBuilderBid = `{"message":{"header":{"parent_hash":"0x17f4eeae822cc81533016678413443b95e34517e67f12b4a3a92ff6b66f972ef","fee_recipient":"0x58E809C71e4885cB7B3f1D5c793AB04eD239d779","state_root":"0x3d6e230e6eceb8f3db582777b1500b8b31b9d268339e7b32bba8d6f1311b211d","receipts_root":"0xea760203509bdde017a506b12c825976d12b04db7bce9eca9e1ed007056a3f36","logs_bloom":"0x0c803a8d3c6642adee3185bd914c599317d96487831dabda82461f65700b2528781bdadf785664f9d8b11c4ee1139dfeb056125d2abd67e379cabc6d58f1c3ea304b97cf17fcd8a4c53f4dedeaa041acce062fc8fbc88ffc111577db4a936378749f2fd82b4bfcb880821dd5cbefee984bc1ad116096a64a44a2aac8a1791a7ad3a53d91c584ac69a8973daed6daee4432a198c9935fa0e5c2a4a6ca78b821a5b046e571a5c0961f469d40e429066755fec611afe25b560db07f989933556ce0cea4070ca47677b007b4b9857fc092625f82c84526737dc98e173e34fe6e4d0f1a400fd994298b7c2fa8187331c333c415f0499836ff0eed5c762bf570e67b44","prev_randao":"0x76ff751467270668df463600d26dba58297a986e649bac84ea856712d4779c00","block_number":"2983837628677007840","gas_limit":"6738255228996962210","gas_used":"5573520557770513197","timestamp":"1744720080366521389","extra_data":"0xc648","base_fee_per_gas":"88770397543877639215846057887940126737648744594802753726778414602657613619599","block_hash":"0x42c294e902bfc9884c1ce5fef156d4661bb8f0ff488bface37f18c3e7be64b0f","transactions_root":"0x8457d0eb7611a621e7a094059f087415ffcfc91714fc184a1f3c48db06b4d08b","withdrawals_root":"0x5c1a7e3e0eab917a7c0d677c6b692ed55ce05f07d572e09b1c06d558f474ea7a","blob_gas_used":"4438756708366371443","excess_blob_gas":"12504111653614393862","deposit_requests_root":"0x0a3d6d2f1b7c0e4e1f5d3c2b1a09f8e7d6c5b4a39281706f5e4d3c2b1a0f9e8d","withdrawal_requests_root":"0x1b4e7e3f2c8d1f5f2a6e4d3c2b1a09f8e7d6c5b4a39281706f5e4d3c2b1a0f9e","consolidation_requests_root":"0x2c5f8f4a3d9e2a6a3b7f5e4d3c2b1a09f8e7d6c5b4a39281706f5e4d3c2b1a0f"},"blob_kzg_commitments":["0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f"],"value":"1000000000000000000","pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c"},"signature":"0x606162636465666768696a6b6c6d6e6f707172737475767778797a7b7c7d7e7f808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e9fa0a1a2a3a4a5a6a7a8a9aaabacadaeafb0b1b2b3b4b5b6b7b8b9babbbcbdbebf"}`
+
+func TestBuilderBid(t *testing.T) {
+	ctx := context.Background()
+	opts := &api.BuilderBidOpts{
+		Slot:       123,
+		ParentHash: phase0.Hash32{0x01},
+		PubKey:     phase0.BLSPubKey{0x02},
+	}
+	expectedPath := fmt.Sprintf("/eth/v1/builder/header/123/%#x/%#x", opts.ParentHash, opts.PubKey)
+
+	bid := &apiv1capella.SignedBuilderBid{}
+	require.NoError(t, json.Unmarshal([]byte(testBuilderBid), bid))
+	sszData, err := bid.MarshalSSZ()
+	require.NoError(t, err)
+
+	t.Run("NilOpts", func(t *testing.T) {
+		service := newTestService(t, func(w http.ResponseWriter, _ *http.Request) {})
+		_, err := service.BuilderBid(ctx, nil)
+		require.EqualError(t, err, "no options specified")
+	})
+
+	t.Run("NoBid", func(t *testing.T) {
+		service := newTestService(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		})
+		res, err := service.BuilderBid(ctx, opts)
+		require.NoError(t, err)
+		require.Nil(t, res.Data)
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, expectedPath, r.URL.Path)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"version":"capella","data":` + testBuilderBid + `}`))
+		})
+		res, err := service.BuilderBid(ctx, opts)
+		require.NoError(t, err)
+		require.Equal(t, spec.DataVersionCapella, res.Data.Version)
+		require.Equal(t, bid, res.Data.Capella)
+		value, err := res.Data.Value()
+		require.NoError(t, err)
+		require.Equal(t, "1000000000000000000", value.Dec())
+	})
+
+	t.Run("SSZ", func(t *testing.T) {
+		service := newTestService(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/octet-stream")
+			w.Header().Set("Eth-Consensus-Version", "capella")
+			_, _ = w.Write(sszData)
+		})
+		res, err := service.BuilderBid(ctx, opts)
+		require.NoError(t, err)
+		require.Equal(t, spec.DataVersionCapella, res.Data.Version)
+		require.Equal(t, bid, res.Data.Capella)
+	})
+
+	t.Run("SSZNoVersion", func(t *testing.T) {
+		service := newTestService(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/octet-stream")
+			_, _ = w.Write(sszData)
+		})
+		_, err := service.BuilderBid(ctx, opts)
+		require.EqualError(t, err, "unhandled builder bid version unknown")
+	})
+}
+
+func TestBuilderBidElectra(t *testing.T) {
+	ctx := context.Background()
+	opts := &api.BuilderBidOpts{
+		Slot:       123,
+		ParentHash: phase0.Hash32{0x01},
+		PubKey:     phase0.BLSPubKey{0x02},
+	}
+
+	bid := &apiv1electra.SignedBuilderBid{}
+	require.NoError(t, json.Unmarshal([]byte(testElectraBuilderBid), bid))
+	sszData, err := bid.MarshalSSZ()
+	require.NoError(t, err)
+
+	t.Run("JSON", func(t *testing.T) {
+		service := newTestService(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"version":"electra","data":` + testElectraBuilderBid + `}`))
+		})
+		res, err := service.BuilderBid(ctx, opts)
+		require.NoError(t, err)
+		require.Equal(t, spec.DataVersionElectra, res.Data.Version)
+		require.Equal(t, bid, res.Data.Electra)
+		value, err := res.Data.Value()
+		require.NoError(t, err)
+		require.Equal(t, "1000000000000000000", value.Dec())
+		commitments, err := res.Data.BlobKZGCommitments()
+		require.NoError(t, err)
+		require.Len(t, commitments, 1)
+	})
+
+	t.Run("SSZ", func(t *testing.T) {
+		service := newTestService(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/octet-stream")
+			w.Header().Set("Eth-Consensus-Version", "electra")
+			_, _ = w.Write(sszData)
+		})
+		res, err := service.BuilderBid(ctx, opts)
+		require.NoError(t, err)
+		require.Equal(t, spec.DataVersionElectra, res.Data.Version)
+		require.Equal(t, bid, res.Data.Electra)
+	})
+}
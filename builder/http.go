@@ -0,0 +1,169 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/pkg/errors"
+)
+
+const (
+	mediaTypeJSON = "application/json"
+	mediaTypeSSZ  = "application/octet-stream"
+)
+
+// httpResponse is the response from a call to the builder API.
+type httpResponse struct {
+	statusCode       int
+	contentType      string
+	consensusVersion spec.DataVersion
+	body             []byte
+}
+
+// versionedJSON is the standard wrapper for versioned builder API responses.
+type versionedJSON struct {
+	Version spec.DataVersion `json:"version"`
+	Data    json.RawMessage  `json:"data"`
+}
+
+// get sends a GET request to the builder API.
+func (s *Service) get(ctx context.Context, endpoint string, opts *api.CommonOpts) (*httpResponse, error) {
+	return s.do(ctx, http.MethodGet, endpoint, nil, "", nil, opts)
+}
+
+// post sends a POST request to the builder API.
+func (s *Service) post(ctx context.Context,
+	endpoint string,
+	body []byte,
+	contentType string,
+	headers map[string]string,
+) (
+	*httpResponse,
+	error,
+) {
+	return s.do(ctx, http.MethodPost, endpoint, body, contentType, headers, &api.CommonOpts{})
+}
+
+func (s *Service) do(ctx context.Context,
+	method string,
+	endpoint string,
+	body []byte,
+	contentType string,
+	headers map[string]string,
+	opts *api.CommonOpts,
+) (
+	*httpResponse,
+	error,
+) {
+	log := s.log.With().Str("address", s.address).Str("method", method).Str("endpoint", endpoint).Logger()
+
+	url, err := url.Parse(fmt.Sprintf("%s%s", strings.TrimSuffix(s.base.String(), "/"), endpoint))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid endpoint")
+	}
+
+	timeout := s.timeout
+	if opts.Timeout != 0 {
+		timeout = opts.Timeout
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+
+	opCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(opCtx, method, url.String(), reqBody)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to create %s request", method))
+	}
+	for k, v := range s.extraHeaders {
+		req.Header.Add(k, v)
+	}
+	if s.enforceJSON {
+		// JSON only.
+		req.Header.Set("Accept", mediaTypeJSON)
+	} else {
+		// Prefer SSZ, JSON if not.
+		req.Header.Set("Accept", "application/octet-stream;q=1,application/json;q=0.9")
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	if req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", "go-eth2-client/0.19.10")
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to call %s endpoint", method))
+	}
+	defer resp.Body.Close()
+
+	res := &httpResponse{
+		statusCode:       resp.StatusCode,
+		contentType:      mediaTypeJSON,
+		consensusVersion: spec.DataVersionUnknown,
+	}
+
+	res.body, err = io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read body")
+	}
+
+	if resp.StatusCode/100 != 2 {
+		log.Debug().Int("status_code", resp.StatusCode).Str("data", string(res.body)).Msg("Request failed")
+
+		return nil, &api.Error{
+			Method:     method,
+			StatusCode: resp.StatusCode,
+			Endpoint:   endpoint,
+			Data:       res.body,
+		}
+	}
+
+	if respContentType := resp.Header.Get("Content-Type"); respContentType != "" {
+		mediaType, _, err := mime.ParseMediaType(respContentType)
+		if err != nil {
+			// Assume that unknown type is JSON.
+			log.Debug().Err(err).Msg("Failed to obtain content type; assuming JSON")
+		} else {
+			res.contentType = mediaType
+		}
+	}
+
+	if respConsensusVersion := resp.Header.Get("Eth-Consensus-Version"); respConsensusVersion != "" {
+		if err := res.consensusVersion.UnmarshalJSON([]byte(fmt.Sprintf("%q", respConsensusVersion))); err != nil {
+			return nil, errors.Wrap(err, "failed to parse consensus version")
+		}
+	}
+	log.Trace().Int("status_code", resp.StatusCode).Msg("Request succeeded")
+
+	return res, nil
+}
@@ -0,0 +1,76 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+// RelayBuilderBlocksReceived obtains blocks received by the relay from builders.
+func (s *Service) RelayBuilderBlocksReceived(ctx context.Context,
+	opts *api.RelayBuilderBlocksReceivedOpts,
+) (
+	*api.Response[[]*apiv1.ReceivedBidTrace],
+	error,
+) {
+	if opts == nil {
+		return nil, errors.New("no options specified")
+	}
+
+	additionalFields := make([]string, 0)
+	if opts.Slot != nil {
+		additionalFields = append(additionalFields, fmt.Sprintf("slot=%d", *opts.Slot))
+	}
+	if opts.BlockHash != nil {
+		additionalFields = append(additionalFields, fmt.Sprintf("block_hash=%#x", *opts.BlockHash))
+	}
+	if opts.BlockNumber != nil {
+		additionalFields = append(additionalFields, fmt.Sprintf("block_number=%d", *opts.BlockNumber))
+	}
+	if opts.BuilderPubkey != nil {
+		additionalFields = append(additionalFields, fmt.Sprintf("builder_pubkey=%#x", *opts.BuilderPubkey))
+	}
+	if len(additionalFields) == 0 {
+		return nil, errors.New("no slot, block hash, block number or builder public key specified")
+	}
+	if opts.Limit != 0 {
+		additionalFields = append(additionalFields, fmt.Sprintf("limit=%d", opts.Limit))
+	}
+
+	endpoint := fmt.Sprintf("/relay/v1/data/bidtraces/builder_blocks_received?%s", strings.Join(additionalFields, "&"))
+	httpResponse, err := s.get(ctx, endpoint, &opts.Common)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request builder blocks received")
+	}
+
+	data := make([]*apiv1.ReceivedBidTrace, 0)
+	if len(httpResponse.body) > 0 {
+		if err := json.NewDecoder(bytes.NewReader(httpResponse.body)).Decode(&data); err != nil {
+			return nil, errors.Wrap(err, "failed to parse builder blocks received")
+		}
+	}
+
+	return &api.Response[[]*apiv1.ReceivedBidTrace]{
+		Data:     data,
+		Metadata: make(map[string]any),
+	}, nil
+}
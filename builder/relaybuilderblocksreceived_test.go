@@ -0,0 +1,50 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelayBuilderBlocksReceived(t *testing.T) {
+	ctx := context.Background()
+	slot := phase0.Slot(1000)
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/relay/v1/data/bidtraces/builder_blocks_received", r.URL.Path)
+		require.Equal(t, "slot=1000&limit=5", r.URL.RawQuery)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"slot":"1000","parent_hash":"0x17f4eeae822cc81533016678413443b95e34517e67f12b4a3a92ff6b66f972ef","block_hash":"0x42c294e902bfc9884c1ce5fef156d4661bb8f0ff488bface37f18c3e7be64b0f","builder_pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","proposer_pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","proposer_fee_recipient":"0x58E809C71e4885cB7B3f1D5c793AB04eD239d779","gas_limit":"30000000","gas_used":"12345678","value":"41234567890123456","block_number":"18000000","num_tx":"123","timestamp":"1700000000","timestamp_ms":"1700000000123","optimistic_submission":true}]`))
+	})
+
+	_, err := service.RelayBuilderBlocksReceived(ctx, &api.RelayBuilderBlocksReceivedOpts{})
+	require.EqualError(t, err, "no slot, block hash, block number or builder public key specified")
+
+	res, err := service.RelayBuilderBlocksReceived(ctx, &api.RelayBuilderBlocksReceivedOpts{
+		Slot:  &slot,
+		Limit: 5,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Data, 1)
+	require.Equal(t, slot, res.Data[0].Slot)
+	require.Equal(t, time.UnixMilli(1700000000123), res.Data[0].Timestamp)
+	require.True(t, res.Data[0].OptimisticSubmission)
+}
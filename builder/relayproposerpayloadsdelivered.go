@@ -0,0 +1,182 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// defaultPageLimit is the number of results requested per page when paginating
+// and no limit is supplied.
+const defaultPageLimit = 100
+
+// RelayProposerPayloadsDelivered obtains payloads delivered by the relay to proposers, in
+// descending slot order.
+func (s *Service) RelayProposerPayloadsDelivered(ctx context.Context,
+	opts *api.RelayProposerPayloadsDeliveredOpts,
+) (
+	*api.Response[[]*apiv1.BidTrace],
+	error,
+) {
+	if opts == nil {
+		return nil, errors.New("no options specified")
+	}
+	if opts.Slot != nil && opts.Cursor != nil {
+		return nil, errors.New("cannot specify both slot and cursor")
+	}
+
+	additionalFields := make([]string, 0)
+	if opts.Slot != nil {
+		additionalFields = append(additionalFields, fmt.Sprintf("slot=%d", *opts.Slot))
+	}
+	if opts.Cursor != nil {
+		additionalFields = append(additionalFields, fmt.Sprintf("cursor=%d", *opts.Cursor))
+	}
+	if opts.Limit != 0 {
+		additionalFields = append(additionalFields, fmt.Sprintf("limit=%d", opts.Limit))
+	}
+	if opts.BlockHash != nil {
+		additionalFields = append(additionalFields, fmt.Sprintf("block_hash=%#x", *opts.BlockHash))
+	}
+	if opts.BlockNumber != nil {
+		additionalFields = append(additionalFields, fmt.Sprintf("block_number=%d", *opts.BlockNumber))
+	}
+	if opts.ProposerPubkey != nil {
+		additionalFields = append(additionalFields, fmt.Sprintf("proposer_pubkey=%#x", *opts.ProposerPubkey))
+	}
+	if opts.BuilderPubkey != nil {
+		additionalFields = append(additionalFields, fmt.Sprintf("builder_pubkey=%#x", *opts.BuilderPubkey))
+	}
+
+	endpoint := "/relay/v1/data/bidtraces/proposer_payload_delivered"
+	if len(additionalFields) > 0 {
+		endpoint = fmt.Sprintf("%s?%s", endpoint, strings.Join(additionalFields, "&"))
+	}
+
+	httpResponse, err := s.get(ctx, endpoint, &opts.Common)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request proposer payloads delivered")
+	}
+
+	data := make([]*apiv1.BidTrace, 0)
+	if len(httpResponse.body) > 0 {
+		if err := json.NewDecoder(bytes.NewReader(httpResponse.body)).Decode(&data); err != nil {
+			return nil, errors.Wrap(err, "failed to parse proposer payloads delivered")
+		}
+	}
+
+	return &api.Response[[]*apiv1.BidTrace]{
+		Data:     data,
+		Metadata: make(map[string]any),
+	}, nil
+}
+
+// RelayProposerPayloadsDeliveredSince obtains all payloads delivered by the relay to proposers
+// from the given slot onwards, paging backwards from the cursor in the options (or the
+// relay's latest delivered payload if no cursor is supplied).
+// Results are in descending slot order.
+func (s *Service) RelayProposerPayloadsDeliveredSince(ctx context.Context,
+	opts *api.RelayProposerPayloadsDeliveredOpts,
+	minSlot phase0.Slot,
+) (
+	*api.Response[[]*apiv1.BidTrace],
+	error,
+) {
+	if opts == nil {
+		return nil, errors.New("no options specified")
+	}
+	if opts.Slot != nil {
+		return nil, errors.New("cannot paginate with a slot")
+	}
+
+	pageOpts := *opts
+	if pageOpts.Limit == 0 {
+		pageOpts.Limit = defaultPageLimit
+	}
+
+	// Pages overlap at their boundary slot, so track the traces already seen.
+	type traceKey struct {
+		slot      phase0.Slot
+		blockHash phase0.Hash32
+	}
+	seen := make(map[traceKey]struct{})
+	res := make([]*apiv1.BidTrace, 0)
+	for {
+		page, err := s.RelayProposerPayloadsDelivered(ctx, &pageOpts)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, trace := range page.Data {
+			if trace.Slot < minSlot {
+				return &api.Response[[]*apiv1.BidTrace]{
+					Data:     res,
+					Metadata: make(map[string]any),
+				}, nil
+			}
+			key := traceKey{slot: trace.Slot, blockHash: trace.BlockHash}
+			if _, exists := seen[key]; exists {
+				continue
+			}
+			seen[key] = struct{}{}
+			res = append(res, trace)
+		}
+
+		cursor, more := RelayNextCursor(page.Data, pageOpts.Limit)
+		if !more {
+			break
+		}
+		if pageOpts.Cursor != nil && cursor >= *pageOpts.Cursor {
+			// The cursor has not decreased.
+			if cursor != *pageOpts.Cursor || page.Data[0].Slot != cursor || cursor == 0 {
+				// The relay is ignoring the cursor, so further requests would not make progress.
+				break
+			}
+			// The page only held traces for the cursor's slot, so move past it.
+			// Any further traces for that slot cannot be obtained.
+			cursor--
+		}
+		if cursor < minSlot {
+			break
+		}
+		pageOpts.Cursor = &cursor
+	}
+
+	return &api.Response[[]*apiv1.BidTrace]{
+		Data:     res,
+		Metadata: make(map[string]any),
+	}, nil
+}
+
+// RelayNextCursor returns the cursor for the page of bid traces following the supplied page,
+// which was requested with the given limit.  It returns false if there are no further pages.
+// The cursor is inclusive, so the following page repeats the traces at the lowest slot of
+// the supplied page; this ensures that traces at that slot which did not fit in the supplied
+// page are not skipped.
+func RelayNextCursor(traces []*apiv1.BidTrace, limit uint64) (phase0.Slot, bool) {
+	if len(traces) == 0 || uint64(len(traces)) < limit {
+		return 0, false
+	}
+
+	return traces[len(traces)-1].Slot, true
+}
@@ -0,0 +1,199 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/builder"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// testBidTraceJSON returns the JSON for a bid trace at the given slot, with a block hash
+// derived from the given index.
+func testBidTraceJSON(slot uint64, index int) string {
+	return fmt.Sprintf(`{"slot":"%d","parent_hash":"0x17f4eeae822cc81533016678413443b95e34517e67f12b4a3a92ff6b66f972ef","block_hash":"0x%064x","builder_pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","proposer_pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","proposer_fee_recipient":"0x58E809C71e4885cB7B3f1D5c793AB04eD239d779","gas_limit":"30000000","gas_used":"12345678","value":"41234567890123456","block_number":"%d","num_tx":"123"}`, slot, index, 18000000+slot)
+}
+
+// newTestRelay starts a relay stand-in that holds a delivered payload for each of the
+// given slots, serving them in descending order with cursor and limit support.  If
+// ignoreCursor is set the relay serves results as if no cursor had been supplied.
+func newTestRelay(t *testing.T, slots []uint64, ignoreCursor bool, requests *[]string) *builder.Service {
+	t.Helper()
+
+	return newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/relay/v1/data/bidtraces/proposer_payload_delivered", r.URL.Path)
+		*requests = append(*requests, r.URL.RawQuery)
+
+		cursor := uint64(1 << 62)
+		if r.URL.Query().Has("cursor") && !ignoreCursor {
+			var err error
+			cursor, err = strconv.ParseUint(r.URL.Query().Get("cursor"), 10, 64)
+			require.NoError(t, err)
+		}
+		limit, err := strconv.ParseUint(r.URL.Query().Get("limit"), 10, 64)
+		require.NoError(t, err)
+
+		traces := make([]string, 0)
+		for i, slot := range slots {
+			if slot <= cursor && uint64(len(traces)) < limit {
+				traces = append(traces, testBidTraceJSON(slot, i))
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte("[" + strings.Join(traces, ",") + "]"))
+	})
+}
+
+func slotsOf(traces []*apiv1.BidTrace) []phase0.Slot {
+	res := make([]phase0.Slot, len(traces))
+	for i := range traces {
+		res[i] = traces[i].Slot
+	}
+
+	return res
+}
+
+func TestRelayProposerPayloadsDelivered(t *testing.T) {
+	ctx := context.Background()
+	slot := phase0.Slot(10)
+
+	var requests []string
+	service := newTestRelay(t, []uint64{12, 11, 10, 8}, false, &requests)
+
+	_, err := service.RelayProposerPayloadsDelivered(ctx, nil)
+	require.EqualError(t, err, "no options specified")
+
+	_, err = service.RelayProposerPayloadsDelivered(ctx, &api.RelayProposerPayloadsDeliveredOpts{Slot: &slot, Cursor: &slot})
+	require.EqualError(t, err, "cannot specify both slot and cursor")
+
+	res, err := service.RelayProposerPayloadsDelivered(ctx, &api.RelayProposerPayloadsDeliveredOpts{
+		Cursor: &slot,
+		Limit:  5,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []phase0.Slot{10, 8}, slotsOf(res.Data))
+	require.Equal(t, "cursor=10&limit=5", requests[len(requests)-1])
+	require.Equal(t, uint64(18000010), res.Data[0].BlockNumber)
+}
+
+func TestRelayProposerPayloadsDeliveredSince(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		slots        []uint64
+		ignoreCursor bool
+		limit        uint64
+		minSlot      phase0.Slot
+		expected     []phase0.Slot
+		requests     []string
+	}{
+		{
+			name:     "SinglePage",
+			slots:    []uint64{5, 4, 3},
+			limit:    10,
+			minSlot:  0,
+			expected: []phase0.Slot{5, 4, 3},
+			requests: []string{"limit=10"},
+		},
+		{
+			name:     "MultiplePages",
+			slots:    []uint64{9, 8, 6, 5, 3, 2},
+			limit:    2,
+			minSlot:  0,
+			expected: []phase0.Slot{9, 8, 6, 5, 3, 2},
+			requests: []string{"limit=2", "cursor=8&limit=2", "cursor=6&limit=2", "cursor=5&limit=2", "cursor=3&limit=2", "cursor=2&limit=2"},
+		},
+		{
+			name:     "MinSlot",
+			slots:    []uint64{9, 8, 6, 5, 3, 2},
+			limit:    2,
+			minSlot:  5,
+			expected: []phase0.Slot{9, 8, 6, 5},
+			requests: []string{"limit=2", "cursor=8&limit=2", "cursor=6&limit=2", "cursor=5&limit=2"},
+		},
+		{
+			name:     "MinSlotMidPage",
+			slots:    []uint64{9, 8, 6, 5, 3, 2},
+			limit:    3,
+			minSlot:  4,
+			expected: []phase0.Slot{9, 8, 6, 5},
+			requests: []string{"limit=3", "cursor=6&limit=3"},
+		},
+		{
+			name:     "SharedSlot",
+			slots:    []uint64{9, 8, 8, 7},
+			limit:    2,
+			expected: []phase0.Slot{9, 8, 8, 7},
+			requests: []string{"limit=2", "cursor=8&limit=2", "cursor=7&limit=2"},
+		},
+		{
+			name:     "FullPageSharedSlot",
+			slots:    []uint64{9, 8, 8, 8, 7},
+			limit:    2,
+			expected: []phase0.Slot{9, 8, 8, 7},
+			requests: []string{"limit=2", "cursor=8&limit=2", "cursor=7&limit=2"},
+		},
+		{
+			name:         "CursorIgnored",
+			slots:        []uint64{9, 8, 6, 5},
+			ignoreCursor: true,
+			limit:        2,
+			expected:     []phase0.Slot{9, 8},
+			requests:     []string{"limit=2", "cursor=8&limit=2"},
+		},
+		{
+			name:     "Empty",
+			limit:    2,
+			expected: []phase0.Slot{},
+			requests: []string{"limit=2"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var requests []string
+			service := newTestRelay(t, test.slots, test.ignoreCursor, &requests)
+			res, err := service.RelayProposerPayloadsDeliveredSince(ctx, &api.RelayProposerPayloadsDeliveredOpts{
+				Limit: test.limit,
+			}, test.minSlot)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, slotsOf(res.Data))
+			require.Equal(t, test.requests, requests)
+		})
+	}
+}
+
+func TestRelayNextCursor(t *testing.T) {
+	traces := []*apiv1.BidTrace{{Slot: 10}, {Slot: 7}}
+
+	cursor, more := builder.RelayNextCursor(traces, 2)
+	require.True(t, more)
+	require.Equal(t, phase0.Slot(7), cursor)
+
+	_, more = builder.RelayNextCursor(traces, 3)
+	require.False(t, more)
+
+	_, more = builder.RelayNextCursor(nil, 2)
+	require.False(t, more)
+}
@@ -0,0 +1,53 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+// RelayValidatorRegistration obtains the latest registration the relay holds for a validator.
+func (s *Service) RelayValidatorRegistration(ctx context.Context,
+	opts *api.RelayValidatorRegistrationOpts,
+) (
+	*api.Response[*apiv1.SignedValidatorRegistration],
+	error,
+) {
+	if opts == nil {
+		return nil, errors.New("no options specified")
+	}
+
+	endpoint := fmt.Sprintf("/relay/v1/data/validator_registration?pubkey=%#x", opts.PubKey)
+	httpResponse, err := s.get(ctx, endpoint, &opts.Common)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request validator registration")
+	}
+
+	var data apiv1.SignedValidatorRegistration
+	if err := json.NewDecoder(bytes.NewReader(httpResponse.body)).Decode(&data); err != nil {
+		return nil, errors.Wrap(err, "failed to parse validator registration")
+	}
+
+	return &api.Response[*apiv1.SignedValidatorRegistration]{
+		Data:     &data,
+		Metadata: make(map[string]any),
+	}, nil
+}
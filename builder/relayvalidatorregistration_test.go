@@ -0,0 +1,45 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelayValidatorRegistration(t *testing.T) {
+	ctx := context.Background()
+	pubKey := phase0.BLSPubKey{0x01, 0x02}
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/relay/v1/data/validator_registration", r.URL.Path)
+		require.Equal(t, fmt.Sprintf("%#x", pubKey), r.URL.Query().Get("pubkey"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"message":{"fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","timestamp":"1700000000","pubkey":"0x010200000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"},"signature":"0x606162636465666768696a6b6c6d6e6f707172737475767778797a7b7c7d7e7f808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e9fa0a1a2a3a4a5a6a7a8a9aaabacadaeafb0b1b2b3b4b5b6b7b8b9babbbcbdbebf"}`))
+	})
+
+	_, err := service.RelayValidatorRegistration(ctx, nil)
+	require.EqualError(t, err, "no options specified")
+
+	res, err := service.RelayValidatorRegistration(ctx, &api.RelayValidatorRegistrationOpts{PubKey: pubKey})
+	require.NoError(t, err)
+	require.Equal(t, pubKey, res.Data.Message.Pubkey)
+	require.Equal(t, uint64(30000000), res.Data.Message.GasLimit)
+}
@@ -0,0 +1,45 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/builder"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestService starts a builder API stand-in with the given handler.
+func newTestService(t *testing.T, handler http.HandlerFunc, params ...builder.Parameter) *builder.Service {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	params = append([]builder.Parameter{
+		builder.WithAddress(server.URL),
+		builder.WithTimeout(5 * time.Second),
+	}, params...)
+	service, err := builder.New(ctx, params...)
+	require.NoError(t, err)
+
+	return service
+}
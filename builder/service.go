@@ -0,0 +1,114 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Service is a builder API client service.
+type Service struct {
+	// log is a service-wide logger.
+	log zerolog.Logger
+
+	base         *url.URL
+	address      string
+	client       *http.Client
+	timeout      time.Duration
+	enforceJSON  bool
+	extraHeaders map[string]string
+}
+
+// New creates a new builder API client service, connecting with a standard HTTP.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "client").Str("impl", "builder").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			DialContext: (&net.Dialer{
+				Timeout:   parameters.timeout,
+				KeepAlive: 30 * time.Second,
+			}).DialContext,
+			MaxIdleConns:        16,
+			MaxConnsPerHost:     16,
+			MaxIdleConnsPerHost: 16,
+			IdleConnTimeout:     600 * time.Second,
+		},
+	}
+
+	address := parameters.address
+	if !strings.HasPrefix(address, "http") {
+		address = fmt.Sprintf("http://%s", address)
+	}
+	if !strings.HasSuffix(address, "/") {
+		address = fmt.Sprintf("%s/", address)
+	}
+	base, err := url.Parse(address)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid URL")
+	}
+
+	s := &Service{
+		log:          log,
+		base:         base,
+		address:      parameters.address,
+		client:       client,
+		timeout:      parameters.timeout,
+		enforceJSON:  parameters.enforceJSON,
+		extraHeaders: parameters.extraHeaders,
+	}
+
+	// Close the service on context done.
+	go func(s *Service) {
+		<-ctx.Done()
+		log.Trace().Msg("Context done; closing connection")
+		s.close()
+	}(s)
+
+	return s, nil
+}
+
+// Name provides the name of the service.
+func (*Service) Name() string {
+	return "Builder (HTTP)"
+}
+
+// Address provides the address for the connection.
+func (s *Service) Address() string {
+	return s.address
+}
+
+// close closes the service, freeing up resources.
+func (s *Service) close() {
+	s.client.CloseIdleConnections()
+}
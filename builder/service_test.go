@@ -0,0 +1,95 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/builder"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tests := []struct {
+		name       string
+		parameters []builder.Parameter
+		err        string
+	}{
+		{
+			name: "Nil",
+			err:  "problem with parameters: no address specified",
+		},
+		{
+			name: "TimeoutZero",
+			parameters: []builder.Parameter{
+				builder.WithAddress("localhost:18550"),
+				builder.WithTimeout(0),
+			},
+			err: "problem with parameters: no timeout specified",
+		},
+		{
+			name: "AddressInvalid",
+			parameters: []builder.Parameter{
+				builder.WithAddress(string([]byte{0x01})),
+				builder.WithTimeout(5 * time.Second),
+			},
+			err: `invalid URL: parse "http://\x01/": net/url: invalid control character in URL`,
+		},
+		{
+			name: "Good",
+			parameters: []builder.Parameter{
+				builder.WithAddress("localhost:18550"),
+				builder.WithTimeout(5 * time.Second),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service, err := builder.New(ctx, test.parameters...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "Builder (HTTP)", service.Name())
+				require.Equal(t, "localhost:18550", service.Address())
+			}
+		})
+	}
+}
+
+func TestStatus(t *testing.T) {
+	ctx := context.Background()
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/eth/v1/builder/status", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+	})
+	require.NoError(t, service.Status(ctx))
+
+	service = newTestService(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	err := service.Status(ctx)
+	var apiErr *api.Error
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusServiceUnavailable, apiErr.StatusCode)
+}
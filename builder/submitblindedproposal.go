@@ -0,0 +1,205 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/pkg/errors"
+)
+
+// SubmitBlindedProposal submits a signed blinded proposal to the builder, returning
+// the unblinded execution payload.
+func (s *Service) SubmitBlindedProposal(ctx context.Context,
+	proposal *api.VersionedSignedBlindedProposal,
+) (
+	*api.Response[*api.VersionedSubmitBlindedBlockResponse],
+	error,
+) {
+	if proposal == nil {
+		return nil, errors.New("no proposal supplied")
+	}
+
+	endpoint := "/eth/v1/builder/blinded_blocks"
+	headers := map[string]string{
+		"Eth-Consensus-Version": strings.ToLower(proposal.Version.String()),
+	}
+
+	var httpResponse *httpResponse
+	var err error
+	if !s.enforceJSON {
+		var body []byte
+		body, err = blindedProposalSSZ(proposal)
+		if err != nil {
+			return nil, err
+		}
+		httpResponse, err = s.post(ctx, endpoint, body, mediaTypeSSZ, headers)
+		if err != nil {
+			var apiErr *api.Error
+			if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnsupportedMediaType {
+				return nil, errors.Wrap(err, "failed to submit blinded proposal")
+			}
+			// Builder does not accept SSZ; fall back to JSON.
+		}
+	}
+	if httpResponse == nil {
+		var body []byte
+		body, err = blindedProposalJSON(proposal)
+		if err != nil {
+			return nil, err
+		}
+		httpResponse, err = s.post(ctx, endpoint, body, mediaTypeJSON, headers)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to submit blinded proposal")
+		}
+	}
+
+	switch httpResponse.contentType {
+	case mediaTypeSSZ:
+		if httpResponse.consensusVersion == spec.DataVersionUnknown {
+			// Not all builders return the version; it must match that of the proposal.
+			httpResponse.consensusVersion = proposal.Version
+		}
+
+		return unblindedPayloadFromSSZ(httpResponse)
+	case mediaTypeJSON:
+		return unblindedPayloadFromJSON(httpResponse)
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", httpResponse.contentType)
+	}
+}
+
+func unblindedPayloadFromSSZ(res *httpResponse) (*api.Response[*api.VersionedSubmitBlindedBlockResponse], error) {
+	response := &api.Response[*api.VersionedSubmitBlindedBlockResponse]{
+		Data: &api.VersionedSubmitBlindedBlockResponse{
+			Version: res.consensusVersion,
+		},
+		Metadata: map[string]any{
+			"version": res.consensusVersion,
+		},
+	}
+
+	var err error
+	switch res.consensusVersion {
+	case spec.DataVersionBellatrix:
+		response.Data.Bellatrix = &bellatrix.ExecutionPayload{}
+		err = response.Data.Bellatrix.UnmarshalSSZ(res.body)
+	case spec.DataVersionCapella:
+		response.Data.Capella = &capella.ExecutionPayload{}
+		err = response.Data.Capella.UnmarshalSSZ(res.body)
+	case spec.DataVersionDeneb:
+		response.Data.Deneb = &apiv1deneb.ExecutionPayloadAndBlobsBundle{}
+		err = response.Data.Deneb.UnmarshalSSZ(res.body)
+	case spec.DataVersionElectra:
+		response.Data.Electra = &apiv1electra.ExecutionPayloadAndBlobsBundle{}
+		err = response.Data.Electra.UnmarshalSSZ(res.body)
+	default:
+		return nil, fmt.Errorf("unhandled payload version %s", res.consensusVersion)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode unblinded payload")
+	}
+
+	return response, nil
+}
+
+func unblindedPayloadFromJSON(res *httpResponse) (*api.Response[*api.VersionedSubmitBlindedBlockResponse], error) {
+	var versioned versionedJSON
+	if err := json.NewDecoder(bytes.NewReader(res.body)).Decode(&versioned); err != nil {
+		return nil, errors.Wrap(err, "failed to parse unblinded payload")
+	}
+
+	response := &api.Response[*api.VersionedSubmitBlindedBlockResponse]{
+		Data: &api.VersionedSubmitBlindedBlockResponse{
+			Version: versioned.Version,
+		},
+		Metadata: map[string]any{
+			"version": versioned.Version,
+		},
+	}
+
+	var err error
+	switch versioned.Version {
+	case spec.DataVersionBellatrix:
+		err = json.Unmarshal(versioned.Data, &response.Data.Bellatrix)
+	case spec.DataVersionCapella:
+		err = json.Unmarshal(versioned.Data, &response.Data.Capella)
+	case spec.DataVersionDeneb:
+		err = json.Unmarshal(versioned.Data, &response.Data.Deneb)
+	case spec.DataVersionElectra:
+		err = json.Unmarshal(versioned.Data, &response.Data.Electra)
+	default:
+		return nil, fmt.Errorf("unhandled payload version %s", versioned.Version)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode unblinded payload")
+	}
+
+	return response, nil
+}
+
+func blindedProposalSSZ(proposal *api.VersionedSignedBlindedProposal) ([]byte, error) {
+	var data []byte
+	var err error
+	switch proposal.Version {
+	case spec.DataVersionBellatrix:
+		data, err = proposal.Bellatrix.MarshalSSZ()
+	case spec.DataVersionCapella:
+		data, err = proposal.Capella.MarshalSSZ()
+	case spec.DataVersionDeneb:
+		data, err = proposal.Deneb.MarshalSSZ()
+	case spec.DataVersionElectra:
+		data, err = proposal.Electra.MarshalSSZ()
+	default:
+		err = fmt.Errorf("unhandled proposal version %s", proposal.Version)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal SSZ")
+	}
+
+	return data, nil
+}
+
+func blindedProposalJSON(proposal *api.VersionedSignedBlindedProposal) ([]byte, error) {
+	var data []byte
+	var err error
+	switch proposal.Version {
+	case spec.DataVersionBellatrix:
+		data, err = json.Marshal(proposal.Bellatrix)
+	case spec.DataVersionCapella:
+		data, err = json.Marshal(proposal.Capella)
+	case spec.DataVersionDeneb:
+		data, err = json.Marshal(proposal.Deneb)
+	case spec.DataVersionElectra:
+		data, err = json.Marshal(proposal.Electra)
+	default:
+		err = fmt.Errorf("unhandled proposal version %s", proposal.Version)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal JSON")
+	}
+
+	return data, nil
+}
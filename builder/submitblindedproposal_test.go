@@ -0,0 +1,151 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	"github.com/attestantio/go-eth2-client/builder"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/stretchr/testify/require"
+)
+
+const testExecutionPayload = `{"parent_hash":"0x17f4eeae822cc81533016678413443b95e34517e67f12b4a3a92ff6b66f972ef","fee_recipient":"0x58E809C71e4885cB7B3f1D5c793AB04eD239d779","state_root":"0x3d6e230e6eceb8f3db582777b1500b8b31b9d268339e7b32bba8d6f1311b211d","receipts_root":"0xea760203509bdde017a506b12c825976d12b04db7bce9eca9e1ed007056a3f36","logs_bloom":"0x0c803a8d3c6642adee3185bd914c599317d96487831dabda82461f65700b2528781bdadf785664f9d8b11c4ee1139dfeb056125d2abd67e379cabc6d58f1c3ea304b97cf17fcd8a4c53f4dedeaa041acce062fc8fbc88ffc111577db4a936378749f2fd82b4bfcb880821dd5cbefee984bc1ad116096a64a44a2aac8a1791a7ad3a53d91c584ac69a8973daed6daee4432a198c9935fa0e5c2a4a6ca78b821a5b046e571a5c0961f469d40e429066755fec611afe25b560db07f989933556ce0cea4070ca47677b007b4b9857fc092625f82c84526737dc98e173e34fe6e4d0f1a400fd994298b7c2fa8187331c333c415f0499836ff0eed5c762bf570e67b44","prev_randao":"0x76ff751467270668df463600d26dba58297a986e649bac84ea856712d4779c00","block_number":"2983837628677007840","gas_limit":"6738255228996962210","gas_used":"5573520557770513197","timestamp":"1744720080366521389","extra_data":"0xc648","base_fee_per_gas":"88770397543877639215846057887940126737648744594802753726778414602657613619599","block_hash":"0x42c294e902bfc9884c1ce5fef156d4661bb8f0ff488bface37f18c3e7be64b0f","transactions":["0x101b883470f1cb7e0a74561be59e08a6eff6aedd3408c190fd359f0bfb628d2461354b7fe4fdad4b8e72b8775cd44e339ad6b5f22a8f53c418bda2b01200a07f1fe3d010bbb96f5ca0d4919192370c15bc46ed455c797b1b11154be359638f9e487121182fae03a7d26012ed7c85b64a63aa5d56a98ac589f9950a9f5bf1a42c1eea245a98f2f4e743c5f8eac1584893104853dc1b5576826156b371a50c59bcb238d0794a185dc0816dbd8c10a0b0e1b8fbe01c4e8dd719f1e4e9b2ded8613f87f1d01e3ea28e9311d135301b2d1260e4811789e1ecbc33e573346f4da94f4c272e21e23b1d414706429c7f0b40c3de243894349c4a59ece791e5fd086897aef81fc23e4d55bb52b28174c3fa9f2c44d370fbbac1dee561f120560c3dda34a731d4618fd22d595b725efa87bb62f8f93bd7d906c4782a647e2cb14ed293e58bc793852058ab5e6f3df76b30e99102b82c8e7d005e1b675fc74b95032616c590ff08da710dd085be570cc0b2c13891625b44b5b3e1846606c39ad39bb72b","0x4b5edff58cf95969ead0470ab897da6c9d69b517e07fd4ed8fa48c14284a5a060ecb745f66cf1be55fb4905d62d8d376865f7d2d1816844fc4719f5d79ab905474d00f62aed6692e5a93be1b32740a8083fc2a61b0e1fc13ad409410d37f3cb0a5275c966abab015047c15251cd301cd31a7b2a0502f7f953e672d61606616b16d5117163064fb33d97eb566f7fce5f01d3833343c1c97e6221f9f0798415f3a4b87fd472e53a24c1a101e1dd55c8f65c2c0f4ccc4b46a133fda49db5dba96631b4cfd1a05662e42a8e15a26d3148a70be305c85f87dae4217fb91498c4098b946a9042355968b765e2e62bb0cf26d59e534c3af8795fa0f4a44ed0d39d258acd934c3416e4d4a738eaa473526d99bee037765d5f6034c830eb766ef067a1468630fbb65b7c5a862017fe84d4d1961f90c37f18a4bd2509fe2e96cb1e26971900e20295c8a9e9ed77b348d4509a8425090318be5c9d2bcda36bdfabb71bfb36755794f78c877df2825bd736a358933af77eae6edf701ed7ef168f57f677df3445d89c5eefc783184eadd3886fcfd75f5f142bc10904a019acdf7861caa7e0fba3e7831b0a549a56c0f174e80cffb8992346ddf7ce4eeff9e335531df3dff57d5f539bc0d3eac57f70a8f973e0864c87b25c3e0bea72e05eda8a120178186dfbfaa9a00f904f23a","0x452af990975c3bcafee7bde4738fdf32b8479be0e9e30b11b0ccbf31a6f884f4098e47759b7a3eb7d7bd0cfa2510dd654b28d664696aca987f55c7bfe73be1cc70a768cdb2594a13a763dbb991186b8b8b2e913857aadc08f940239d03a0b181ae849d557da5b54bfc966231690bb4660e083cdae28caf8ed33e3f66672772ea827253421bade013af57a290a915dbc777f2afccd9cb29e260ecc5ea54cd3a1e25cf66f2937f8061b3ba6b1ebf3129568f16e3dd04d5c50992cc348f3e615af346dbf2c144aeb19932dcfbff0221fae0ed9706b53245176630d011b9cd2d8630848ab1196cf9a3cc0d94392df4295be246e0ac24545c2715a40dcbc57aabffd0a86acec362affaf1bafc5c75b7ca28698a1ac14ea2c8def8ac1a32d3bf65b98aac7d0cb6fd93e5ff16274ad6d0eedf773694f29fc7a234deebc893e4cea4a5483d876e4f35019d6a62f1c407739b68b7a9f5408b4fb854534344fbffe3239feb17c0e7ac269b447bc6246579e1208b6904751eeacb985cbd43bb7792de0b428f1476301c479a3922f61f650c8298fb0b7584b52c7bfcf4dfe51335ab68d571c5815fc78d772346b0b13dbbb8906f076a0452e7e7a414e005dc37cfee85810eccab5999e2d43e13709bf66e8a8936a4283885b158115050789d3b4d9c8dc8026ac720069d78d47dd5e183032f9c53d4c57640fcd6207118d9738f00cd5ddf587f3a7c401d923aa2fb08dba1768728001abc3436cc2b5cf978b558ae58a0578344e7464cd00e719135c70244e2faf1264571a8999789c26f401753e429a1135f18906ebef19e122489622738724a6424cad363bed43304c1285c8da4824fec75d7c51b0a34070d8b976e8e8c8fd50908a7e440092dddd970fd55793e2a4446342bd3daf5b96220977d8f0c","0x0877448694993717a89c57b63640612ac4b0258cb5fdda4a311650c631c35c7313b6d2a094fdb207857d94500c37ab20ea0aa54af951fb04584a37b857981c6d13922e95cfecb70b69ab9a57ee6c13ccf8aa38c52de008ec16d9090aa4bf15db2f4afcdbb1bf4920efe5a1aeeff2c949d43460d67837af87bcdffd9e972340cb40de6d87fa11d83bbfb29e97ef2509097e8dec69a1318132a5dd7d95c1c1e13cc85d37a33c9f7d52379b4a47bf889903c8f3ebd2800526d0916e1aad00e02b682e55bc2865c3ff4ce0cc6aad1bd7d8e2901ea53f3a5e2c025dbb9a00f0ce88583c1dbd3d491ed04ba8260dc06fdb8a9162e022c75e9f057da0abed537b34214df234e1f8b26e7374cfa5470272ef03f7b41f7ed067c4c6011c8a17b2e65340e36af81ecb86420755fe5a0413495e16fabee3f9e5524ab7b12a3cffe20b1df7be32434d7da3fb1f3e9b16f42a4f550501120036b193701ac9eb6f760c2da70e3175a66b10463e43c0442a56217ca7cd25fdb46f3eff28cf1bdfe1b7eb3bf9e85ad8ffde207529c9bb1094dbae4db04a4bff6571c985bd629cdc2b78f739eca6694f32fded6944202859277740267d5dc4c1f74ddd1401c6d514ce23b885723c4618789b5c2ddeffec2179be8dec1347ca4ebed5e8bb10d7d17d41c9709a978fc6189f0c3d49d1b7f41bf8f1dc112f2ece84b6c6a687f44b95e62d274f89fa07bd0d3fdd3ee2a97233e363329ef7096ae5a45b2982859e983f5d989a928e9b88579308ece2391dbde378b81a54d38b3f81225d59f8bb511ba7eb590154ceb8258b804b6da98b3af6f395f5b3f1d12f5fd3c29ef54f31ccea0a36ec2daec0a87030daba8d079093ddde17871c4aa1a7dc3dbd4d760be2152dc250ca2bba34a55daf257b9e3704c3ee244081524cb1ae7c22a0d22f1c65b88b1e534ea1cb8f75cea4a7c03d6786f85327876da72dff1d4d049b51ecc10124279a0cbc151e76ddd475cf3dfeede59a902f4c7145786b5993c8bf8016265ec36298b27d0c6a21c7484fc01a8f14c8c287d14ab86789e34699fdb57f6c43486f0fd9013f2f2c62c60b75b1dc3e4d38a6f7c06e7029f874a204b059d834ffb44c99f843ae33ed0950","0x259310d5134d22e0ef42c3686b2adadc6cd1ae7d7836ac71a69d8ba2d02d0152c320610c12c57cba182c5d1e21198e787b21d0c522106aa8243ec994c4ea0b7959a3269d13566f3d0a3eb5ed276d9e22b33fc12e26cafde04b24ec0fd90455dc26d30a9fc25588b762681ca69aecc19e7971dd4cd063d4e31ee99f3c82015ed9a70e58b9d7cd9a8de38eba90ceffc629e7d6c06e4f2fe9cd45bae557652fe58cc9be54de9a994bf14c3bce787a106778416fbe966e95e51a35ba78d3dc4e4f7551e2791af00d50362493697d55ea6718ba2f089eda330e26100fb5adbb939afaf74982795414422712e8560cec372eaf1a56b50ba4e00e42b145537e94e88eddd7d200d0153edb6dcc12eb298666b0aef9ea495fccfda06b7affe7227bceb41d9ac9dd150df8642cdb11df5ab92b69629b6a5ccdc7ba92b6cf12172217057d291b2fdc6f104e86617be1b7fffeb36af59a018f50c055e24ef9b6daff839083bc9dfeae9101f6aff49f808f603834802d160283cd71b275bf97eb49f5612215cc8fe93875","0xfa082adb51ff0dca75ff57ba7852d794284db5b8d498002a821e5fc2c57a27cc1fe591f12860895b0057bc75ecbff9824bb46e2af06a785b6adfa9e32f49d6235776c3bcace18b330cec1126ac2bb5f3679339037817eab536fed29fe5c62ef790cb74893f1524280b0111e24fc0172130d00a88361e63511eb56a96e552d02c4944544c193189a152844ca49cadae38b7424426a74d61763716a068ba5ca9f3bdc2e0e9b644f1f2e02596bd3f446bb9f13dfb18ad2c9a2ba97771bb994f801affe2e8dda8d638e366c5b8263cc891a8a35e52c79f0856bfeaf0719bd1bfb54acc467783b6c08e55491d39b20f218342991381c38d357a4659baf8d44ddf5045d7f116bcc55f78c28a0dc100d06dff44e639b8e7adf967816c03de54eccdf9f6402a2806889d2cd980f34e5197771f13f1fa6b8c7c732031664bb675ff12d642ae62459e92b1cc28c62349e1636850b8a3ff411e1f14b097f7fa3b23eaa173d17a13f0703eecd7358aa623057325eed381a2ba13c50a13a3d8adaad0295960d925709d6e7cb101e894d9ae8db3dcb82a45570b6e02ab68aa4ea94f8779a9c45a5599","0x71ea3e9ec7ac8a144753ec38e78401b14b489a79266dd0527a4505adca584fe7406d9f7f05ef46d262384fbf1c0607f745a40681d4855aa34c37375b2cf7c99d46b6ae4e3aa9ee209782dce9d167bbff79686e59426a0513b951818ee02a9ea5ed8bcd0f991f46eee44c6e82fc6d07823a9f44f2fd7bfa0e250d6699cf7ad2577eb9eaf0dd9b1595cf018383c3d45956e508fa982bbb7744522a03bac5cba22f58a41801f579434126f5b866dcc6ca0454e9be1c7f2f6649254f3cc09142068f412d5d454b4e4d5b54e459719550f2df1901a18467d9d5297ed4a9eedb9f402f2bfd4bd2e50ab697ea5bef5e7e8082650b823635a4f0f55c18712d0f4d365824c79827d454425aec0b4ea6561ce3f1c5411ab4dff26e2412791cdaa28bf6c8fa53af412828c599d7876508f78f2c82ee67e8357947c6848af143fc5d20409049925cd1b194244466711ce7c34a72a165392564b96e280406a95da927d14ebbb6b999ef446d5ad49881c219dac8ad02d994e059569e91b84f211a4c39a3fbd594a8c835aa5976e0c8899d81a5abaa14301662b9e14fb96ea89862ad898cd7d77bcd2547f0f40471f86d4a4f26e4274f2e95fd9b2b604de867be95630b1ea6ce45ba79acc81b986605e46acda208bd3302ffcc6e83f91bff8362b3f9641ca0227d8b31341e320003","0x2b2acf1e7e043b2c38ea1750a6c31f174b0a8b00137ec3eea24ed9fdf979bf04ed923a2cf5cc05a9acf697d27ccdb2a903a4846729cd9b2eead414da983d4f5bfd0ff4f2e7eb75988e58c3a635c271","0xce0c3b31143f1d68987deaac86a1e079deb07b3d2d497de5ebe8d94486e9a7b300c691621a68b3af4fee781c7c05a931123f910054d096c2e154950d32a26c38edb70dda50a242be4d15ce60c265767b141011cd84aa585c3af798fc1eb5ea63e93e0a426c3e1468f402f7e64f20281a4bd73cc1234174f1762a9a41989f570997036c885b1fdbf9c8153a1d19afb6526a123ca6a2fe6e98c6009f8439f6b6eea881453cc58ef344338ffd04783ce9c28c2e373812a65157643f679ed99ab35f4024e6ee31877e536b03c38616fccc993365143ddaffce39c805b391674070e993c6464156043a84266860c769de218bb5f5698f4c7a9b74142535cbcc08d5b3f747cbf6a7dfbb6c7b0ccc58af4886bc441558496e9c84d80660117777f01cdb84c0a2d3b0f2d8a6eacff5a0e55bd1be6387c3793ae8f5231c59697ae914894a49b3ae13a3a124cbf6cea33b7eb575cf6cd13e6073ddf4d033a3f87366c27089afc03707ef9a44c828388733d70f09bc08de574d07cf193f0a26a08ce8253bfdb26d5306632012c6dae194783deb31b72ac35bbd57f07f1667746d85f5d5b2132b885f8cb0206949780f9406307396dc7ece7938225fc5a55c65ed3608c27f668ba9b08875979e249655d15a4c3d77b1433ab26f56d80e2ca9178d88501ee682a14d07b17635e9968da2d98aa9eb4a5ae639e42eed0c7735313d3308fd079589d5cb2dd381a9298f67325976a6dcd3e38f836e4bc3106885f6348c57c90beadd7cbca17570b90130aaadc7eb0ed2bfb97c5c8a791d0b6b2736d43b0f444909449e6ea32ba706ddfe28c407a85e82e3f22064696d2fd7b37b33e01e0016ad91047f95f0cf5c5d6abc8fd7698c4155c290a1a1db842e77e656c69ad8bc06cea9e00dadbec886ca64af5fe3045ebc8c549bf23e71c634f02da8b250618c169a54e4af45d799f2de6747bde01395a13f1a605e9be5","0x9ced04a51e77ed0730f3420571164ea5247a670b962ebf6b453660748ca5"],"withdrawals":[{"index":"2","validator_index":"3","address":"0x000102030405060708090a0b0c0d0e0f10111213","amount":"1000000000000000000"}]}`
+
+const testElectraExecutionPayloadAndBlobsBundle = `{"execution_payload":{"parent_hash":"0x17f4eeae822cc81533016678413443b95e34517e67f12b4a3a92ff6b66f972ef","fee_recipient":"0x58E809C71e4885cB7B3f1D5c793AB04eD239d779","state_root":"0x3d6e230e6eceb8f3db582777b1500b8b31b9d268339e7b32bba8d6f1311b211d","receipts_root":"0xea760203509bdde017a506b12c825976d12b04db7bce9eca9e1ed007056a3f36","logs_bloom":"0x0c803a8d3c6642adee3185bd914c599317d96487831dabda82461f65700b2528781bdadf785664f9d8b11c4ee1139dfeb056125d2abd67e379cabc6d58f1c3ea304b97cf17fcd8a4c53f4dedeaa041acce062fc8fbc88ffc111577db4a936378749f2fd82b4bfcb880821dd5cbefee984bc1ad116096a64a44a2aac8a1791a7ad3a53d91c584ac69a8973daed6daee4432a198c9935fa0e5c2a4a6ca78b821a5b046e571a5c0961f469d40e429066755fec611afe25b560db07f989933556ce0cea4070ca47677b007b4b9857fc092625f82c84526737dc98e173e34fe6e4d0f1a400fd994298b7c2fa8187331c333c415f0499836ff0eed5c762bf570e67b44","prev_randao":"0x76ff751467270668df463600d26dba58297a986e649bac84ea856712d4779c00","block_number":"2983837628677007840","gas_limit":"6738255228996962210","gas_used":"5573520557770513197","timestamp":"1744720080366521389","extra_data":"0xc648","base_fee_per_gas":"88770397543877639215846057887940126737648744594802753726778414602657613619599","block_hash":"0x42c294e902bfc9884c1ce5fef156d4661bb8f0ff488bface37f18c3e7be64b0f","transactions":["0x02f878831469668303f51d843b9ac9f9843b9aca0082520894c93269b73096998db66be0441e836d873535cb9c8894a19041886f000080c001a031cc29234036afbf9a1fb9476b463367cb1f957ac0b919b69bbc798436e604aaa018c4e9c3914eb27aadd0b91e10b18655739fcf8c1fc398763a9f1beecb8ddc86"],"withdrawals":[{"index":"2","validator_index":"3","address":"0x000102030405060708090a0b0c0d0e0f10111213","amount":"1000000000000000000"}],"blob_gas_used":"4438756708366371443","excess_blob_gas":"12504111653614393862","deposit_requests":[],"withdrawal_requests":[{"source_address":"0x58E809C71e4885cB7B3f1D5c793AB04eD239d779","validator_pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","amount":"1000000000"}],"consolidation_requests":[]},"blobs_bundle":{"commitments":["0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f"],"proofs":["0x303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f"],"blobs":[]}}`
+
+func TestSubmitBlindedProposal(t *testing.T) {
+	ctx := context.Background()
+
+	block := &apiv1capella.SignedBlindedBeaconBlock{}
+	require.NoError(t, json.Unmarshal([]byte(`{"message":{"slot":"348241","proposer_index":"35822","parent_root":"0x15bd2273ad32344e34f842fc77ad8acb2a2eaedafa6e5328ef799babfe81113d","state_root":"0x4f6e0857501da4ab1d72f0c122869e1c084e16daa96613b64914aada28d0dc28","body":{"randao_reveal":"0xb2b7d2e89bb4a4aa6a377972651bb9041cb59af8eedd19568d699fc0866189d3fd78cc93c0e63877b7e2bd6d34d1597c0afd4508aa99b6e882c2cb1ac6f424adba29afd46d1737124300ad72177715fcce8584dd25a06c45bfe9a8ccabd6175d","eth1_data":{"deposit_root":"0x704964a5ad034a440f4f29ff1875986db66adbca45dc0014e439349c7e10194f","deposit_count":"4933","block_hash":"0x9c69de2814a7c3e3751654511372937627dacc3187bf457892789f3f5533c794"},"graffiti":"0x74656b752d626573750000000000000000000000000000000000000000000000","proposer_slashings":[],"attester_slashings":[],"attestations":[{"aggregation_bits":"0xffffffffffffffffffffffffffffff5fff","data":{"slot":"348240","index":"7","beacon_block_root":"0x15bd2273ad32344e34f842fc77ad8acb2a2eaedafa6e5328ef799babfe81113d","source":{"epoch":"10881","root":"0x12a21e7bb91e09dac76d5d3f170db6358785032f10b9130a1e92e6f4409f2ecf"},"target":{"epoch":"10882","root":"0x1c8a9a3a0d4c9d72a93b9ff2ea442a986f4d6dfde52953e48a146206393e7708"}},"signature":"0x92da7bf78eb364219f85af2388d7ac7ddbea1934786d75875486ec9fceb310eee131dcfea131bdf4593d3c431b31b2900bb48ebb7ab02e17524d86a4e132883246df8ce427e935dd9e20c422cdf8eb135b3cc45b86fe4c2f592fb4899eb22f7c"},{"aggregation_bits":"0xffdffffffffffff5fffffffffffffffffd","data":{"slot":"348240","index":"3","beacon_block_root":"0x15bd2273ad32344e34f842fc77ad8acb2a2eaedafa6e5328ef799babfe81113d","source":{"epoch":"10881","root":"0x12a21e7bb91e09dac76d5d3f170db6358785032f10b9130a1e92e6f4409f2ecf"},"target":{"epoch":"10882","root":"0x1c8a9a3a0d4c9d72a93b9ff2ea442a986f4d6dfde52953e48a146206393e7708"}},"signature":"0x88cce1f9fbf651e52a6ab71dea2f4025702021744aac90fb2997f82bac6c192e295ae39b2a430546cb102bf8b68f687e0f40a5179bc293e1424e37d694ef1ad6b3b8de72a0e7fbbe97aeafe6f47e949d415381fbbb090e3135224d5b324eefcb"},{"aggregation_bits":"0xffffffffffefffffffffbfffff7fffdfff","data":{"slot":"348240","index":"11","beacon_block_root":"0x15bd2273ad32344e34f842fc77ad8acb2a2eaedafa6e5328ef799babfe81113d","source":{"epoch":"10881","root":"0x12a21e7bb91e09dac76d5d3f170db6358785032f10b9130a1e92e6f4409f2ecf"},"target":{"epoch":"10882","root":"0x1c8a9a3a0d4c9d72a93b9ff2ea442a986f4d6dfde52953e48a146206393e7708"}},"signature":"0x82186d946dfde2ab3b5fcf5bd858fadeec7fa9729f28527e209d16a1d9b4d635558cad6f8de8cee12caa2a4fc5459fb911ca17cbbecfd22e83c82e244ad7a8c8c849a1e03ee88bf0d338c633c2acfefd142574897cd78f9076b69f6e370e3751"},{"aggregation_bits":"0xfffdffffffffffffffffffffffffffffff","data":{"slot":"348240","index":"4","beacon_block_root":"0x15bd2273ad32344e34f842fc77ad8acb2a2eaedafa6e5328ef799babfe81113d","source":{"epoch":"10881","root":"0x12a21e7bb91e09dac76d5d3f170db6358785032f10b9130a1e92e6f4409f2ecf"},"target":{"epoch":"10882","root":"0x1c8a9a3a0d4c9d72a93b9ff2ea442a986f4d6dfde52953e48a146206393e7708"}},"signature":"0xb9c5ee50f800fe2619d1d0fe46a2fb00a64bcf2613e46a40b579ce6a39c4f6cd71a46790757ccc3df8f5f82e34c77c8d084f525ea8a4bd5bd10190496644be0740ace3d217e43af15229a8023d58e583cfec849fab10169225444f4f4ecc66a8"},{"aggregation_bits":"0xffffffffeffffffffffffdffffffffffff","data":{"slot":"348240","index":"12","beacon_block_root":"0x15bd2273ad32344e34f842fc77ad8acb2a2eaedafa6e5328ef799babfe81113d","source":{"epoch":"10881","root":"0x12a21e7bb91e09dac76d5d3f170db6358785032f10b9130a1e92e6f4409f2ecf"},"target":{"epoch":"10882","root":"0x1c8a9a3a0d4c9d72a93b9ff2ea442a986f4d6dfde52953e48a146206393e7708"}},"signature":"0x91e4ee5c0d6bb54d6856cee653c6859f635cebf9c51bef524d6f439cf6d1c69bea5fcb3e4c067c178cfa4518a76baba909b18189a864f38020f44b2cd5223a11e42d58aaedfa2f004710a72a704357874850842a1493017eca6e473d01395932"},{"aggregation_bits":"0xffffffffffffffffffffffffffffffffff","data":{"slot":"348240","index":"13","beacon_block_root":"0x15bd2273ad32344e34f842fc77ad8acb2a2eaedafa6e5328ef799babfe81113d","source":{"epoch":"10881","root":"0x12a21e7bb91e09dac76d5d3f170db6358785032f10b9130a1e92e6f4409f2ecf"},"target":{"epoch":"10882","root":"0x1c8a9a3a0d4c9d72a93b9ff2ea442a986f4d6dfde52953e48a146206393e7708"}},"signature":"0x8c376c5bb5ada745ba8cb8ce2aae103f4e3f85549ceaacaf312b1fa8e6d2ee5232149a926dcfd58ffa1f50f710eb4edc10943bbd40a601f2fb4d53104a59c0663a397744b59f1fa0744bba49f22afc3bab47045ebb42e61dac41ad44c6bf28f4"},{"aggregation_bits":"0xfffffffffffffffffffffffffffeffffff","data":{"slot":"348240","index":"1","beacon_block_root":"0x15bd2273ad32344e34f842fc77ad8acb2a2eaedafa6e5328ef799babfe81113d","source":{"epoch":"10881","root":"0x12a21e7bb91e09dac76d5d3f170db6358785032f10b9130a1e92e6f4409f2ecf"},"target":{"epoch":"10882","root":"0x1c8a9a3a0d4c9d72a93b9ff2ea442a986f4d6dfde52953e48a146206393e7708"}},"signature":"0xaf11c64ce957f2a1686d12b07d0fbc170d89e48490e326cd73ef761ba042bddc01e48e5fc39953c6113df0a989d75e750d5b9d75155259508c2bbdd53903967f893e24f2f7f751f4a05b0fb1cb2b9084ce8543690a8a623599308d6c190fca4a"},{"aggregation_bits":"0xfffffff5fffffffffdfffbbffffff7ffff","data":{"slot":"348240","index":"6","beacon_block_root":"0x15bd2273ad32344e34f842fc77ad8acb2a2eaedafa6e5328ef799babfe81113d","source":{"epoch":"10881","root":"0x12a21e7bb91e09dac76d5d3f170db6358785032f10b9130a1e92e6f4409f2ecf"},"target":{"epoch":"10882","root":"0x1c8a9a3a0d4c9d72a93b9ff2ea442a986f4d6dfde52953e48a146206393e7708"}},"signature":"0x91075da401796a4341ab9a850ff330c9b0d996ca12b9970ec15a4b40fee652edd043e0c9f9d81529621b3a7970e676f619d7a39af67bf193af4441b5447f199f02d75a26c32181569cddc0a237b7064971539f80811fe40e9362d4d9242404ed"},{"aggregation_bits":"0xfffffffdfffffffffffffdfffffeffffff","data":{"slot":"348240","index":"0","beacon_block_root":"0x15bd2273ad32344e34f842fc77ad8acb2a2eaedafa6e5328ef799babfe81113d","source":{"epoch":"10881","root":"0x12a21e7bb91e09dac76d5d3f170db6358785032f10b9130a1e92e6f4409f2ecf"},"target":{"epoch":"10882","root":"0x1c8a9a3a0d4c9d72a93b9ff2ea442a986f4d6dfde52953e48a146206393e7708"}},"signature":"0xad9aa5aa9b9c022036fbb81a0aca626b19a2ccd7c7ee6efa5b2a454f5ffb5d75d00e5563b31319b3a0ad1e0ef6f512be00fb8c39243004a1133610344473953dfcf06c3bd53f00255de6983927acd8624b0131fe9d8a085062747d70972b4713"},{"aggregation_bits":"0xffffffffffff7fdfffffffffffffffffff","data":{"slot":"348240","index":"2","beacon_block_root":"0x15bd2273ad32344e34f842fc77ad8acb2a2eaedafa6e5328ef799babfe81113d","source":{"epoch":"10881","root":"0x12a21e7bb91e09dac76d5d3f170db6358785032f10b9130a1e92e6f4409f2ecf"},"target":{"epoch":"10882","root":"0x1c8a9a3a0d4c9d72a93b9ff2ea442a986f4d6dfde52953e48a146206393e7708"}},"signature":"0x89dde857bc31a4cc5e71d6cc440c00b2b1ee85b758722aadc5c4da0a939523de7532aabcfef4e80f84094924bb69d80d0a3d702b85859c5fce0433b6d0f7bc302af866ef7a9234a75be7bbd91b32256126808ffdf65ac0ce07a33afbaa16c575"},{"aggregation_bits":"0xffffffffffffffffffffffffffffffffff","data":{"slot":"348240","index":"8","beacon_block_root":"0x15bd2273ad32344e34f842fc77ad8acb2a2eaedafa6e5328ef799babfe81113d","source":{"epoch":"10881","root":"0x12a21e7bb91e09dac76d5d3f170db6358785032f10b9130a1e92e6f4409f2ecf"},"target":{"epoch":"10882","root":"0x1c8a9a3a0d4c9d72a93b9ff2ea442a986f4d6dfde52953e48a146206393e7708"}},"signature":"0x891fbba500eee2cf2f5734d3bf8445e8684376e47469692d44e87fc8a295616d9f29410afc2d6ff2bc649618b33b417e13de4e152099aac054f4d35df4cd79234b6df1edcf2393b7ebc0f2ecf61f4604232b96830e0dbff9311408dad4479667"},{"aggregation_bits":"0xfffffffffffbffffffffefffffffff5fff","data":{"slot":"348240","index":"14","beacon_block_root":"0x15bd2273ad32344e34f842fc77ad8acb2a2eaedafa6e5328ef799babfe81113d","source":{"epoch":"10881","root":"0x12a21e7bb91e09dac76d5d3f170db6358785032f10b9130a1e92e6f4409f2ecf"},"target":{"epoch":"10882","root":"0x1c8a9a3a0d4c9d72a93b9ff2ea442a986f4d6dfde52953e48a146206393e7708"}},"signature":"0xb9ab0354d0d61eb6b5f2184dc3bd0c8416cca74f2c913c6aaca653a87dd2c4b8ba2471aa450e0fa170573637c49dc8920eb84970fea4230d7b3c3c8c8152c782e912b29bc19a6de05dc36c1b44db2f649f31673b4751e1b22f17021833ca9cc8"},{"aggregation_bits":"0xfffffebffffbf7ffeffffbffffffffffff","data":{"slot":"348240","index":"5","beacon_block_root":"0x15bd2273ad32344e34f842fc77ad8acb2a2eaedafa6e5328ef799babfe81113d","source":{"epoch":"10881","root":"0x12a21e7bb91e09dac76d5d3f170db6358785032f10b9130a1e92e6f4409f2ecf"},"target":{"epoch":"10882","root":"0x1c8a9a3a0d4c9d72a93b9ff2ea442a986f4d6dfde52953e48a146206393e7708"}},"signature":"0x8e17aa835e6343f708e73e432b7268741f60565f5bb6ef62b5fba892438ca5474a14c0382609e14624058f3fab120e8902ad3f667cf14418836ce120f3bbf50ea3c15923c881e599227cc3a2758ef9a2cd08bd3b862bd711a875e27477ac347c"},{"aggregation_bits":"0xfffffffffffefffffffffffeffffffffff","data":{"slot":"348240","index":"10","beacon_block_root":"0x15bd2273ad32344e34f842fc77ad8acb2a2eaedafa6e5328ef799babfe81113d","source":{"epoch":"10881","root":"0x12a21e7bb91e09dac76d5d3f170db6358785032f10b9130a1e92e6f4409f2ecf"},"target":{"epoch":"10882","root":"0x1c8a9a3a0d4c9d72a93b9ff2ea442a986f4d6dfde52953e48a146206393e7708"}},"signature":"0xa0909f67a7745300cee6278605e3cb79e5a9564cd6e81ac379b285e0eb6e1849537326b546079d7bf676c8e33a166cad00ab74a396f12c9f4851fb64612f6aeb911db550e0aeae88e1b90831a5a858ae64f9262f67403327d85fcb345df9fca4"},{"aggregation_bits":"0xffffffffffffffffffffffffffffffffff","data":{"slot":"348240","index":"9","beacon_block_root":"0x15bd2273ad32344e34f842fc77ad8acb2a2eaedafa6e5328ef799babfe81113d","source":{"epoch":"10881","root":"0x12a21e7bb91e09dac76d5d3f170db6358785032f10b9130a1e92e6f4409f2ecf"},"target":{"epoch":"10882","root":"0x1c8a9a3a0d4c9d72a93b9ff2ea442a986f4d6dfde52953e48a146206393e7708"}},"signature":"0x886d038ddd7598cfda720dfe1caf83e030e24b207bbc0c97d012fbf5accbaa2f63366f32fe643aa1fdf6c8282480cd51165710bb786d77ecfb72ef7cc9d55e342c94fb57f5a75d50a0d486ecdf014bb08e0195f24202911c86efb5b46b2167ab"}],"deposits":[],"voluntary_exits":[],"sync_aggregate":{"sync_committee_bits":"0xffffffffffffffffffff7edfffffffff7ffffffffffffffffffffffffffff7ffdf7ffffffff7fffffefffffffffffffbfffffffffdffffffffffffffffffffff","sync_committee_signature":"0x877de19f5fff89de5af36954d4c7f7f5c2ccf6f8dc39fe7e3eb87c3357fca26f0af747dffd0f992c8844a20763e9f8a51858d0be70ce610055c6421d340160adec0ddcb706a7d7a5c45edff7b5f9b9a039fce093cea41c7a5699834f9de48b94"},"execution_payload_header":{"parent_hash":"0xa330251430b91a6fb5342f30a1f527dc76499c03a411464235951dbd51b94d9f","fee_recipient":"0xf97e180c050e5Ab072211Ad2C213Eb5AEE4DF134","state_root":"0x079f2cc22a29388fd4fc20f451cbaa3ff39845d68b2c368ff7be314617418e38","receipts_root":"0xed980a4cf6df8ba330c14ed9fe0597ec20515f44e5a9adfd2f7b72aa14890996","logs_bloom":"0x0000000400000008000008000040000000000000000000001000104880000200000004000000400000000204000020002000000000000000000000000022000800000004000000000002000c000000000000000000000100000000000000000000000000000000000000000000000040000000000040000001000014000000010002104000000000000000000000000000000000000000000000000000000080020000000000000000002400000000000001000000000002000200102000000040100002000000000000000000000000000000000000000800000000000000000010000000000000000000000000000000000400002000000000000000200000","prev_randao":"0x86cc02ef030b0c147321a7f94158c1b33cb730f8baac3c59955b983fda3ae39b","block_number":"330714","gas_limit":"30000000","gas_used":"369098","timestamp":"1679442492","extra_data":"0x","base_fee_per_gas":"7","block_hash":"0x4ab1ced57222819bf6a6b6c1456715011585599a1cef18b060eb364811bbb14e","transactions_root":"0x6d47bae3b4963cbde00ec39bbd6442540afe26f8005e73722489904836008bfc","withdrawals_root":"0x5dc5f3ff8bade8e1dd04e5cf56292b2a194a2829e1c8e8b4a627d95e08296ba3"},"bls_to_execution_changes":[]}},"signature":"0x8c3095fd9d3a18e43ceeb7648281e16bb03044839dffea796432c4e5a1372bef22c11a98a31e0c1c5389b98cc6d45917170a0f1634bcf152d896f360dc599fabba2ec4de77898b5dff080fa1628482bdbad5b37d2e64fea3d8721095186cfe50"}`), block))
+	proposal := &api.VersionedSignedBlindedProposal{
+		Version: spec.DataVersionCapella,
+		Capella: block,
+	}
+	blockSSZ, err := block.MarshalSSZ()
+	require.NoError(t, err)
+
+	payload := &capella.ExecutionPayload{}
+	require.NoError(t, json.Unmarshal([]byte(testExecutionPayload), payload))
+	payloadSSZ, err := payload.MarshalSSZ()
+	require.NoError(t, err)
+
+	t.Run("Nil", func(t *testing.T) {
+		service := newTestService(t, func(w http.ResponseWriter, _ *http.Request) {})
+		_, err := service.SubmitBlindedProposal(ctx, nil)
+		require.EqualError(t, err, "no proposal supplied")
+	})
+
+	t.Run("SSZ", func(t *testing.T) {
+		service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "/eth/v1/builder/blinded_blocks", r.URL.Path)
+			require.Equal(t, "capella", r.Header.Get("Eth-Consensus-Version"))
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			require.Equal(t, blockSSZ, body)
+			w.Header().Set("Content-Type", "application/octet-stream")
+			_, _ = w.Write(payloadSSZ)
+		})
+		res, err := service.SubmitBlindedProposal(ctx, proposal)
+		require.NoError(t, err)
+		require.Equal(t, spec.DataVersionCapella, res.Data.Version)
+		require.Equal(t, payload, res.Data.Capella)
+	})
+
+	t.Run("JSONFallback", func(t *testing.T) {
+		service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Content-Type") != "application/json" {
+				w.WriteHeader(http.StatusUnsupportedMediaType)
+
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"version":"capella","data":` + testExecutionPayload + `}`))
+		})
+		res, err := service.SubmitBlindedProposal(ctx, proposal)
+		require.NoError(t, err)
+		require.Equal(t, payload, res.Data.Capella)
+		blockHash, err := res.Data.BlockHash()
+		require.NoError(t, err)
+		require.Equal(t, payload.BlockHash, blockHash)
+	})
+
+	t.Run("EnforceJSON", func(t *testing.T) {
+		service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			require.Equal(t, "application/json", r.Header.Get("Accept"))
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"version":"capella","data":` + testExecutionPayload + `}`))
+		}, builder.WithEnforceJSON(true))
+		res, err := service.SubmitBlindedProposal(ctx, proposal)
+		require.NoError(t, err)
+		require.Equal(t, payload, res.Data.Capella)
+	})
+}
+
+func TestSubmitBlindedProposalElectra(t *testing.T) {
+	ctx := context.Background()
+
+	block := &apiv1electra.SignedBlindedBeaconBlock{}
+	require.NoError(t, json.Unmarshal([]byte(`{"message":{"slot":"348241","proposer_index":"35822","parent_root":"0x15bd2273ad32344e34f842fc77ad8acb2a2eaedafa6e5328ef799babfe81113d","state_root":"0x4f6e0857501da4ab1d72f0c122869e1c084e16daa96613b64914aada28d0dc28","body":{"randao_reveal":"0xb2b7d2e89bb4a4aa6a377972651bb9041cb59af8eedd19568d699fc0866189d3fd78cc93c0e63877b7e2bd6d34d1597c0afd4508aa99b6e882c2cb1ac6f424adba29afd46d1737124300ad72177715fcce8584dd25a06c45bfe9a8ccabd6175d","eth1_data":{"deposit_root":"0x704964a5ad034a440f4f29ff1875986db66adbca45dc0014e439349c7e10194f","deposit_count":"4933","block_hash":"0x9c69de2814a7c3e3751654511372937627dacc3187bf457892789f3f5533c794"},"graffiti":"0x74656b752d626573750000000000000000000000000000000000000000000000","proposer_slashings":[],"attester_slashings":[],"attestations":[{"aggregation_bits":"0xffffffffffffffffffffffffffffff5fff","data":{"slot":"348240","index":"0","beacon_block_root":"0x15bd2273ad32344e34f842fc77ad8acb2a2eaedafa6e5328ef799babfe81113d","source":{"epoch":"10881","root":"0x12a21e7bb91e09dac76d5d3f170db6358785032f10b9130a1e92e6f4409f2ecf"},"target":{"epoch":"10882","root":"0x1c8a9a3a0d4c9d72a93b9ff2ea442a986f4d6dfde52953e48a146206393e7708"}},"signature":"0x92da7bf78eb364219f85af2388d7ac7ddbea1934786d75875486ec9fceb310eee131dcfea131bdf4593d3c431b31b2900bb48ebb7ab02e17524d86a4e132883246df8ce427e935dd9e20c422cdf8eb135b3cc45b86fe4c2f592fb4899eb22f7c","committee_bits":"0x8000000000000000"},{"aggregation_bits":"0xffdffffffffffff5fffffffffffffffffd","data":{"slot":"348240","index":"0","beacon_block_root":"0x15bd2273ad32344e34f842fc77ad8acb2a2eaedafa6e5328ef799babfe81113d","source":{"epoch":"10881","root":"0x12a21e7bb91e09dac76d5d3f170db6358785032f10b9130a1e92e6f4409f2ecf"},"target":{"epoch":"10882","root":"0x1c8a9a3a0d4c9d72a93b9ff2ea442a986f4d6dfde52953e48a146206393e7708"}},"signature":"0x88cce1f9fbf651e52a6ab71dea2f4025702021744aac90fb2997f82bac6c192e295ae39b2a430546cb102bf8b68f687e0f40a5179bc293e1424e37d694ef1ad6b3b8de72a0e7fbbe97aeafe6f47e949d415381fbbb090e3135224d5b324eefcb","committee_bits":"0x0800000000000000"},{"aggregation_bits":"0xffffffffffefffffffffbfffff7fffdfff","data":{"slot":"348240","index":"0","beacon_block_root":"0x15bd2273ad32344e34f842fc77ad8acb2a2eaedafa6e5328ef799babfe81113d","source":{"epoch":"10881","root":"0x12a21e7bb91e09dac76d5d3f170db6358785032f10b9130a1e92e6f4409f2ecf"},"target":{"epoch":"10882","root":"0x1c8a9a3a0d4c9d72a93b9ff2ea442a986f4d6dfde52953e48a146206393e7708"}},"signature":"0x82186d946dfde2ab3b5fcf5bd858fadeec7fa9729f28527e209d16a1d9b4d635558cad6f8de8cee12caa2a4fc5459fb911ca17cbbecfd22e83c82e244ad7a8c8c849a1e03ee88bf0d338c633c2acfefd142574897cd78f9076b69f6e370e3751","committee_bits":"0x0008000000000000"},{"aggregation_bits":"0xfffdffffffffffffffffffffffffffffff","data":{"slot":"348240","index":"0","beacon_block_root":"0x15bd2273ad32344e34f842fc77ad8acb2a2eaedafa6e5328ef799babfe81113d","source":{"epoch":"10881","root":"0x12a21e7bb91e09dac76d5d3f170db6358785032f10b9130a1e92e6f4409f2ecf"},"target":{"epoch":"10882","root":"0x1c8a9a3a0d4c9d72a93b9ff2ea442a986f4d6dfde52953e48a146206393e7708"}},"signature":"0xb9c5ee50f800fe2619d1d0fe46a2fb00a64bcf2613e46a40b579ce6a39c4f6cd71a46790757ccc3df8f5f82e34c77c8d084f525ea8a4bd5bd10190496644be0740ace3d217e43af15229a8023d58e583cfec849fab10169225444f4f4ecc66a8","committee_bits":"0x1000000000000000"},{"aggregation_bits":"0xffffffffeffffffffffffdffffffffffff","data":{"slot":"348240","index":"0","beacon_block_root":"0x15bd2273ad32344e34f842fc77ad8acb2a2eaedafa6e5328ef799babfe81113d","source":{"epoch":"10881","root":"0x12a21e7bb91e09dac76d5d3f170db6358785032f10b9130a1e92e6f4409f2ecf"},"target":{"epoch":"10882","root":"0x1c8a9a3a0d4c9d72a93b9ff2ea442a986f4d6dfde52953e48a146206393e7708"}},"signature":"0x91e4ee5c0d6bb54d6856cee653c6859f635cebf9c51bef524d6f439cf6d1c69bea5fcb3e4c067c178cfa4518a76baba909b18189a864f38020f44b2cd5223a11e42d58aaedfa2f004710a72a704357874850842a1493017eca6e473d01395932","committee_bits":"0x0010000000000000"},{"aggregation_bits":"0xffffffffffffffffffffffffffffffffff","data":{"slot":"348240","index":"0","beacon_block_root":"0x15bd2273ad32344e34f842fc77ad8acb2a2eaedafa6e5328ef799babfe81113d","source":{"epoch":"10881","root":"0x12a21e7bb91e09dac76d5d3f170db6358785032f10b9130a1e92e6f4409f2ecf"},"target":{"epoch":"10882","root":"0x1c8a9a3a0d4c9d72a93b9ff2ea442a986f4d6dfde52953e48a146206393e7708"}},"signature":"0x8c376c5bb5ada745ba8cb8ce2aae103f4e3f85549ceaacaf312b1fa8e6d2ee5232149a926dcfd58ffa1f50f710eb4edc10943bbd40a601f2fb4d53104a59c0663a397744b59f1fa0744bba49f22afc3bab47045ebb42e61dac41ad44c6bf28f4","committee_bits":"0x0020000000000000"},{"aggregation_bits":"0xfffffffffffffffffffffffffffeffffff","data":{"slot":"348240","index":"0","beacon_block_root":"0x15bd2273ad32344e34f842fc77ad8acb2a2eaedafa6e5328ef799babfe81113d","source":{"epoch":"10881","root":"0x12a21e7bb91e09dac76d5d3f170db6358785032f10b9130a1e92e6f4409f2ecf"},"target":{"epoch":"10882","root":"0x1c8a9a3a0d4c9d72a93b9ff2ea442a986f4d6dfde52953e48a146206393e7708"}},"signature":"0xaf11c64ce957f2a1686d12b07d0fbc170d89e48490e326cd73ef761ba042bddc01e48e5fc39953c6113df0a989d75e750d5b9d75155259508c2bbdd53903967f893e24f2f7f751f4a05b0fb1cb2b9084ce8543690a8a623599308d6c190fca4a","committee_bits":"0x0200000000000000"},{"aggregation_bits":"0xfffffff5fffffffffdfffbbffffff7ffff","data":{"slot":"348240","index":"0","beacon_block_root":"0x15bd2273ad32344e34f842fc77ad8acb2a2eaedafa6e5328ef799babfe81113d","source":{"epoch":"10881","root":"0x12a21e7bb91e09dac76d5d3f170db6358785032f10b9130a1e92e6f4409f2ecf"},"target":{"epoch":"10882","root":"0x1c8a9a3a0d4c9d72a93b9ff2ea442a986f4d6dfde52953e48a146206393e7708"}},"signature":"0x91075da401796a4341ab9a850ff330c9b0d996ca12b9970ec15a4b40fee652edd043e0c9f9d81529621b3a7970e676f619d7a39af67bf193af4441b5447f199f02d75a26c32181569cddc0a237b7064971539f80811fe40e9362d4d9242404ed","committee_bits":"0x4000000000000000"}],"deposits":[],"voluntary_exits":[],"sync_aggregate":{"sync_committee_bits":"0xffffffffffffffffffff7edfffffffff7ffffffffffffffffffffffffffff7ffdf7ffffffff7fffffefffffffffffffbfffffffffdffffffffffffffffffffff","sync_committee_signature":"0x877de19f5fff89de5af36954d4c7f7f5c2ccf6f8dc39fe7e3eb87c3357fca26f0af747dffd0f992c8844a20763e9f8a51858d0be70ce610055c6421d340160adec0ddcb706a7d7a5c45edff7b5f9b9a039fce093cea41c7a5699834f9de48b94"},"execution_payload_header":{"parent_hash":"0xa330251430b91a6fb5342f30a1f527dc76499c03a411464235951dbd51b94d9f","fee_recipient":"0xf97e180c050e5Ab072211Ad2C213Eb5AEE4DF134","state_root":"0x079f2cc22a29388fd4fc20f451cbaa3ff39845d68b2c368ff7be314617418e38","receipts_root":"0xed980a4cf6df8ba330c14ed9fe0597ec20515f44e5a9adfd2f7b72aa14890996","logs_bloom":"0x0000000400000008000008000040000000000000000000001000104880000200000004000000400000000204000020002000000000000000000000000022000800000004000000000002000c000000000000000000000100000000000000000000000000000000000000000000000040000000000040000001000014000000010002104000000000000000000000000000000000000000000000000000000080020000000000000000002400000000000001000000000002000200102000000040100002000000000000000000000000000000000000000800000000000000000010000000000000000000000000000000000400002000000000000000200000","prev_randao":"0x86cc02ef030b0c147321a7f94158c1b33cb730f8baac3c59955b983fda3ae39b","block_number":"330714","gas_limit":"30000000","gas_used":"369098","timestamp":"1679442492","extra_data":"0x","base_fee_per_gas":"7","block_hash":"0x4ab1ced57222819bf6a6b6c1456715011585599a1cef18b060eb364811bbb14e","transactions_root":"0x6d47bae3b4963cbde00ec39bbd6442540afe26f8005e73722489904836008bfc","withdrawals_root":"0x5dc5f3ff8bade8e1dd04e5cf56292b2a194a2829e1c8e8b4a627d95e08296ba3","blob_gas_used":"4438756708366371443","excess_blob_gas":"12504111653614393862","deposit_requests_root":"0x1111111111111111111111111111111111111111111111111111111111111111","withdrawal_requests_root":"0x2222222222222222222222222222222222222222222222222222222222222222","consolidation_requests_root":"0x3333333333333333333333333333333333333333333333333333333333333333"},"bls_to_execution_changes":[],"blob_kzg_commitments":["0x95cc5099bbd8420d8ebade383c00a2346dace60a7604f768cd71501757b4d72eeb7d5474a6b615af10379d69aa9f478f","0xae9f2d2217013ef61f995f9074faead9ec24e8048440164ec3d6029b87d43686dd0c97c2df9554fc997d0d66c3a78929"]}},"signature":"0x8c3095fd9d3a18e43ceeb7648281e16bb03044839dffea796432c4e5a1372bef22c11a98a31e0c1c5389b98cc6d45917170a0f1634bcf152d896f360dc599fabba2ec4de77898b5dff080fa1628482bdbad5b37d2e64fea3d8721095186cfe50"}`), block))
+	proposal := &api.VersionedSignedBlindedProposal{
+		Version: spec.DataVersionElectra,
+		Electra: block,
+	}
+	blockSSZ, err := block.MarshalSSZ()
+	require.NoError(t, err)
+
+	payload := &apiv1electra.ExecutionPayloadAndBlobsBundle{}
+	require.NoError(t, json.Unmarshal([]byte(testElectraExecutionPayloadAndBlobsBundle), payload))
+	payloadSSZ, err := payload.MarshalSSZ()
+	require.NoError(t, err)
+
+	t.Run("SSZ", func(t *testing.T) {
+		service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "electra", r.Header.Get("Eth-Consensus-Version"))
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			require.Equal(t, blockSSZ, body)
+			w.Header().Set("Content-Type", "application/octet-stream")
+			_, _ = w.Write(payloadSSZ)
+		})
+		res, err := service.SubmitBlindedProposal(ctx, proposal)
+		require.NoError(t, err)
+		require.Equal(t, spec.DataVersionElectra, res.Data.Version)
+		require.Equal(t, payload, res.Data.Electra)
+	})
+
+	t.Run("EnforceJSON", func(t *testing.T) {
+		service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"version":"electra","data":` + testElectraExecutionPayloadAndBlobsBundle + `}`))
+		}, builder.WithEnforceJSON(true))
+		res, err := service.SubmitBlindedProposal(ctx, proposal)
+		require.NoError(t, err)
+		require.Equal(t, payload, res.Data.Electra)
+		blockHash, err := res.Data.BlockHash()
+		require.NoError(t, err)
+		require.Equal(t, payload.ExecutionPayload.BlockHash, blockHash)
+	})
+}
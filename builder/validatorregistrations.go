@@ -0,0 +1,82 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/pkg/errors"
+)
+
+// SubmitValidatorRegistrations submits validator registrations to the builder.
+func (s *Service) SubmitValidatorRegistrations(ctx context.Context,
+	registrations []*api.VersionedSignedValidatorRegistration,
+) error {
+	if len(registrations) == 0 {
+		return errors.New("no registrations supplied")
+	}
+
+	v1Registrations := make([]*apiv1.SignedValidatorRegistration, len(registrations))
+	for i := range registrations {
+		if registrations[i] == nil {
+			return errors.New("nil registration supplied")
+		}
+		if registrations[i].Version != spec.BuilderVersionV1 {
+			return errors.New("unsupported registration version")
+		}
+		if registrations[i].V1 == nil {
+			return errors.New("registration data missing")
+		}
+		v1Registrations[i] = registrations[i].V1
+	}
+
+	endpoint := "/eth/v1/builder/validators"
+
+	if !s.enforceJSON {
+		// The SSZ encoding of a list of registrations is the concatenation of the
+		// individual encodings, as registrations are fixed size.
+		body := make([]byte, 0, len(v1Registrations)*v1Registrations[0].SizeSSZ())
+		for i := range v1Registrations {
+			var err error
+			body, err = v1Registrations[i].MarshalSSZTo(body)
+			if err != nil {
+				return errors.Wrap(err, "failed to marshal SSZ")
+			}
+		}
+		_, err := s.post(ctx, endpoint, body, mediaTypeSSZ, nil)
+		if err == nil {
+			return nil
+		}
+		var apiErr *api.Error
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnsupportedMediaType {
+			return errors.Wrap(err, "failed to submit validator registrations")
+		}
+		// Builder does not accept SSZ; fall back to JSON.
+	}
+
+	body, err := json.Marshal(v1Registrations)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal JSON")
+	}
+	if _, err := s.post(ctx, endpoint, body, mediaTypeJSON, nil); err != nil {
+		return errors.Wrap(err, "failed to submit validator registrations")
+	}
+
+	return nil
+}
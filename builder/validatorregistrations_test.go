@@ -0,0 +1,82 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/builder"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmitValidatorRegistrations(t *testing.T) {
+	ctx := context.Background()
+
+	registration := &apiv1.SignedValidatorRegistration{}
+	require.NoError(t, json.Unmarshal([]byte(`{"message":{"fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"100","timestamp":"100","pubkey":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f"},"signature":"0x606162636465666768696a6b6c6d6e6f707172737475767778797a7b7c7d7e7f808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e9fa0a1a2a3a4a5a6a7a8a9aaabacadaeafb0b1b2b3b4b5b6b7b8b9babbbcbdbebf"}`), registration))
+	registrations := []*api.VersionedSignedValidatorRegistration{
+		{
+			Version: spec.BuilderVersionV1,
+			V1:      registration,
+		},
+	}
+	sszData, err := registration.MarshalSSZ()
+	require.NoError(t, err)
+
+	t.Run("Empty", func(t *testing.T) {
+		service := newTestService(t, func(w http.ResponseWriter, _ *http.Request) {})
+		require.EqualError(t, service.SubmitValidatorRegistrations(ctx, nil), "no registrations supplied")
+	})
+
+	t.Run("SSZ", func(t *testing.T) {
+		service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "/eth/v1/builder/validators", r.URL.Path)
+			require.Equal(t, "application/octet-stream", r.Header.Get("Content-Type"))
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			require.Equal(t, sszData, body)
+		})
+		require.NoError(t, service.SubmitValidatorRegistrations(ctx, registrations))
+	})
+
+	t.Run("JSONFallback", func(t *testing.T) {
+		var jsonBody []byte
+		service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Content-Type") != "application/json" {
+				w.WriteHeader(http.StatusUnsupportedMediaType)
+
+				return
+			}
+			var err error
+			jsonBody, err = io.ReadAll(r.Body)
+			require.NoError(t, err)
+		})
+		require.NoError(t, service.SubmitValidatorRegistrations(ctx, registrations))
+		require.JSONEq(t, `[{"message":{"fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"100","timestamp":"100","pubkey":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f"},"signature":"0x606162636465666768696a6b6c6d6e6f707172737475767778797a7b7c7d7e7f808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e9fa0a1a2a3a4a5a6a7a8a9aaabacadaeafb0b1b2b3b4b5b6b7b8b9babbbcbdbebf"}]`, string(jsonBody))
+	})
+
+	t.Run("EnforceJSON", func(t *testing.T) {
+		service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		}, builder.WithEnforceJSON(true))
+		require.NoError(t, service.SubmitValidatorRegistrations(ctx, registrations))
+	})
+}
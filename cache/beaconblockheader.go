@@ -0,0 +1,72 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// BeaconBlockHeader provides the block header of a given block ID.
+// The response is shared with other callers, so must not be altered.
+func (s *Service) BeaconBlockHeader(ctx context.Context,
+	opts *api.BeaconBlockHeaderOpts,
+) (
+	*api.Response[*apiv1.BeaconBlockHeader],
+	error,
+) {
+	provider, isProvider := s.client.(consensusclient.BeaconBlockHeadersProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide beacon block headers")
+	}
+	if opts == nil {
+		return provider.BeaconBlockHeader(ctx, opts)
+	}
+
+	return cached(ctx, s, s.store, "beaconblockheader:"+opts.Block, opts.Block,
+		func() (*api.Response[*apiv1.BeaconBlockHeader], error) {
+			return provider.BeaconBlockHeader(ctx, opts)
+		},
+		func(response *api.Response[*apiv1.BeaconBlockHeader]) (phase0.Slot, bool) {
+			if response.Data == nil ||
+				response.Data.Header == nil ||
+				response.Data.Header.Message == nil {
+				return 0, false
+			}
+
+			return response.Data.Header.Message.Slot, true
+		},
+	)
+}
+
+// BeaconBlockHeaders provides the block headers matching the given options.
+// Responses are not cached, as they are selected relative to the head of the chain.
+func (s *Service) BeaconBlockHeaders(ctx context.Context,
+	opts *api.BeaconBlockHeadersOpts,
+) (
+	*api.Response[[]*apiv1.BeaconBlockHeader],
+	error,
+) {
+	provider, isProvider := s.client.(consensusclient.BeaconBlockHeadersListProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide beacon block headers")
+	}
+
+	return provider.BeaconBlockHeaders(ctx, opts)
+}
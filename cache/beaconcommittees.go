@@ -0,0 +1,67 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"fmt"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// BeaconCommittees fetches all beacon committees for the epoch at the given state.
+// The response is shared with other callers, so must not be altered.
+func (s *Service) BeaconCommittees(ctx context.Context,
+	opts *api.BeaconCommitteesOpts,
+) (
+	*api.Response[[]*apiv1.BeaconCommittee],
+	error,
+) {
+	provider, isProvider := s.client.(consensusclient.BeaconCommitteesProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide beacon committees")
+	}
+	if opts == nil {
+		return provider.BeaconCommittees(ctx, opts)
+	}
+
+	key := "beaconcommittees:" + opts.State
+	if opts.Epoch != nil {
+		key = fmt.Sprintf("%s:%d", key, *opts.Epoch)
+	}
+
+	return cached(ctx, s, s.store, key, opts.State,
+		func() (*api.Response[[]*apiv1.BeaconCommittee], error) {
+			return provider.BeaconCommittees(ctx, opts)
+		},
+		func(response *api.Response[[]*apiv1.BeaconCommittee]) (phase0.Slot, bool) {
+			// Committees are only fixed once all of their slots are finalized.
+			if len(response.Data) == 0 {
+				return 0, false
+			}
+			slot := phase0.Slot(0)
+			for _, committee := range response.Data {
+				if committee.Slot > slot {
+					slot = committee.Slot
+				}
+			}
+
+			return slot, true
+		},
+	)
+}
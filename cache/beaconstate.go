@@ -0,0 +1,59 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// BeaconState fetches a beacon state.
+// States are only cached if WithMaxStates is not 0.
+// The response is shared with other callers, so must not be altered.
+func (s *Service) BeaconState(ctx context.Context,
+	opts *api.BeaconStateOpts,
+) (
+	*api.Response[*spec.VersionedBeaconState],
+	error,
+) {
+	provider, isProvider := s.client.(consensusclient.BeaconStateProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide beacon states")
+	}
+	if opts == nil || s.stateStore == nil {
+		return provider.BeaconState(ctx, opts)
+	}
+
+	return cached(ctx, s, s.stateStore, "beaconstate:"+opts.State, opts.State,
+		func() (*api.Response[*spec.VersionedBeaconState], error) {
+			return provider.BeaconState(ctx, opts)
+		},
+		func(response *api.Response[*spec.VersionedBeaconState]) (phase0.Slot, bool) {
+			if response.Data == nil {
+				return 0, false
+			}
+			slot, err := response.Data.Slot()
+			if err != nil {
+				return 0, false
+			}
+
+			return slot, true
+		},
+	)
+}
@@ -0,0 +1,817 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// EpochFromStateID converts a state ID to its epoch.
+//
+// Deprecated: will be removed in a future release.
+func (s *Service) EpochFromStateID(ctx context.Context, stateID string) (phase0.Epoch, error) {
+	next, isNext := s.client.(consensusclient.EpochFromStateIDProvider)
+	if !isNext {
+		return 0, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.EpochFromStateID(ctx, stateID)
+}
+
+// SlotFromStateID converts a state ID to its slot.
+//
+// Deprecated: will be removed in a future release.
+func (s *Service) SlotFromStateID(ctx context.Context, stateID string) (phase0.Slot, error) {
+	next, isNext := s.client.(consensusclient.SlotFromStateIDProvider)
+	if !isNext {
+		return 0, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.SlotFromStateID(ctx, stateID)
+}
+
+// SlotDuration provides the duration of a slot of the chain.
+//
+// Deprecated: use Spec()
+func (s *Service) SlotDuration(ctx context.Context) (time.Duration, error) {
+	next, isNext := s.client.(consensusclient.SlotDurationProvider)
+	if !isNext {
+		return 0, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.SlotDuration(ctx)
+}
+
+// SlotsPerEpoch provides the slots per epoch of the chain.
+//
+// Deprecated: use Spec()
+func (s *Service) SlotsPerEpoch(ctx context.Context) (uint64, error) {
+	next, isNext := s.client.(consensusclient.SlotsPerEpochProvider)
+	if !isNext {
+		return 0, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.SlotsPerEpoch(ctx)
+}
+
+// FarFutureEpoch provides the far future epoch of the chain.
+func (s *Service) FarFutureEpoch(ctx context.Context) (phase0.Epoch, error) {
+	next, isNext := s.client.(consensusclient.FarFutureEpochProvider)
+	if !isNext {
+		return 0, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.FarFutureEpoch(ctx)
+}
+
+// TargetAggregatorsPerCommittee provides the target number of aggregators for each attestation committee.
+//
+// Deprecated: use Spec()
+func (s *Service) TargetAggregatorsPerCommittee(ctx context.Context) (uint64, error) {
+	next, isNext := s.client.(consensusclient.TargetAggregatorsPerCommitteeProvider)
+	if !isNext {
+		return 0, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.TargetAggregatorsPerCommittee(ctx)
+}
+
+// BlobSidecars fetches the blobs given a block ID.
+func (s *Service) BlobSidecars(ctx context.Context, opts *api.BlobSidecarsOpts) (*api.Response[[]*deneb.BlobSidecar], error) {
+	next, isNext := s.client.(consensusclient.BlobSidecarsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.BlobSidecars(ctx, opts)
+}
+
+// SyncCommittee fetches the sync committee for the given state.
+func (s *Service) SyncCommittee(ctx context.Context, opts *api.SyncCommitteeOpts) (*api.Response[*apiv1.SyncCommittee], error) {
+	next, isNext := s.client.(consensusclient.SyncCommitteesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.SyncCommittee(ctx, opts)
+}
+
+// AggregateAttestation fetches the aggregate attestation for the given options.
+func (s *Service) AggregateAttestation(ctx context.Context, opts *api.AggregateAttestationOpts) (*api.Response[*phase0.Attestation], error) {
+	next, isNext := s.client.(consensusclient.AggregateAttestationProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.AggregateAttestation(ctx, opts)
+}
+
+// VersionedAggregateAttestation fetches the aggregate attestation for the given options.
+func (s *Service) VersionedAggregateAttestation(ctx context.Context, opts *api.AggregateAttestationOpts) (*api.Response[*spec.VersionedAttestation], error) {
+	next, isNext := s.client.(consensusclient.VersionedAggregateAttestationProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.VersionedAggregateAttestation(ctx, opts)
+}
+
+// SubmitAggregateAttestations submits aggregate attestations.
+func (s *Service) SubmitAggregateAttestations(ctx context.Context, aggregateAndProofs []*phase0.SignedAggregateAndProof) error {
+	next, isNext := s.client.(consensusclient.AggregateAttestationsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.SubmitAggregateAttestations(ctx, aggregateAndProofs)
+}
+
+// SubmitVersionedAggregateAttestations submits aggregate attestations.
+func (s *Service) SubmitVersionedAggregateAttestations(ctx context.Context, opts *api.SubmitAggregateAttestationsOpts) error {
+	next, isNext := s.client.(consensusclient.VersionedAggregateAttestationsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.SubmitVersionedAggregateAttestations(ctx, opts)
+}
+
+// AttestationData fetches the attestation data for the given options.
+func (s *Service) AttestationData(ctx context.Context, opts *api.AttestationDataOpts) (*api.Response[*phase0.AttestationData], error) {
+	next, isNext := s.client.(consensusclient.AttestationDataProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.AttestationData(ctx, opts)
+}
+
+// AttestationPool fetches the attestation pool for the given options.
+func (s *Service) AttestationPool(ctx context.Context, opts *api.AttestationPoolOpts) (*api.Response[[]*phase0.Attestation], error) {
+	next, isNext := s.client.(consensusclient.AttestationPoolProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.AttestationPool(ctx, opts)
+}
+
+// VersionedAttestationPool fetches the attestation pool for the given options.
+func (s *Service) VersionedAttestationPool(ctx context.Context, opts *api.AttestationPoolOpts) (*api.Response[[]*spec.VersionedAttestation], error) {
+	next, isNext := s.client.(consensusclient.VersionedAttestationPoolProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.VersionedAttestationPool(ctx, opts)
+}
+
+// SubmitAttestations submits attestations.
+func (s *Service) SubmitAttestations(ctx context.Context, attestations []*phase0.Attestation) error {
+	next, isNext := s.client.(consensusclient.AttestationsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.SubmitAttestations(ctx, attestations)
+}
+
+// SubmitVersionedAttestations submits attestations.
+func (s *Service) SubmitVersionedAttestations(ctx context.Context, opts *api.SubmitAttestationsOpts) error {
+	next, isNext := s.client.(consensusclient.VersionedAttestationsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.SubmitVersionedAttestations(ctx, opts)
+}
+
+// SubmitAttesterSlashing submits an attester slashing
+func (s *Service) SubmitAttesterSlashing(ctx context.Context, slashing *phase0.AttesterSlashing) error {
+	next, isNext := s.client.(consensusclient.AttesterSlashingSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.SubmitAttesterSlashing(ctx, slashing)
+}
+
+// AttesterDuties obtains attester duties.
+func (s *Service) AttesterDuties(ctx context.Context, opts *api.AttesterDutiesOpts) (*api.Response[[]*apiv1.AttesterDuty], error) {
+	next, isNext := s.client.(consensusclient.AttesterDutiesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.AttesterDuties(ctx, opts)
+}
+
+// DepositContract provides details of the execution deposit contract for the chain.
+func (s *Service) DepositContract(ctx context.Context, opts *api.DepositContractOpts) (*api.Response[*apiv1.DepositContract], error) {
+	next, isNext := s.client.(consensusclient.DepositContractProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.DepositContract(ctx, opts)
+}
+
+// DepositSnapshot provides the EIP-4881 snapshot of the finalized deposit tree.
+func (s *Service) DepositSnapshot(ctx context.Context, opts *api.DepositSnapshotOpts) (*api.Response[*apiv1.DepositSnapshot], error) {
+	next, isNext := s.client.(consensusclient.DepositSnapshotProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.DepositSnapshot(ctx, opts)
+}
+
+// ExpectedWithdrawals provides the withdrawals expected in the payload of a proposal.
+func (s *Service) ExpectedWithdrawals(ctx context.Context, opts *api.ExpectedWithdrawalsOpts) (*api.Response[[]*capella.Withdrawal], error) {
+	next, isNext := s.client.(consensusclient.ExpectedWithdrawalsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.ExpectedWithdrawals(ctx, opts)
+}
+
+// SyncCommitteeDuties obtains sync committee duties.
+// If validatorIndicess is nil it will return all duties for the given epoch.
+func (s *Service) SyncCommitteeDuties(ctx context.Context, opts *api.SyncCommitteeDutiesOpts) (*api.Response[[]*apiv1.SyncCommitteeDuty], error) {
+	next, isNext := s.client.(consensusclient.SyncCommitteeDutiesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.SyncCommitteeDuties(ctx, opts)
+}
+
+// SubmitSyncCommitteeMessages submits sync committee messages.
+func (s *Service) SubmitSyncCommitteeMessages(ctx context.Context, messages []*altair.SyncCommitteeMessage) error {
+	next, isNext := s.client.(consensusclient.SyncCommitteeMessagesSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.SubmitSyncCommitteeMessages(ctx, messages)
+}
+
+// SubmitSyncCommitteeSubscriptions subscribes to sync committees.
+func (s *Service) SubmitSyncCommitteeSubscriptions(ctx context.Context, subscriptions []*apiv1.SyncCommitteeSubscription) error {
+	next, isNext := s.client.(consensusclient.SyncCommitteeSubscriptionsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.SubmitSyncCommitteeSubscriptions(ctx, subscriptions)
+}
+
+// SyncCommitteeContribution provides a sync committee contribution.
+func (s *Service) SyncCommitteeContribution(ctx context.Context, opts *api.SyncCommitteeContributionOpts) (*api.Response[*altair.SyncCommitteeContribution], error) {
+	next, isNext := s.client.(consensusclient.SyncCommitteeContributionProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.SyncCommitteeContribution(ctx, opts)
+}
+
+// SubmitSyncCommitteeContributions submits sync committee contributions.
+func (s *Service) SubmitSyncCommitteeContributions(ctx context.Context, contributionAndProofs []*altair.SignedContributionAndProof) error {
+	next, isNext := s.client.(consensusclient.SyncCommitteeContributionsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.SubmitSyncCommitteeContributions(ctx, contributionAndProofs)
+}
+
+// SubmitBLSToExecutionChanges submits BLS to execution address change operations.
+func (s *Service) SubmitBLSToExecutionChanges(ctx context.Context, blsToExecutionChanges []*capella.SignedBLSToExecutionChange) error {
+	next, isNext := s.client.(consensusclient.BLSToExecutionChangesSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.SubmitBLSToExecutionChanges(ctx, blsToExecutionChanges)
+}
+
+// Proposal fetches a proposal for signing.
+func (s *Service) Proposal(ctx context.Context, opts *api.ProposalOpts) (*api.Response[*api.VersionedProposal], error) {
+	next, isNext := s.client.(consensusclient.ProposalProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.Proposal(ctx, opts)
+}
+
+func (s *Service) SubmitProposalSlashing(ctx context.Context, slashing *phase0.ProposerSlashing) error {
+	next, isNext := s.client.(consensusclient.ProposalSlashingSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.SubmitProposalSlashing(ctx, slashing)
+}
+
+// BeaconBlockRoot fetches a block's root given a set of options.
+func (s *Service) BeaconBlockRoot(ctx context.Context, opts *api.BeaconBlockRootOpts) (*api.Response[*phase0.Root], error) {
+	next, isNext := s.client.(consensusclient.BeaconBlockRootProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.BeaconBlockRoot(ctx, opts)
+}
+
+// SubmitBeaconBlock submits a beacon block.
+//
+// Deprecated: this will not work from the deneb hard-fork onwards.  Use ProposalSubmitter.SubmitProposal() instead.
+func (s *Service) SubmitBeaconBlock(ctx context.Context, block *spec.VersionedSignedBeaconBlock) error {
+	next, isNext := s.client.(consensusclient.BeaconBlockSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.SubmitBeaconBlock(ctx, block)
+}
+
+// SubmitProposal submits a proposal.
+func (s *Service) SubmitProposal(ctx context.Context, block *api.VersionedSignedProposal) error {
+	next, isNext := s.client.(consensusclient.ProposalSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.SubmitProposal(ctx, block)
+}
+
+// SubmitProposalWithOpts submits a proposal with the given options.
+func (s *Service) SubmitProposalWithOpts(ctx context.Context, opts *api.SubmitProposalOpts) error {
+	next, isNext := s.client.(consensusclient.ProposalWithOptsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.SubmitProposalWithOpts(ctx, opts)
+}
+
+// BeaconCommitteeSelections exchanges partial beacon committee selections for aggregated beacon committee selections.
+func (s *Service) BeaconCommitteeSelections(ctx context.Context, opts *api.BeaconCommitteeSelectionsOpts) (*api.Response[[]*apiv1.BeaconCommitteeSelection], error) {
+	next, isNext := s.client.(consensusclient.BeaconCommitteeSelectionsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.BeaconCommitteeSelections(ctx, opts)
+}
+
+// SyncCommitteeSelections exchanges partial sync committee selections for aggregated sync committee selections.
+func (s *Service) SyncCommitteeSelections(ctx context.Context, opts *api.SyncCommitteeSelectionsOpts) (*api.Response[[]*apiv1.SyncCommitteeSelection], error) {
+	next, isNext := s.client.(consensusclient.SyncCommitteeSelectionsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.SyncCommitteeSelections(ctx, opts)
+}
+
+// SubmitBeaconCommitteeSubscriptions subscribes to beacon committees.
+func (s *Service) SubmitBeaconCommitteeSubscriptions(ctx context.Context, subscriptions []*apiv1.BeaconCommitteeSubscription) error {
+	next, isNext := s.client.(consensusclient.BeaconCommitteeSubscriptionsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.SubmitBeaconCommitteeSubscriptions(ctx, subscriptions)
+}
+
+// BeaconStateRandao fetches a beacon state RANDAO given a state ID.
+func (s *Service) BeaconStateRandao(ctx context.Context, opts *api.BeaconStateRandaoOpts) (*api.Response[*phase0.Root], error) {
+	next, isNext := s.client.(consensusclient.BeaconStateRandaoProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.BeaconStateRandao(ctx, opts)
+}
+
+// BeaconStateRoot fetches a beacon state root given a state ID.
+func (s *Service) BeaconStateRoot(ctx context.Context, opts *api.BeaconStateRootOpts) (*api.Response[*phase0.Root], error) {
+	next, isNext := s.client.(consensusclient.BeaconStateRootProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.BeaconStateRoot(ctx, opts)
+}
+
+// BlindedProposal fetches a blinded proposed beacon block for signing.
+func (s *Service) BlindedProposal(ctx context.Context, opts *api.BlindedProposalOpts) (*api.Response[*api.VersionedBlindedProposal], error) {
+	next, isNext := s.client.(consensusclient.BlindedProposalProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.BlindedProposal(ctx, opts)
+}
+
+// SubmitBlindedBeaconBlock submits a beacon block.
+//
+// Deprecated: this will not work from the deneb hard-fork onwards.  Use BlindedProposalSubmitter.SubmitBlindedProposal() instead.
+func (s *Service) SubmitBlindedBeaconBlock(ctx context.Context, block *api.VersionedSignedBlindedBeaconBlock) error {
+	next, isNext := s.client.(consensusclient.BlindedBeaconBlockSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.SubmitBlindedBeaconBlock(ctx, block)
+}
+
+// SubmitBlindedProposal submits a beacon block.
+func (s *Service) SubmitBlindedProposal(ctx context.Context, block *api.VersionedSignedBlindedProposal) error {
+	next, isNext := s.client.(consensusclient.BlindedProposalSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.SubmitBlindedProposal(ctx, block)
+}
+
+// SubmitBlindedProposalWithOpts submits a blinded proposal with the given options.
+func (s *Service) SubmitBlindedProposalWithOpts(ctx context.Context, opts *api.SubmitBlindedProposalOpts) error {
+	next, isNext := s.client.(consensusclient.BlindedProposalWithOptsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.SubmitBlindedProposalWithOpts(ctx, opts)
+}
+
+// SubmitValidatorRegistrations submits a validator registration.
+func (s *Service) SubmitValidatorRegistrations(ctx context.Context, registrations []*api.VersionedSignedValidatorRegistration) error {
+	next, isNext := s.client.(consensusclient.ValidatorRegistrationsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.SubmitValidatorRegistrations(ctx, registrations)
+}
+
+// Events feeds requested events with the given topics to the supplied handler.
+func (s *Service) Events(ctx context.Context, topics []string, handler consensusclient.EventHandlerFunc) error {
+	next, isNext := s.client.(consensusclient.EventsProvider)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.Events(ctx, topics, handler)
+}
+
+// Finality provides the finality given a state ID.
+func (s *Service) Finality(ctx context.Context, opts *api.FinalityOpts) (*api.Response[*apiv1.Finality], error) {
+	next, isNext := s.client.(consensusclient.FinalityProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.Finality(ctx, opts)
+}
+
+// Fork fetches all current fork choice context.
+func (s *Service) ForkChoice(ctx context.Context, opts *api.ForkChoiceOpts) (*api.Response[*apiv1.ForkChoice], error) {
+	next, isNext := s.client.(consensusclient.ForkChoiceProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.ForkChoice(ctx, opts)
+}
+
+// Fork fetches fork information for the given state.
+func (s *Service) Fork(ctx context.Context, opts *api.ForkOpts) (*api.Response[*phase0.Fork], error) {
+	next, isNext := s.client.(consensusclient.ForkProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.Fork(ctx, opts)
+}
+
+// ForkSchedule provides details of past and future changes in the chain's fork version.
+func (s *Service) ForkSchedule(ctx context.Context, opts *api.ForkScheduleOpts) (*api.Response[[]*phase0.Fork], error) {
+	next, isNext := s.client.(consensusclient.ForkScheduleProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.ForkSchedule(ctx, opts)
+}
+
+// Genesis fetches genesis information for the chain.
+func (s *Service) Genesis(ctx context.Context, opts *api.GenesisOpts) (*api.Response[*apiv1.Genesis], error) {
+	next, isNext := s.client.(consensusclient.GenesisProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.Genesis(ctx, opts)
+}
+
+// NodeHealth provides the health of the node.
+func (s *Service) NodeHealth(ctx context.Context, opts *api.NodeHealthOpts) (*api.Response[apiv1.NodeHealth], error) {
+	next, isNext := s.client.(consensusclient.NodeHealthProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.NodeHealth(ctx, opts)
+}
+
+// NodeIdentity provides the identity of the node.
+func (s *Service) NodeIdentity(ctx context.Context, opts *api.NodeIdentityOpts) (*api.Response[*apiv1.NodeIdentity], error) {
+	next, isNext := s.client.(consensusclient.NodeIdentityProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.NodeIdentity(ctx, opts)
+}
+
+// NodePeerCount provides the number of peers of the node in each connection state.
+func (s *Service) NodePeerCount(ctx context.Context, opts *api.NodePeerCountOpts) (*api.Response[*apiv1.PeerCount], error) {
+	next, isNext := s.client.(consensusclient.NodePeerCountProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.NodePeerCount(ctx, opts)
+}
+
+// NodePeers provides the peers of the node.
+func (s *Service) NodePeers(ctx context.Context, opts *api.NodePeersOpts) (*api.Response[[]*apiv1.Peer], error) {
+	next, isNext := s.client.(consensusclient.NodePeersProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.NodePeers(ctx, opts)
+}
+
+// NodeSyncing provides the state of the node's synchronization with the chain.
+func (s *Service) NodeSyncing(ctx context.Context, opts *api.NodeSyncingOpts) (*api.Response[*apiv1.SyncState], error) {
+	next, isNext := s.client.(consensusclient.NodeSyncingProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.NodeSyncing(ctx, opts)
+}
+
+// NodeVersion returns a free-text string with the node version.
+func (s *Service) NodeVersion(ctx context.Context, opts *api.NodeVersionOpts) (*api.Response[string], error) {
+	next, isNext := s.client.(consensusclient.NodeVersionProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.NodeVersion(ctx, opts)
+}
+
+// SubmitProposalPreparations provides the beacon node with information required if a proposal for the given validators
+// shows up in the next epoch.
+func (s *Service) SubmitProposalPreparations(ctx context.Context, preparations []*apiv1.ProposalPreparation) error {
+	next, isNext := s.client.(consensusclient.ProposalPreparationsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.SubmitProposalPreparations(ctx, preparations)
+}
+
+// ProposerDuties obtains proposer duties for the given options.
+func (s *Service) ProposerDuties(ctx context.Context, opts *api.ProposerDutiesOpts) (*api.Response[[]*apiv1.ProposerDuty], error) {
+	next, isNext := s.client.(consensusclient.ProposerDutiesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.ProposerDuties(ctx, opts)
+}
+
+// Spec provides the spec information of the chain.
+func (s *Service) Spec(ctx context.Context, opts *api.SpecOpts) (*api.Response[map[string]any], error) {
+	next, isNext := s.client.(consensusclient.SpecProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.Spec(ctx, opts)
+}
+
+// AttestationRewards provides the attestation rewards for the given options.
+func (s *Service) AttestationRewards(ctx context.Context, opts *api.AttestationRewardsOpts) (*api.Response[*apiv1.AttestationRewards], error) {
+	next, isNext := s.client.(consensusclient.AttestationRewardsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.AttestationRewards(ctx, opts)
+}
+
+// BlockRewards provides the block rewards for the given options.
+func (s *Service) BlockRewards(ctx context.Context, opts *api.BlockRewardsOpts) (*api.Response[*apiv1.BlockRewards], error) {
+	next, isNext := s.client.(consensusclient.BlockRewardsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.BlockRewards(ctx, opts)
+}
+
+// SyncCommitteeRewards provides the sync committee rewards for the given options.
+func (s *Service) SyncCommitteeRewards(ctx context.Context, opts *api.SyncCommitteeRewardsOpts) (*api.Response[[]*apiv1.SyncCommitteeReward], error) {
+	next, isNext := s.client.(consensusclient.SyncCommitteeRewardsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.SyncCommitteeRewards(ctx, opts)
+}
+
+// ValidatorLiveness provides the liveness of validators for the given options.
+func (s *Service) ValidatorLiveness(ctx context.Context, opts *api.ValidatorLivenessOpts) (*api.Response[[]*apiv1.ValidatorLiveness], error) {
+	next, isNext := s.client.(consensusclient.ValidatorLivenessProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.ValidatorLiveness(ctx, opts)
+}
+
+// Validators provides the validators, with their balance and status, for the given options.
+func (s *Service) Validators(ctx context.Context, opts *api.ValidatorsOpts) (*api.Response[map[phase0.ValidatorIndex]*apiv1.Validator], error) {
+	next, isNext := s.client.(consensusclient.ValidatorsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.Validators(ctx, opts)
+}
+
+// SubmitVoluntaryExit submits a voluntary exit.
+func (s *Service) SubmitVoluntaryExit(ctx context.Context, voluntaryExit *phase0.SignedVoluntaryExit) error {
+	next, isNext := s.client.(consensusclient.VoluntaryExitSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.SubmitVoluntaryExit(ctx, voluntaryExit)
+}
+
+// LightClientBootstrap provides the light client bootstrap of a given block ID.
+func (s *Service) LightClientBootstrap(ctx context.Context, blockID string) (*altair.LightClientBootstrap, error) {
+	next, isNext := s.client.(consensusclient.LightClientProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.LightClientBootstrap(ctx, blockID)
+}
+
+// LightClientUpdates provides the light client updates.
+func (s *Service) LightClientUpdates(ctx context.Context, start, count uint64) ([]*altair.LightClientUpdate, error) {
+	next, isNext := s.client.(consensusclient.LightClientProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.LightClientUpdates(ctx, start, count)
+}
+
+// LightClientFinalityUpdate provides the light client finality_update.
+func (s *Service) LightClientFinalityUpdate(ctx context.Context) (*altair.LightClientFinalityUpdate, error) {
+	next, isNext := s.client.(consensusclient.LightClientProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.LightClientFinalityUpdate(ctx)
+}
+
+// LightClientOptimisticUpdate provides the light client optimistic_update.
+func (s *Service) LightClientOptimisticUpdate(ctx context.Context) (*altair.LightClientOptimisticUpdate, error) {
+	next, isNext := s.client.(consensusclient.LightClientProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.LightClientOptimisticUpdate(ctx)
+}
+
+// AttesterSlashingPool fetches the attester slashing pool.
+func (s *Service) AttesterSlashingPool(ctx context.Context, opts *api.AttesterSlashingPoolOpts) (*api.Response[[]*phase0.AttesterSlashing], error) {
+	next, isNext := s.client.(consensusclient.AttesterSlashingPoolProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.AttesterSlashingPool(ctx, opts)
+}
+
+// ProposerSlashingPool fetches the proposer slashing pool.
+func (s *Service) ProposerSlashingPool(ctx context.Context, opts *api.ProposerSlashingPoolOpts) (*api.Response[[]*phase0.ProposerSlashing], error) {
+	next, isNext := s.client.(consensusclient.ProposerSlashingPoolProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.ProposerSlashingPool(ctx, opts)
+}
+
+// BLSToExecutionChangePool fetches the BLS to execution change pool.
+func (s *Service) BLSToExecutionChangePool(ctx context.Context, opts *api.BLSToExecutionChangePoolOpts) (*api.Response[[]*capella.SignedBLSToExecutionChange], error) {
+	next, isNext := s.client.(consensusclient.BLSToExecutionChangePoolProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.BLSToExecutionChangePool(ctx, opts)
+}
+
+// VoluntaryExitPool fetches the voluntary exit pool.
+func (s *Service) VoluntaryExitPool(ctx context.Context, opts *api.VoluntaryExitPoolOpts) (*api.Response[[]*phase0.SignedVoluntaryExit], error) {
+	next, isNext := s.client.(consensusclient.VoluntaryExitPoolProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.VoluntaryExitPool(ctx, opts)
+}
+
+// Domain provides a domain for a given domain type at a given epoch.
+func (s *Service) Domain(ctx context.Context, domainType phase0.DomainType, epoch phase0.Epoch) (phase0.Domain, error) {
+	next, isNext := s.client.(consensusclient.DomainProvider)
+	if !isNext {
+		return phase0.Domain{}, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.Domain(ctx, domainType, epoch)
+}
+
+// GenesisDomain returns the domain for the given domain type at genesis.
+// N.B. this is not always the same as the domain at epoch 0.  It is possible
+// for a chain's fork schedule to have multiple forks at genesis.  In this situation,
+// GenesisDomain() will return the first, and Domain() will return the last.
+func (s *Service) GenesisDomain(ctx context.Context, domainType phase0.DomainType) (phase0.Domain, error) {
+	next, isNext := s.client.(consensusclient.DomainProvider)
+	if !isNext {
+		return phase0.Domain{}, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.GenesisDomain(ctx, domainType)
+}
+
+// GenesisTime provides the genesis time of the chain.
+func (s *Service) GenesisTime(ctx context.Context) (time.Time, error) {
+	next, isNext := s.client.(consensusclient.GenesisTimeProvider)
+	if !isNext {
+		return time.Time{}, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.GenesisTime(ctx)
+}
+
+// NodeClient provides the client for the node.
+func (s *Service) NodeClient(ctx context.Context) (*api.Response[string], error) {
+	next, isNext := s.client.(consensusclient.NodeClientProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.client.Name(), s.client.Address())
+	}
+
+	return next.NodeClient(ctx)
+}
@@ -0,0 +1,109 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel   zerolog.Level
+	client     consensusclient.Service
+	headTTL    time.Duration
+	maxEntries int
+	maxStates  int
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithClient sets the client for which responses are cached.
+func WithClient(client consensusclient.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.client = client
+	})
+}
+
+// WithHeadTTL sets the time for which responses that are not finalized are cached.
+func WithHeadTTL(ttl time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.headTTL = ttl
+	})
+}
+
+// WithMaxEntries sets the maximum number of responses held in the cache.
+// Beacon states are held separately; see WithMaxStates.
+func WithMaxEntries(maxEntries int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxEntries = maxEntries
+	})
+}
+
+// WithMaxStates sets the maximum number of beacon states held in the cache.
+// Beacon states can be very large, so this defaults to 2.
+// A value of 0 disables caching of beacon states.
+func WithMaxStates(maxStates int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxStates = maxStates
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:   zerolog.GlobalLevel(),
+		headTTL:    12 * time.Second,
+		maxEntries: 1024,
+		maxStates:  2,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.client == nil {
+		return nil, errors.New("no client specified")
+	}
+	if parameters.headTTL <= 0 {
+		return nil, errors.New("head TTL must be positive")
+	}
+	if parameters.maxEntries <= 0 {
+		return nil, errors.New("max entries must be positive")
+	}
+	if parameters.maxStates < 0 {
+		return nil, errors.New("max states cannot be negative")
+	}
+
+	return &parameters, nil
+}
@@ -0,0 +1,279 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Service is a client that caches responses from an underlying client.
+//
+// Responses for data at finalized slots are cached indefinitely, subject to the
+// maximum number of entries.  Responses for other data are cached for a short time,
+// and are removed when the underlying client reports a new head or a chain
+// reorganisation.
+//
+// Beacon states are held in a separate, smaller, store to the other responses
+// due to their size.
+//
+// Cached responses are shared between callers, so must not be altered.
+// Calls for data that is not cached are passed directly to the underlying client.
+type Service struct {
+	log zerolog.Logger
+
+	client  consensusclient.Service
+	store   *store
+	headTTL time.Duration
+
+	// stateStore holds beacon states.
+	// This is nil if beacon states are not cached.
+	stateStore *store
+
+	slotsPerEpoch uint64
+
+	finalizedMu        sync.RWMutex
+	finalizedSlot      phase0.Slot
+	finalizedRefreshed time.Time
+}
+
+// New creates a new caching client.
+// The underlying client must provide spec and finality information.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "client").Str("impl", "cache").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	specProvider, isProvider := parameters.client.(consensusclient.SpecProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide spec")
+	}
+	if _, isProvider := parameters.client.(consensusclient.FinalityProvider); !isProvider {
+		return nil, errors.New("client does not provide finality")
+	}
+
+	specResponse, err := specProvider.Spec(ctx, &api.SpecOpts{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain spec")
+	}
+	tmp, exists := specResponse.Data["SLOTS_PER_EPOCH"]
+	if !exists {
+		return nil, errors.New("SLOTS_PER_EPOCH not found in spec")
+	}
+	slotsPerEpoch, isUint := tmp.(uint64)
+	if !isUint {
+		return nil, errors.New("SLOTS_PER_EPOCH of unexpected type")
+	}
+	if slotsPerEpoch == 0 {
+		return nil, errors.New("SLOTS_PER_EPOCH cannot be 0")
+	}
+
+	s := &Service{
+		log:           log,
+		client:        parameters.client,
+		store:         newStore(parameters.maxEntries),
+		headTTL:       parameters.headTTL,
+		slotsPerEpoch: slotsPerEpoch,
+	}
+	if parameters.maxStates > 0 {
+		s.stateStore = newStore(parameters.maxStates)
+	}
+
+	if err := s.refreshFinalized(ctx); err != nil {
+		return nil, err
+	}
+
+	if eventsProvider, isProvider := parameters.client.(consensusclient.EventsProvider); isProvider {
+		if err := eventsProvider.Events(ctx, []string{"head", "chain_reorg", "finalized_checkpoint"}, s.handleEvent); err != nil {
+			// Not fatal, as unfinalized entries will expire regardless.
+			log.Warn().Err(err).Msg("Failed to subscribe to events; unfinalized entries will only expire")
+		}
+	}
+
+	return s, nil
+}
+
+// Name returns the name of the client implementation.
+func (s *Service) Name() string {
+	return "cache"
+}
+
+// Address returns the address of the client.
+func (s *Service) Address() string {
+	return s.client.Address()
+}
+
+// handleEvent handles events from the underlying client.
+func (s *Service) handleEvent(event *apiv1.Event) {
+	switch event.Topic {
+	case "head", "chain_reorg":
+		s.store.purgeUnfinalized()
+		if s.stateStore != nil {
+			s.stateStore.purgeUnfinalized()
+		}
+	case "finalized_checkpoint":
+		data, isData := event.Data.(*apiv1.FinalizedCheckpointEvent)
+		if !isData {
+			return
+		}
+		s.setFinalizedEpoch(data.Epoch)
+	}
+}
+
+// setFinalizedEpoch sets the finalized epoch.
+func (s *Service) setFinalizedEpoch(epoch phase0.Epoch) {
+	s.finalizedMu.Lock()
+	defer s.finalizedMu.Unlock()
+
+	slot := phase0.Slot(uint64(epoch) * s.slotsPerEpoch)
+	if slot > s.finalizedSlot {
+		s.finalizedSlot = slot
+	}
+	s.finalizedRefreshed = time.Now()
+}
+
+// refreshFinalized obtains the finalized epoch from the underlying client.
+func (s *Service) refreshFinalized(ctx context.Context) error {
+	response, err := s.client.(consensusclient.FinalityProvider).Finality(ctx, &api.FinalityOpts{
+		State: "head",
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain finality")
+	}
+	if response.Data == nil || response.Data.Finalized == nil {
+		return errors.New("finality missing finalized checkpoint")
+	}
+	s.setFinalizedEpoch(response.Data.Finalized.Epoch)
+
+	return nil
+}
+
+// isFinalized returns true if the data obtained using the given block or state ID
+// at the given slot is finalized.
+func (s *Service) isFinalized(ctx context.Context,
+	id string,
+	slot phase0.Slot,
+	metadata map[string]any,
+) bool {
+	switch id {
+	case "head", "justified", "finalized":
+		// Relative to the chain, so will change.
+		return false
+	}
+
+	if finalized, isBool := metadata["finalized"].(bool); isBool && finalized {
+		return true
+	}
+
+	s.finalizedMu.RLock()
+	finalizedSlot := s.finalizedSlot
+	stale := time.Since(s.finalizedRefreshed) > s.headTTL
+	s.finalizedMu.RUnlock()
+
+	if slot <= finalizedSlot {
+		return true
+	}
+	if !stale {
+		return false
+	}
+
+	if err := s.refreshFinalized(ctx); err != nil {
+		s.log.Debug().Err(err).Msg("Failed to refresh finality")
+
+		return false
+	}
+	s.finalizedMu.RLock()
+	finalizedSlot = s.finalizedSlot
+	s.finalizedMu.RUnlock()
+
+	return slot <= finalizedSlot
+}
+
+// slotFromID returns the slot if the block or state ID is a slot number.
+func slotFromID(id string) (phase0.Slot, bool) {
+	if id == "genesis" {
+		return 0, true
+	}
+	if strings.HasPrefix(id, "0x") {
+		return 0, false
+	}
+	slot, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return phase0.Slot(slot), true
+}
+
+// cached returns the cached response for the key in the given store if present,
+// otherwise it fetches the response and caches it.
+// slotFunc returns the slot of the fetched data, or false if it cannot be
+// determined, in which case the data is only cached if the response states
+// it to be finalized.
+func cached[T any](ctx context.Context,
+	s *Service,
+	cacheStore *store,
+	key string,
+	id string,
+	fetch func() (*api.Response[T], error),
+	slotFunc func(*api.Response[T]) (phase0.Slot, bool),
+) (
+	*api.Response[T],
+	error,
+) {
+	if value, exists := cacheStore.get(key); exists {
+		s.log.Trace().Str("key", key).Msg("Cache hit")
+
+		return value.(*api.Response[T]), nil
+	}
+
+	response, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+
+	slot, slotKnown := slotFunc(response)
+	if !slotKnown {
+		// Use a slot that can never be finalized, so that only
+		// the response metadata is able to mark it as finalized.
+		slot = phase0.Slot(^uint64(0))
+	}
+	if s.isFinalized(ctx, id, slot, response.Metadata) {
+		s.log.Trace().Str("key", key).Msg("Caching finalized response")
+		cacheStore.set(key, response, time.Time{})
+	} else {
+		s.log.Trace().Str("key", key).Msg("Caching unfinalized response")
+		cacheStore.set(key, response, time.Now().Add(s.headTTL))
+	}
+
+	return response, nil
+}
@@ -0,0 +1,340 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/cache"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// testClient is a client that counts the requests made of it.
+type testClient struct {
+	finalizedEpoch phase0.Epoch
+	handler        consensusclient.EventHandlerFunc
+	headerCalls    int
+	balancesCalls  int
+	stateCalls     int
+}
+
+func (c *testClient) Name() string {
+	return "test"
+}
+
+func (c *testClient) Address() string {
+	return "test"
+}
+
+func (c *testClient) Spec(_ context.Context, _ *api.SpecOpts) (*api.Response[map[string]any], error) {
+	return &api.Response[map[string]any]{
+		Data: map[string]any{
+			"SLOTS_PER_EPOCH": uint64(32),
+		},
+	}, nil
+}
+
+func (c *testClient) Finality(_ context.Context, _ *api.FinalityOpts) (*api.Response[*apiv1.Finality], error) {
+	return &api.Response[*apiv1.Finality]{
+		Data: &apiv1.Finality{
+			Finalized: &phase0.Checkpoint{Epoch: c.finalizedEpoch},
+		},
+	}, nil
+}
+
+func (c *testClient) Events(_ context.Context, _ []string, handler consensusclient.EventHandlerFunc) error {
+	c.handler = handler
+
+	return nil
+}
+
+func (c *testClient) BeaconBlockHeader(_ context.Context, opts *api.BeaconBlockHeaderOpts) (*api.Response[*apiv1.BeaconBlockHeader], error) {
+	c.headerCalls++
+	slot := uint64(1000)
+	if opts.Block != "head" {
+		var err error
+		slot, err = strconv.ParseUint(opts.Block, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &api.Response[*apiv1.BeaconBlockHeader]{
+		Data: &apiv1.BeaconBlockHeader{
+			Header: &phase0.SignedBeaconBlockHeader{
+				Message: &phase0.BeaconBlockHeader{
+					Slot: phase0.Slot(slot),
+				},
+			},
+		},
+	}, nil
+}
+
+func (c *testClient) BeaconBlockHeaders(_ context.Context, _ *api.BeaconBlockHeadersOpts) (*api.Response[[]*apiv1.BeaconBlockHeader], error) {
+	return &api.Response[[]*apiv1.BeaconBlockHeader]{}, nil
+}
+
+func (c *testClient) ValidatorBalances(_ context.Context, _ *api.ValidatorBalancesOpts) (*api.Response[map[phase0.ValidatorIndex]phase0.Gwei], error) {
+	c.balancesCalls++
+
+	return &api.Response[map[phase0.ValidatorIndex]phase0.Gwei]{
+		Data: map[phase0.ValidatorIndex]phase0.Gwei{1: 32000000000},
+	}, nil
+}
+
+func (c *testClient) BeaconState(_ context.Context, opts *api.BeaconStateOpts) (*api.Response[*spec.VersionedBeaconState], error) {
+	c.stateCalls++
+	slot, err := strconv.ParseUint(opts.State, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[*spec.VersionedBeaconState]{
+		Data: &spec.VersionedBeaconState{
+			Version: spec.DataVersionPhase0,
+			Phase0: &phase0.BeaconState{
+				Slot: phase0.Slot(slot),
+			},
+		},
+	}, nil
+}
+
+func (c *testClient) Genesis(_ context.Context, _ *api.GenesisOpts) (*api.Response[*apiv1.Genesis], error) {
+	return &api.Response[*apiv1.Genesis]{
+		Data: &apiv1.Genesis{
+			GenesisTime: time.Unix(1606824023, 0),
+		},
+	}, nil
+}
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := cache.New(ctx)
+	require.EqualError(t, err, "problem with parameters: no client specified")
+
+	_, err = cache.New(ctx, cache.WithClient(&testClient{}), cache.WithHeadTTL(0))
+	require.EqualError(t, err, "problem with parameters: head TTL must be positive")
+
+	_, err = cache.New(ctx, cache.WithClient(&testClient{}), cache.WithMaxStates(-1))
+	require.EqualError(t, err, "problem with parameters: max states cannot be negative")
+
+	s, err := cache.New(ctx, cache.WithClient(&testClient{}))
+	require.NoError(t, err)
+	require.Equal(t, "cache", s.Name())
+	require.Equal(t, "test", s.Address())
+}
+
+func TestFinalized(t *testing.T) {
+	ctx := context.Background()
+	client := &testClient{finalizedEpoch: 10}
+	s, err := cache.New(ctx, cache.WithClient(client), cache.WithHeadTTL(time.Hour))
+	require.NoError(t, err)
+
+	// Finalized slot is cached, and survives a new head.
+	for i := 0; i < 3; i++ {
+		_, err = s.BeaconBlockHeader(ctx, &api.BeaconBlockHeaderOpts{Block: "320"})
+		require.NoError(t, err)
+	}
+	require.Equal(t, 1, client.headerCalls)
+	client.handler(&apiv1.Event{Topic: "head", Data: &apiv1.HeadEvent{}})
+	_, err = s.BeaconBlockHeader(ctx, &api.BeaconBlockHeaderOpts{Block: "320"})
+	require.NoError(t, err)
+	require.Equal(t, 1, client.headerCalls)
+
+	// Unfinalized slot is cached until a new head.
+	_, err = s.BeaconBlockHeader(ctx, &api.BeaconBlockHeaderOpts{Block: "321"})
+	require.NoError(t, err)
+	_, err = s.BeaconBlockHeader(ctx, &api.BeaconBlockHeaderOpts{Block: "321"})
+	require.NoError(t, err)
+	require.Equal(t, 2, client.headerCalls)
+	client.handler(&apiv1.Event{Topic: "head", Data: &apiv1.HeadEvent{}})
+	_, err = s.BeaconBlockHeader(ctx, &api.BeaconBlockHeaderOpts{Block: "321"})
+	require.NoError(t, err)
+	require.Equal(t, 3, client.headerCalls)
+
+	// Once finalized it is cached indefinitely.
+	client.handler(&apiv1.Event{Topic: "finalized_checkpoint", Data: &apiv1.FinalizedCheckpointEvent{Epoch: 11}})
+	client.handler(&apiv1.Event{Topic: "chain_reorg", Data: &apiv1.ChainReorgEvent{}})
+	_, err = s.BeaconBlockHeader(ctx, &api.BeaconBlockHeaderOpts{Block: "321"})
+	require.NoError(t, err)
+	require.Equal(t, 4, client.headerCalls)
+	client.handler(&apiv1.Event{Topic: "chain_reorg", Data: &apiv1.ChainReorgEvent{}})
+	_, err = s.BeaconBlockHeader(ctx, &api.BeaconBlockHeaderOpts{Block: "321"})
+	require.NoError(t, err)
+	require.Equal(t, 4, client.headerCalls)
+
+	// Head is never finalized.
+	_, err = s.BeaconBlockHeader(ctx, &api.BeaconBlockHeaderOpts{Block: "head"})
+	require.NoError(t, err)
+	client.handler(&apiv1.Event{Topic: "head", Data: &apiv1.HeadEvent{}})
+	_, err = s.BeaconBlockHeader(ctx, &api.BeaconBlockHeaderOpts{Block: "head"})
+	require.NoError(t, err)
+	require.Equal(t, 6, client.headerCalls)
+}
+
+func TestHeadTTL(t *testing.T) {
+	ctx := context.Background()
+	client := &testClient{finalizedEpoch: 10}
+	s, err := cache.New(ctx, cache.WithClient(client), cache.WithHeadTTL(10*time.Millisecond))
+	require.NoError(t, err)
+
+	_, err = s.BeaconBlockHeader(ctx, &api.BeaconBlockHeaderOpts{Block: "head"})
+	require.NoError(t, err)
+	_, err = s.BeaconBlockHeader(ctx, &api.BeaconBlockHeaderOpts{Block: "head"})
+	require.NoError(t, err)
+	require.Equal(t, 1, client.headerCalls)
+	time.Sleep(20 * time.Millisecond)
+	_, err = s.BeaconBlockHeader(ctx, &api.BeaconBlockHeaderOpts{Block: "head"})
+	require.NoError(t, err)
+	require.Equal(t, 2, client.headerCalls)
+}
+
+func TestValidatorBalances(t *testing.T) {
+	ctx := context.Background()
+	client := &testClient{finalizedEpoch: 10}
+	s, err := cache.New(ctx, cache.WithClient(client), cache.WithHeadTTL(time.Hour))
+	require.NoError(t, err)
+
+	// Index order does not affect caching.
+	_, err = s.ValidatorBalances(ctx, &api.ValidatorBalancesOpts{State: "100", Indices: []phase0.ValidatorIndex{1, 2}})
+	require.NoError(t, err)
+	_, err = s.ValidatorBalances(ctx, &api.ValidatorBalancesOpts{State: "100", Indices: []phase0.ValidatorIndex{2, 1}})
+	require.NoError(t, err)
+	require.Equal(t, 1, client.balancesCalls)
+
+	// Different indices are cached separately.
+	_, err = s.ValidatorBalances(ctx, &api.ValidatorBalancesOpts{State: "100", Indices: []phase0.ValidatorIndex{1}})
+	require.NoError(t, err)
+	require.Equal(t, 2, client.balancesCalls)
+
+	// Balance functions bypass the cache.
+	_, err = s.ValidatorBalances(ctx, &api.ValidatorBalancesOpts{
+		State:       "100",
+		Indices:     []phase0.ValidatorIndex{1},
+		BalanceFunc: func(phase0.ValidatorIndex, phase0.Gwei) error { return nil },
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, client.balancesCalls)
+
+	// Client without the provider returns an error.
+	_, err = s.SignedBeaconBlock(ctx, &api.SignedBeaconBlockOpts{Block: "100"})
+	require.EqualError(t, err, "client does not provide signed beacon blocks")
+}
+
+func TestMaxEntries(t *testing.T) {
+	ctx := context.Background()
+	client := &testClient{finalizedEpoch: 10}
+	s, err := cache.New(ctx, cache.WithClient(client), cache.WithMaxEntries(2))
+	require.NoError(t, err)
+
+	for _, block := range []string{"1", "2", "3", "1"} {
+		_, err = s.BeaconBlockHeader(ctx, &api.BeaconBlockHeaderOpts{Block: block})
+		require.NoError(t, err)
+	}
+	// Block 1 was evicted, so is fetched again.
+	require.Equal(t, 4, client.headerCalls)
+}
+
+func TestMaxStates(t *testing.T) {
+	ctx := context.Background()
+	client := &testClient{finalizedEpoch: 10}
+	s, err := cache.New(ctx, cache.WithClient(client), cache.WithMaxEntries(1), cache.WithMaxStates(2))
+	require.NoError(t, err)
+
+	for _, state := range []string{"1", "2", "1"} {
+		_, err = s.BeaconState(ctx, &api.BeaconStateOpts{State: state})
+		require.NoError(t, err)
+	}
+	require.Equal(t, 2, client.stateCalls)
+
+	// Other responses do not evict states.
+	for _, block := range []string{"1", "2"} {
+		_, err = s.BeaconBlockHeader(ctx, &api.BeaconBlockHeaderOpts{Block: block})
+		require.NoError(t, err)
+	}
+	_, err = s.BeaconState(ctx, &api.BeaconStateOpts{State: "2"})
+	require.NoError(t, err)
+	require.Equal(t, 2, client.stateCalls)
+
+	// States are limited separately.
+	for _, state := range []string{"3", "1"} {
+		_, err = s.BeaconState(ctx, &api.BeaconStateOpts{State: state})
+		require.NoError(t, err)
+	}
+	require.Equal(t, 4, client.stateCalls)
+}
+
+func TestStatesDisabled(t *testing.T) {
+	ctx := context.Background()
+	client := &testClient{finalizedEpoch: 10}
+	s, err := cache.New(ctx, cache.WithClient(client), cache.WithMaxStates(0))
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		_, err = s.BeaconState(ctx, &api.BeaconStateOpts{State: "1"})
+		require.NoError(t, err)
+	}
+	require.Equal(t, 2, client.stateCalls)
+}
+
+func TestForwarded(t *testing.T) {
+	ctx := context.Background()
+	client := &testClient{finalizedEpoch: 10}
+	s, err := cache.New(ctx, cache.WithClient(client))
+	require.NoError(t, err)
+
+	// Calls that are not cached are passed to the underlying client.
+	genesisResponse, err := s.Genesis(ctx, &api.GenesisOpts{})
+	require.NoError(t, err)
+	require.Equal(t, time.Unix(1606824023, 0), genesisResponse.Data.GenesisTime)
+
+	// Calls that the underlying client does not support return an error.
+	_, err = s.NodeVersion(ctx, &api.NodeVersionOpts{})
+	require.EqualError(t, err, "test@test does not support this call")
+}
+
+// The cache can be used in place of a full client.
+var (
+	_ consensusclient.AggregateAttestationProvider          = (*cache.Service)(nil)
+	_ consensusclient.AttestationDataProvider               = (*cache.Service)(nil)
+	_ consensusclient.AttestationsSubmitter                 = (*cache.Service)(nil)
+	_ consensusclient.AttesterDutiesProvider                = (*cache.Service)(nil)
+	_ consensusclient.BeaconBlockHeadersProvider            = (*cache.Service)(nil)
+	_ consensusclient.BeaconBlockHeadersListProvider        = (*cache.Service)(nil)
+	_ consensusclient.BeaconCommitteesProvider              = (*cache.Service)(nil)
+	_ consensusclient.BeaconStateProvider                   = (*cache.Service)(nil)
+	_ consensusclient.EventsProvider                        = (*cache.Service)(nil)
+	_ consensusclient.GenesisProvider                       = (*cache.Service)(nil)
+	_ consensusclient.NodeSyncingProvider                   = (*cache.Service)(nil)
+	_ consensusclient.ProposalProvider                      = (*cache.Service)(nil)
+	_ consensusclient.ProposalSubmitter                     = (*cache.Service)(nil)
+	_ consensusclient.ProposerDutiesProvider                = (*cache.Service)(nil)
+	_ consensusclient.SignedBeaconBlockProvider             = (*cache.Service)(nil)
+	_ consensusclient.SpecProvider                          = (*cache.Service)(nil)
+	_ consensusclient.SyncCommitteeDutiesProvider           = (*cache.Service)(nil)
+	_ consensusclient.ValidatorBalancesProvider             = (*cache.Service)(nil)
+	_ consensusclient.ValidatorsProvider                    = (*cache.Service)(nil)
+	_ consensusclient.ValidatorRegistrationsSubmitter       = (*cache.Service)(nil)
+	_ consensusclient.VersionedAggregateAttestationProvider = (*cache.Service)(nil)
+	_ consensusclient.VersionedAttestationsSubmitter        = (*cache.Service)(nil)
+)
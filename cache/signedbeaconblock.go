@@ -0,0 +1,58 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// SignedBeaconBlock fetches a signed beacon block given a block ID.
+// The response is shared with other callers, so must not be altered.
+func (s *Service) SignedBeaconBlock(ctx context.Context,
+	opts *api.SignedBeaconBlockOpts,
+) (
+	*api.Response[*spec.VersionedSignedBeaconBlock],
+	error,
+) {
+	provider, isProvider := s.client.(consensusclient.SignedBeaconBlockProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide signed beacon blocks")
+	}
+	if opts == nil {
+		return provider.SignedBeaconBlock(ctx, opts)
+	}
+
+	return cached(ctx, s, s.store, "signedbeaconblock:"+opts.Block, opts.Block,
+		func() (*api.Response[*spec.VersionedSignedBeaconBlock], error) {
+			return provider.SignedBeaconBlock(ctx, opts)
+		},
+		func(response *api.Response[*spec.VersionedSignedBeaconBlock]) (phase0.Slot, bool) {
+			if response.Data == nil {
+				return 0, false
+			}
+			slot, err := response.Data.Slot()
+			if err != nil {
+				return 0, false
+			}
+
+			return slot, true
+		},
+	)
+}
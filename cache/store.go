@@ -0,0 +1,120 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// entry is a single cached response.
+type entry struct {
+	key   string
+	value any
+	// expiry is the time at which the entry is no longer valid.
+	// Entries with a zero expiry are finalized and never expire.
+	expiry time.Time
+}
+
+// store is a least-recently-used store of cached responses.
+type store struct {
+	mu         sync.Mutex
+	maxEntries int
+	entries    map[string]*list.Element
+	lru        *list.List
+}
+
+// newStore creates a new store.
+func newStore(maxEntries int) *store {
+	return &store{
+		maxEntries: maxEntries,
+		entries:    make(map[string]*list.Element),
+		lru:        list.New(),
+	}
+}
+
+// get obtains the value for the given key, if present and unexpired.
+func (s *store) get(key string) (any, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	element, exists := s.entries[key]
+	if !exists {
+		return nil, false
+	}
+	e := element.Value.(*entry)
+	if !e.expiry.IsZero() && time.Now().After(e.expiry) {
+		s.remove(element)
+
+		return nil, false
+	}
+	s.lru.MoveToFront(element)
+
+	return e.value, true
+}
+
+// set stores the value for the given key.
+// A zero expiry marks the value as never expiring.
+func (s *store) set(key string, value any, expiry time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if element, exists := s.entries[key]; exists {
+		e := element.Value.(*entry)
+		e.value = value
+		e.expiry = expiry
+		s.lru.MoveToFront(element)
+
+		return
+	}
+
+	s.entries[key] = s.lru.PushFront(&entry{
+		key:    key,
+		value:  value,
+		expiry: expiry,
+	})
+	for s.lru.Len() > s.maxEntries {
+		s.remove(s.lru.Back())
+	}
+}
+
+// purgeUnfinalized removes all entries that are not finalized.
+func (s *store) purgeUnfinalized() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for element := s.lru.Front(); element != nil; {
+		next := element.Next()
+		if !element.Value.(*entry).expiry.IsZero() {
+			s.remove(element)
+		}
+		element = next
+	}
+}
+
+// len returns the number of entries in the store.
+func (s *store) len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.lru.Len()
+}
+
+// remove removes an element from the store.
+// This assumes the lock is held.
+func (s *store) remove(element *list.Element) {
+	s.lru.Remove(element)
+	delete(s.entries, element.Value.(*entry).key)
+}
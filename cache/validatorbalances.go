@@ -0,0 +1,65 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// ValidatorBalances provides the validator balances for the given options.
+// Requests that supply a balance function are not cached.
+// The response is shared with other callers, so must not be altered.
+func (s *Service) ValidatorBalances(ctx context.Context,
+	opts *api.ValidatorBalancesOpts,
+) (
+	*api.Response[map[phase0.ValidatorIndex]phase0.Gwei],
+	error,
+) {
+	provider, isProvider := s.client.(consensusclient.ValidatorBalancesProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide validator balances")
+	}
+	if opts == nil || opts.BalanceFunc != nil {
+		return provider.ValidatorBalances(ctx, opts)
+	}
+
+	indices := make([]phase0.ValidatorIndex, len(opts.Indices))
+	copy(indices, opts.Indices)
+	sort.Slice(indices, func(i, j int) bool {
+		return indices[i] < indices[j]
+	})
+	var key strings.Builder
+	key.WriteString("validatorbalances:")
+	key.WriteString(opts.State)
+	for _, index := range indices {
+		key.WriteString(fmt.Sprintf(":%d", index))
+	}
+
+	return cached(ctx, s, s.store, key.String(), opts.State,
+		func() (*api.Response[map[phase0.ValidatorIndex]phase0.Gwei], error) {
+			return provider.ValidatorBalances(ctx, opts)
+		},
+		func(_ *api.Response[map[phase0.ValidatorIndex]phase0.Gwei]) (phase0.Slot, bool) {
+			return slotFromID(opts.State)
+		},
+	)
+}
@@ -0,0 +1,56 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+// AttestationRewards provides the attestation rewards for the given options.
+func (s *Service) AttestationRewards(ctx context.Context,
+	opts *api.AttestationRewardsOpts,
+) (
+	*api.Response[*apiv1.AttestationRewards],
+	error,
+) {
+	if opts == nil {
+		return nil, errors.New("no options specified")
+	}
+
+	reqBody, err := validatorIDsJSON(opts.Indices, opts.PubKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("/eth/v1/beacon/rewards/attestations/%d", opts.Epoch)
+	respBodyReader, err := s.idempotentPost(ctx, url, reqBody, &opts.Common)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request attestation rewards")
+	}
+
+	data, metadata, err := decodeJSONResponse(respBodyReader, apiv1.AttestationRewards{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[*apiv1.AttestationRewards]{
+		Data:     &data,
+		Metadata: metadata,
+	}, nil
+}
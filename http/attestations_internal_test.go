@@ -0,0 +1,222 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"io"
+	nethttp "net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testAttestationDataJSON    = `{"slot":"1","index":"0","beacon_block_root":"0xff27c7551bf4cfe4dc4cce00920e7a5c5074860d1dbd8aa8b3b5f888523f51ff","source":{"epoch":"0","root":"0x38758fb180459583bd5e8e1a31711eb09e63eb92be974485397e9a2c57de2783"},"target":{"epoch":"0","root":"0x46d4629861bd81cfc94007501b4edb1b3ca9444b41d7a98681b6c2f4bdb978bd"}}`
+	testSignatureJSON          = `"0xacb9f562a28c4ef5b60b88678068ea51573a3237d3331dda3b2d845a0d03bc56ab2994d2deb90d9f074a8bdab59945150d0a7717e74b1bf2627f8971c81091f724c211dfce8fa16fb839c6a1bfd341ddec5e7eb88472682fd1a170e373660534"`
+	testPhase0AttestationJSON  = `{"aggregation_bits":"0x03","data":` + testAttestationDataJSON + `,"signature":` + testSignatureJSON + `}`
+	testElectraAttestationJSON = `{"aggregation_bits":"0x03","data":` + testAttestationDataJSON + `,"signature":` + testSignatureJSON + `,"committee_bits":"0x0100000000000000"}`
+	testSpecJSON               = `{"data":{"SLOTS_PER_EPOCH":"32","ALTAIR_FORK_EPOCH":"0","BELLATRIX_FORK_EPOCH":"0","CAPELLA_FORK_EPOCH":"1","DENEB_FORK_EPOCH":"18446744073709551615"}}`
+)
+
+// attestationTestService returns a service connected to a server that uses the supplied handler.
+func attestationTestService(t *testing.T, handler nethttp.Handler) *Service {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	base, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	return &Service{
+		log:     zerolog.Nop(),
+		base:    base,
+		address: srv.URL,
+		client:  srv.Client(),
+		timeout: 5 * time.Second,
+	}
+}
+
+func writeJSON(w nethttp.ResponseWriter, headers map[string]string, body string) {
+	for k, v := range headers {
+		w.Header().Set(k, v)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte(body))
+}
+
+func TestVersionedAttestationPoolElectra(t *testing.T) {
+	mux := nethttp.NewServeMux()
+	mux.HandleFunc("/eth/v2/beacon/pool/attestations", func(w nethttp.ResponseWriter, _ *nethttp.Request) {
+		writeJSON(w, map[string]string{"Eth-Consensus-Version": "electra"}, `{"data":[`+testElectraAttestationJSON+`]}`)
+	})
+	s := attestationTestService(t, mux)
+
+	response, err := s.VersionedAttestationPool(context.Background(), &api.AttestationPoolOpts{Slot: 1})
+	require.NoError(t, err)
+	require.Len(t, response.Data, 1)
+	require.Equal(t, spec.DataVersionElectra, response.Data[0].Version)
+	require.NotNil(t, response.Data[0].Electra)
+	committeeIndex, err := response.Data[0].CommitteeIndex()
+	require.NoError(t, err)
+	require.Equal(t, phase0.CommitteeIndex(0), committeeIndex)
+}
+
+func TestVersionedAttestationPoolV1Fallback(t *testing.T) {
+	mux := nethttp.NewServeMux()
+	mux.HandleFunc("/eth/v2/beacon/pool/attestations", func(w nethttp.ResponseWriter, _ *nethttp.Request) {
+		w.WriteHeader(nethttp.StatusNotFound)
+	})
+	mux.HandleFunc("/eth/v1/beacon/pool/attestations", func(w nethttp.ResponseWriter, _ *nethttp.Request) {
+		writeJSON(w, nil, `{"data":[`+testPhase0AttestationJSON+`]}`)
+	})
+	mux.HandleFunc("/eth/v1/config/spec", func(w nethttp.ResponseWriter, _ *nethttp.Request) {
+		writeJSON(w, nil, testSpecJSON)
+	})
+	s := attestationTestService(t, mux)
+
+	response, err := s.VersionedAttestationPool(context.Background(), &api.AttestationPoolOpts{Slot: 1})
+	require.NoError(t, err)
+	require.Len(t, response.Data, 1)
+	// Slot 1 is in epoch 0, which is Bellatrix according to the spec.
+	require.Equal(t, spec.DataVersionBellatrix, response.Data[0].Version)
+	require.NotNil(t, response.Data[0].Bellatrix)
+}
+
+func TestVersionedAggregateAttestationElectra(t *testing.T) {
+	attestation := &electra.Attestation{}
+	require.NoError(t, attestation.UnmarshalJSON([]byte(testElectraAttestationJSON)))
+	dataRoot, err := attestation.Data.HashTreeRoot()
+	require.NoError(t, err)
+
+	mux := nethttp.NewServeMux()
+	mux.HandleFunc("/eth/v2/validator/aggregate_attestation", func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.URL.Query().Get("committee_index") != "0" {
+			w.WriteHeader(nethttp.StatusBadRequest)
+
+			return
+		}
+		writeJSON(w, map[string]string{"Eth-Consensus-Version": "electra"}, `{"data":`+testElectraAttestationJSON+`}`)
+	})
+	s := attestationTestService(t, mux)
+
+	response, err := s.VersionedAggregateAttestation(context.Background(), &api.AggregateAttestationOpts{
+		Slot:                1,
+		AttestationDataRoot: dataRoot,
+	})
+	require.NoError(t, err)
+	require.Equal(t, spec.DataVersionElectra, response.Data.Version)
+	require.Equal(t, attestation, response.Data.Electra)
+}
+
+func TestSubmitVersionedAttestationsEndpoint(t *testing.T) {
+	phase0Attestation := &phase0.Attestation{}
+	require.NoError(t, phase0Attestation.UnmarshalJSON([]byte(testPhase0AttestationJSON)))
+	electraAttestation := &electra.Attestation{}
+	require.NoError(t, electraAttestation.UnmarshalJSON([]byte(testElectraAttestationJSON)))
+
+	tests := []struct {
+		name         string
+		attestations []*spec.VersionedAttestation
+		v2Status     int
+		endpoint     string
+		version      string
+		err          string
+	}{
+		{
+			name: "Electra",
+			attestations: []*spec.VersionedAttestation{
+				{Version: spec.DataVersionElectra, Electra: electraAttestation},
+			},
+			v2Status: nethttp.StatusOK,
+			endpoint: "/eth/v2/beacon/pool/attestations",
+			version:  "electra",
+		},
+		{
+			name: "DenebV1Fallback",
+			attestations: []*spec.VersionedAttestation{
+				{Version: spec.DataVersionDeneb, Deneb: phase0Attestation},
+			},
+			v2Status: nethttp.StatusNotFound,
+			endpoint: "/eth/v1/beacon/pool/attestations",
+		},
+		{
+			name: "ElectraNoV1Fallback",
+			attestations: []*spec.VersionedAttestation{
+				{Version: spec.DataVersionElectra, Electra: electraAttestation},
+			},
+			v2Status: nethttp.StatusNotFound,
+			err:      "failed to submit beacon attestations: POST failed with status 404",
+		},
+		{
+			name: "Missing",
+			attestations: []*spec.VersionedAttestation{
+				{Version: spec.DataVersionElectra, Deneb: phase0Attestation},
+			},
+			err: "invalid attestation 0: no electra attestation",
+		},
+		{
+			name: "MixedVersions",
+			attestations: []*spec.VersionedAttestation{
+				{Version: spec.DataVersionDeneb, Deneb: phase0Attestation},
+				{Version: spec.DataVersionElectra, Electra: electraAttestation},
+			},
+			err: "attestations have differing versions",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var endpoint, version, body string
+			mux := nethttp.NewServeMux()
+			mux.HandleFunc("/eth/v2/beacon/pool/attestations", func(w nethttp.ResponseWriter, r *nethttp.Request) {
+				if test.v2Status != nethttp.StatusOK {
+					w.WriteHeader(test.v2Status)
+
+					return
+				}
+				data, _ := io.ReadAll(r.Body)
+				endpoint, version, body = r.URL.Path, r.Header.Get("Eth-Consensus-Version"), string(data)
+			})
+			mux.HandleFunc("/eth/v1/beacon/pool/attestations", func(_ nethttp.ResponseWriter, r *nethttp.Request) {
+				data, _ := io.ReadAll(r.Body)
+				endpoint, version, body = r.URL.Path, r.Header.Get("Eth-Consensus-Version"), string(data)
+			})
+			s := attestationTestService(t, mux)
+
+			err := s.SubmitVersionedAttestations(context.Background(), &api.SubmitAttestationsOpts{
+				Attestations: test.attestations,
+			})
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+				require.Empty(t, endpoint)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.endpoint, endpoint)
+			require.Equal(t, test.version, version)
+			require.Contains(t, body, `"aggregation_bits":"0x03"`)
+		})
+	}
+}
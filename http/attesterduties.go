@@ -57,7 +57,7 @@ func (s *Service) AttesterDuties(ctx context.Context,
 	}
 
 	url := fmt.Sprintf("/eth/v1/validator/duties/attester/%d", opts.Epoch)
-	respBodyReader, err := s.post(ctx, url, &reqBodyReader)
+	respBodyReader, err := s.idempotentPost(ctx, url, reqBodyReader.Bytes(), &opts.Common)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request attester duties")
 	}
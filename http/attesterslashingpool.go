@@ -0,0 +1,51 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// AttesterSlashingPool obtains the attester slashing pool.
+func (s *Service) AttesterSlashingPool(ctx context.Context,
+	opts *api.AttesterSlashingPoolOpts,
+) (
+	*api.Response[[]*phase0.AttesterSlashing],
+	error,
+) {
+	if opts == nil {
+		return nil, errors.New("no options specified")
+	}
+
+	url := "/eth/v1/beacon/pool/attester_slashings"
+	httpResponse, err := s.get(ctx, url, &opts.Common)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request attester slashing pool")
+	}
+
+	data, metadata, err := decodeJSONResponse(bytes.NewReader(httpResponse.body), []*phase0.AttesterSlashing{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[[]*phase0.AttesterSlashing]{
+		Data:     data,
+		Metadata: metadata,
+	}, nil
+}
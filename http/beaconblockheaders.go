@@ -0,0 +1,65 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+// BeaconBlockHeaders provides the block headers matching the opts.
+// If no filters are supplied this will return the header of the canonical head block.
+func (s *Service) BeaconBlockHeaders(ctx context.Context,
+	opts *api.BeaconBlockHeadersOpts,
+) (
+	*api.Response[[]*apiv1.BeaconBlockHeader],
+	error,
+) {
+	if opts == nil {
+		return nil, errors.New("no options specified")
+	}
+
+	url := "/eth/v1/beacon/headers"
+	additionalFields := make([]string, 0, 2)
+	if opts.Slot != nil {
+		additionalFields = append(additionalFields, fmt.Sprintf("slot=%d", *opts.Slot))
+	}
+	if opts.ParentRoot != nil {
+		additionalFields = append(additionalFields, fmt.Sprintf("parent_root=%#x", *opts.ParentRoot))
+	}
+	if len(additionalFields) > 0 {
+		url = fmt.Sprintf("%s?%s", url, strings.Join(additionalFields, "&"))
+	}
+
+	httpResponse, err := s.get(ctx, url, &opts.Common)
+	if err != nil {
+		return nil, err
+	}
+
+	data, metadata, err := decodeJSONResponse(bytes.NewReader(httpResponse.body), []*apiv1.BeaconBlockHeader{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[[]*apiv1.BeaconBlockHeader]{
+		Metadata: metadata,
+		Data:     data,
+	}, nil
+}
@@ -16,7 +16,9 @@ package http
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 
 	"github.com/attestantio/go-eth2-client/api"
 	"github.com/attestantio/go-eth2-client/spec"
@@ -24,6 +26,7 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 )
@@ -43,10 +46,11 @@ func (s *Service) BeaconState(ctx context.Context,
 	}
 
 	url := fmt.Sprintf("/eth/v2/debug/beacon/states/%s", opts.State)
-	httpResponse, err := s.get(ctx, url, &opts.Common)
+	httpResponse, err := s.getStream(ctx, url, &opts.Common)
 	if err != nil {
 		return nil, err
 	}
+	defer httpResponse.bodyReader.Close()
 
 	switch httpResponse.contentType {
 	case ContentTypeSSZ:
@@ -59,47 +63,32 @@ func (s *Service) BeaconState(ctx context.Context,
 }
 
 func (s *Service) beaconStateFromSSZ(res *httpResponse) (*api.Response[*spec.VersionedBeaconState], error) {
-	response := &api.Response[*spec.VersionedBeaconState]{
-		Data: &spec.VersionedBeaconState{
-			Version: res.consensusVersion,
-		},
-		Metadata: metadataFromHeaders(res.headers),
+	data, err := decodeBeaconStateSSZ(res.bodyReader, res.consensusVersion)
+	if err != nil {
+		return nil, err
 	}
 
-	switch res.consensusVersion {
-	case spec.DataVersionPhase0:
-		response.Data.Phase0 = &phase0.BeaconState{}
-		if err := response.Data.Phase0.UnmarshalSSZ(res.body); err != nil {
-			return nil, errors.Wrap(err, "failed to decode phase0 beacon state")
-		}
-	case spec.DataVersionAltair:
-		response.Data.Altair = &altair.BeaconState{}
-		if err := response.Data.Altair.UnmarshalSSZ(res.body); err != nil {
-			return nil, errors.Wrap(err, "failed to decode altair beacon state")
-		}
-	case spec.DataVersionBellatrix:
-		response.Data.Bellatrix = &bellatrix.BeaconState{}
-		if err := response.Data.Bellatrix.UnmarshalSSZ(res.body); err != nil {
-			return nil, errors.Wrap(err, "failed to decode bellatrix beacon state")
-		}
-	case spec.DataVersionCapella:
-		response.Data.Capella = &capella.BeaconState{}
-		if err := response.Data.Capella.UnmarshalSSZ(res.body); err != nil {
-			return nil, errors.Wrap(err, "failed to decode capella beacon state")
+	return &api.Response[*spec.VersionedBeaconState]{
+		Data:     data,
+		Metadata: metadataFromHeaders(res.headers),
+	}, nil
+}
+
+func (s *Service) beaconStateFromJSON(res *httpResponse) (*api.Response[*spec.VersionedBeaconState], error) {
+	if res.consensusVersion == spec.DataVersionUnknown {
+		// The consensus version was not supplied in the headers, so we need
+		// to read the body to find it before decoding the state.
+		var err error
+		res.body, err = io.ReadAll(res.bodyReader)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read body")
 		}
-	case spec.DataVersionDeneb:
-		response.Data.Deneb = &deneb.BeaconState{}
-		if err := response.Data.Deneb.UnmarshalSSZ(res.body); err != nil {
-			return nil, errors.Wrap(err, "failed to decode deneb beacon state")
+		if err := populateConsensusVersionFromBody(res); err != nil {
+			return nil, errors.Wrap(err, "failed to parse consensus version")
 		}
-	default:
-		return nil, fmt.Errorf("unhandled state version %s", res.consensusVersion)
+		res.bodyReader = io.NopCloser(bytes.NewReader(res.body))
 	}
 
-	return response, nil
-}
-
-func (s *Service) beaconStateFromJSON(res *httpResponse) (*api.Response[*spec.VersionedBeaconState], error) {
 	response := &api.Response[*spec.VersionedBeaconState]{
 		Data: &spec.VersionedBeaconState{
 			Version: res.consensusVersion,
@@ -109,15 +98,35 @@ func (s *Service) beaconStateFromJSON(res *httpResponse) (*api.Response[*spec.Ve
 	var err error
 	switch res.consensusVersion {
 	case spec.DataVersionPhase0:
-		response.Data.Phase0, response.Metadata, err = decodeJSONResponse(bytes.NewReader(res.body), &phase0.BeaconState{})
+		response.Data.Phase0 = &phase0.BeaconState{}
+		response.Metadata, err = decodeJSONResponseStream(res.bodyReader, func(decoder *json.Decoder) error {
+			return decoder.Decode(response.Data.Phase0)
+		})
 	case spec.DataVersionAltair:
-		response.Data.Altair, response.Metadata, err = decodeJSONResponse(bytes.NewReader(res.body), &altair.BeaconState{})
+		response.Data.Altair = &altair.BeaconState{}
+		response.Metadata, err = decodeJSONResponseStream(res.bodyReader, func(decoder *json.Decoder) error {
+			return decoder.Decode(response.Data.Altair)
+		})
 	case spec.DataVersionBellatrix:
-		response.Data.Bellatrix, response.Metadata, err = decodeJSONResponse(bytes.NewReader(res.body), &bellatrix.BeaconState{})
+		response.Data.Bellatrix = &bellatrix.BeaconState{}
+		response.Metadata, err = decodeJSONResponseStream(res.bodyReader, func(decoder *json.Decoder) error {
+			return decoder.Decode(response.Data.Bellatrix)
+		})
 	case spec.DataVersionCapella:
-		response.Data.Capella, response.Metadata, err = decodeJSONResponse(bytes.NewReader(res.body), &capella.BeaconState{})
+		response.Data.Capella = &capella.BeaconState{}
+		response.Metadata, err = decodeJSONResponseStream(res.bodyReader, func(decoder *json.Decoder) error {
+			return decoder.Decode(response.Data.Capella)
+		})
 	case spec.DataVersionDeneb:
-		response.Data.Deneb, response.Metadata, err = decodeJSONResponse(bytes.NewReader(res.body), &deneb.BeaconState{})
+		response.Data.Deneb = &deneb.BeaconState{}
+		response.Metadata, err = decodeJSONResponseStream(res.bodyReader, func(decoder *json.Decoder) error {
+			return decoder.Decode(response.Data.Deneb)
+		})
+	case spec.DataVersionElectra:
+		response.Data.Electra = &electra.BeaconState{}
+		response.Metadata, err = decodeJSONResponseStream(res.bodyReader, func(decoder *json.Decoder) error {
+			return decoder.Decode(response.Data.Electra)
+		})
 	default:
 		err = fmt.Errorf("unsupported version %s", res.consensusVersion)
 	}
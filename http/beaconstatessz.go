@@ -0,0 +1,204 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+const (
+	// stateValidatorsOffsetPos is the position of the offset of the validators
+	// field in the SSZ encoding of the beacon state.  It is the same for all forks.
+	stateValidatorsOffsetPos = 524552
+	// stateBalancesOffsetPos is the position of the offset of the balances
+	// field in the SSZ encoding of the beacon state.  It is the same for all forks.
+	stateBalancesOffsetPos = 524556
+	// validatorSSZSize is the size of the SSZ encoding of a validator.
+	validatorSSZSize = 121
+	// balanceSSZSize is the size of the SSZ encoding of a balance.
+	balanceSSZSize = 8
+)
+
+// beaconStateSSZLayout is the layout of the fixed part of the SSZ encoding
+// of a beacon state, as required to stream the state.
+type beaconStateSSZLayout struct {
+	// fixedSize is the size of the fixed part of the state.
+	fixedSize int
+	// trailingOffsetPos are the positions of the offsets of the variable
+	// fields that follow the balances field.
+	trailingOffsetPos []int
+}
+
+var beaconStateSSZLayouts = map[spec.DataVersion]*beaconStateSSZLayout{
+	spec.DataVersionPhase0: {
+		fixedSize:         2687377,
+		trailingOffsetPos: []int{2687248, 2687252},
+	},
+	spec.DataVersionAltair: {
+		fixedSize:         2736629,
+		trailingOffsetPos: []int{2687248, 2687252, 2687377},
+	},
+	spec.DataVersionBellatrix: {
+		fixedSize:         2736633,
+		trailingOffsetPos: []int{2687248, 2687252, 2687377, 2736629},
+	},
+	spec.DataVersionCapella: {
+		fixedSize:         2736653,
+		trailingOffsetPos: []int{2687248, 2687252, 2687377, 2736629, 2736649},
+	},
+	spec.DataVersionDeneb: {
+		fixedSize:         2736653,
+		trailingOffsetPos: []int{2687248, 2687252, 2687377, 2736629, 2736649},
+	},
+	spec.DataVersionElectra: {
+		fixedSize:         2736713,
+		trailingOffsetPos: []int{2687248, 2687252, 2687377, 2736629, 2736649, 2736701, 2736705, 2736709},
+	},
+}
+
+// decodeBeaconStateSSZ decodes an SSZ-encoded beacon state as it is read.
+//
+// The validators and balances, which make up the bulk of the state, are
+// decoded an element at a time; the remainder of the state is decoded with
+// its generated SSZ decoder.  This avoids holding the full encoded state in
+// memory alongside the decoded state.
+func decodeBeaconStateSSZ(reader io.Reader, version spec.DataVersion) (*spec.VersionedBeaconState, error) {
+	layout, exists := beaconStateSSZLayouts[version]
+	if !exists {
+		return nil, fmt.Errorf("unhandled state version %s", version)
+	}
+	reader = bufio.NewReaderSize(reader, 1024*1024)
+
+	fixed := make([]byte, layout.fixedSize)
+	if _, err := io.ReadFull(reader, fixed); err != nil {
+		return nil, errors.Wrap(err, "failed to read fixed part of state")
+	}
+	validatorsOffset := int(binary.LittleEndian.Uint32(fixed[stateValidatorsOffsetPos:]))
+	balancesOffset := int(binary.LittleEndian.Uint32(fixed[stateBalancesOffsetPos:]))
+	balancesEnd := int(binary.LittleEndian.Uint32(fixed[layout.trailingOffsetPos[0]:]))
+	if validatorsOffset < layout.fixedSize || balancesOffset < validatorsOffset || balancesEnd < balancesOffset {
+		return nil, errors.New("invalid offsets in state")
+	}
+	if (balancesOffset-validatorsOffset)%validatorSSZSize != 0 {
+		return nil, errors.New("invalid length for validators")
+	}
+	if (balancesEnd-balancesOffset)%balanceSSZSize != 0 {
+		return nil, errors.New("invalid length for balances")
+	}
+
+	leading := make([]byte, validatorsOffset-layout.fixedSize)
+	if _, err := io.ReadFull(reader, leading); err != nil {
+		return nil, errors.Wrap(err, "failed to read state")
+	}
+
+	validators := make([]*phase0.Validator, (balancesOffset-validatorsOffset)/validatorSSZSize)
+	buf := make([]byte, validatorSSZSize)
+	for i := range validators {
+		if _, err := io.ReadFull(reader, buf); err != nil {
+			return nil, errors.Wrap(err, "failed to read validator")
+		}
+		validators[i] = &phase0.Validator{}
+		if err := validators[i].UnmarshalSSZ(buf); err != nil {
+			return nil, errors.Wrapf(err, "failed to decode validator %d", i)
+		}
+	}
+
+	balances := make([]phase0.Gwei, (balancesEnd-balancesOffset)/balanceSSZSize)
+	for i := range balances {
+		if _, err := io.ReadFull(reader, buf[:balanceSSZSize]); err != nil {
+			return nil, errors.Wrap(err, "failed to read balance")
+		}
+		balances[i] = phase0.Gwei(binary.LittleEndian.Uint64(buf[:balanceSSZSize]))
+	}
+
+	trailing, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read state")
+	}
+
+	// Reassemble the state without the validators and balances, and adjust the
+	// offsets to match.
+	removed := uint32(balancesEnd - validatorsOffset)
+	data := make([]byte, 0, len(fixed)+len(leading)+len(trailing))
+	data = append(data, fixed...)
+	data = append(data, leading...)
+	data = append(data, trailing...)
+	binary.LittleEndian.PutUint32(data[stateBalancesOffsetPos:], uint32(validatorsOffset))
+	for _, pos := range layout.trailingOffsetPos {
+		offset := binary.LittleEndian.Uint32(data[pos:])
+		binary.LittleEndian.PutUint32(data[pos:], offset-removed)
+	}
+
+	res := &spec.VersionedBeaconState{
+		Version: version,
+	}
+	switch version {
+	case spec.DataVersionPhase0:
+		res.Phase0 = &phase0.BeaconState{}
+		if err := res.Phase0.UnmarshalSSZ(data); err != nil {
+			return nil, errors.Wrap(err, "failed to decode phase0 beacon state")
+		}
+		res.Phase0.Validators = validators
+		res.Phase0.Balances = balances
+	case spec.DataVersionAltair:
+		res.Altair = &altair.BeaconState{}
+		if err := res.Altair.UnmarshalSSZ(data); err != nil {
+			return nil, errors.Wrap(err, "failed to decode altair beacon state")
+		}
+		res.Altair.Validators = validators
+		res.Altair.Balances = balances
+	case spec.DataVersionBellatrix:
+		res.Bellatrix = &bellatrix.BeaconState{}
+		if err := res.Bellatrix.UnmarshalSSZ(data); err != nil {
+			return nil, errors.Wrap(err, "failed to decode bellatrix beacon state")
+		}
+		res.Bellatrix.Validators = validators
+		res.Bellatrix.Balances = balances
+	case spec.DataVersionCapella:
+		res.Capella = &capella.BeaconState{}
+		if err := res.Capella.UnmarshalSSZ(data); err != nil {
+			return nil, errors.Wrap(err, "failed to decode capella beacon state")
+		}
+		res.Capella.Validators = validators
+		res.Capella.Balances = balances
+	case spec.DataVersionDeneb:
+		res.Deneb = &deneb.BeaconState{}
+		if err := res.Deneb.UnmarshalSSZ(data); err != nil {
+			return nil, errors.Wrap(err, "failed to decode deneb beacon state")
+		}
+		res.Deneb.Validators = validators
+		res.Deneb.Balances = balances
+	case spec.DataVersionElectra:
+		res.Electra = &electra.BeaconState{}
+		if err := res.Electra.UnmarshalSSZ(data); err != nil {
+			return nil, errors.Wrap(err, "failed to decode electra beacon state")
+		}
+		res.Electra.Validators = validators
+		res.Electra.Balances = balances
+	}
+
+	return res, nil
+}
@@ -0,0 +1,300 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+)
+
+func testRoots(n int, seed byte) []phase0.Root {
+	res := make([]phase0.Root, n)
+	for i := range res {
+		res[i] = phase0.Root{seed, byte(i), byte(i >> 8)}
+	}
+
+	return res
+}
+
+func testValidators(n int) ([]*phase0.Validator, []phase0.Gwei) {
+	validators := make([]*phase0.Validator, n)
+	balances := make([]phase0.Gwei, n)
+	for i := range validators {
+		validators[i] = &phase0.Validator{
+			PublicKey:             phase0.BLSPubKey{byte(i), byte(i >> 8)},
+			WithdrawalCredentials: make([]byte, 32),
+			EffectiveBalance:      32000000000,
+			ActivationEpoch:       phase0.Epoch(i),
+			ExitEpoch:             0xffffffffffffffff,
+			WithdrawableEpoch:     0xffffffffffffffff,
+		}
+		balances[i] = phase0.Gwei(32000000000 + i)
+	}
+
+	return validators, balances
+}
+
+func testSyncCommittee() *altair.SyncCommittee {
+	return &altair.SyncCommittee{
+		Pubkeys: make([]phase0.BLSPubKey, 512),
+	}
+}
+
+type sszState interface {
+	MarshalSSZ() ([]byte, error)
+	UnmarshalSSZ(buf []byte) error
+}
+
+func TestDecodeBeaconStateSSZ(t *testing.T) {
+	validators, balances := testValidators(37)
+	votes := []*phase0.ETH1Data{{BlockHash: make([]byte, 32), DepositCount: 5}}
+	participation := []altair.ParticipationFlags{1, 2, 3}
+
+	tests := []struct {
+		name    string
+		version spec.DataVersion
+		state   sszState
+		empty   sszState
+	}{
+		{
+			name:    "Phase0",
+			version: spec.DataVersionPhase0,
+			state: &phase0.BeaconState{
+				Fork:              &phase0.Fork{},
+				LatestBlockHeader: &phase0.BeaconBlockHeader{},
+				BlockRoots:        testRoots(8192, 1),
+				StateRoots:        testRoots(8192, 2),
+				HistoricalRoots:   testRoots(3, 3),
+				ETH1Data:          &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+				ETH1DataVotes:     votes,
+				Validators:        validators,
+				Balances:          balances,
+				RANDAOMixes:       testRoots(65536, 4),
+				Slashings:         make([]phase0.Gwei, 8192),
+				PreviousEpochAttestations: []*phase0.PendingAttestation{
+					{
+						AggregationBits: []byte{0x01},
+						Data: &phase0.AttestationData{
+							Source: &phase0.Checkpoint{},
+							Target: &phase0.Checkpoint{},
+						},
+						InclusionDelay: 1,
+					},
+				},
+				JustificationBits:           []byte{0x0f},
+				PreviousJustifiedCheckpoint: &phase0.Checkpoint{},
+				CurrentJustifiedCheckpoint:  &phase0.Checkpoint{},
+				FinalizedCheckpoint:         &phase0.Checkpoint{Epoch: 5},
+			},
+			empty: &phase0.BeaconState{},
+		},
+		{
+			name:    "Altair",
+			version: spec.DataVersionAltair,
+			state: &altair.BeaconState{
+				Fork:                        &phase0.Fork{},
+				LatestBlockHeader:           &phase0.BeaconBlockHeader{},
+				BlockRoots:                  testRoots(8192, 1),
+				StateRoots:                  testRoots(8192, 2),
+				HistoricalRoots:             testRoots(3, 3),
+				ETH1Data:                    &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+				ETH1DataVotes:               votes,
+				Validators:                  validators,
+				Balances:                    balances,
+				RANDAOMixes:                 testRoots(65536, 4),
+				Slashings:                   make([]phase0.Gwei, 8192),
+				PreviousEpochParticipation:  participation,
+				CurrentEpochParticipation:   participation,
+				JustificationBits:           []byte{0x0f},
+				PreviousJustifiedCheckpoint: &phase0.Checkpoint{},
+				CurrentJustifiedCheckpoint:  &phase0.Checkpoint{},
+				FinalizedCheckpoint:         &phase0.Checkpoint{Epoch: 5},
+				InactivityScores:            []uint64{1, 2, 3},
+				CurrentSyncCommittee:        testSyncCommittee(),
+				NextSyncCommittee:           testSyncCommittee(),
+			},
+			empty: &altair.BeaconState{},
+		},
+		{
+			name:    "Bellatrix",
+			version: spec.DataVersionBellatrix,
+			state: &bellatrix.BeaconState{
+				Fork:                         &phase0.Fork{},
+				LatestBlockHeader:            &phase0.BeaconBlockHeader{},
+				BlockRoots:                   testRoots(8192, 1),
+				StateRoots:                   testRoots(8192, 2),
+				HistoricalRoots:              testRoots(3, 3),
+				ETH1Data:                     &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+				ETH1DataVotes:                votes,
+				Validators:                   validators,
+				Balances:                     balances,
+				RANDAOMixes:                  testRoots(65536, 4),
+				Slashings:                    make([]phase0.Gwei, 8192),
+				PreviousEpochParticipation:   participation,
+				CurrentEpochParticipation:    participation,
+				JustificationBits:            []byte{0x0f},
+				PreviousJustifiedCheckpoint:  &phase0.Checkpoint{},
+				CurrentJustifiedCheckpoint:   &phase0.Checkpoint{},
+				FinalizedCheckpoint:          &phase0.Checkpoint{Epoch: 5},
+				InactivityScores:             []uint64{1, 2, 3},
+				CurrentSyncCommittee:         testSyncCommittee(),
+				NextSyncCommittee:            testSyncCommittee(),
+				LatestExecutionPayloadHeader: &bellatrix.ExecutionPayloadHeader{ExtraData: []byte{0x01, 0x02}},
+			},
+			empty: &bellatrix.BeaconState{},
+		},
+		{
+			name:    "Capella",
+			version: spec.DataVersionCapella,
+			state: &capella.BeaconState{
+				Fork:                         &phase0.Fork{},
+				LatestBlockHeader:            &phase0.BeaconBlockHeader{},
+				BlockRoots:                   testRoots(8192, 1),
+				StateRoots:                   testRoots(8192, 2),
+				HistoricalRoots:              testRoots(3, 3),
+				ETH1Data:                     &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+				ETH1DataVotes:                votes,
+				Validators:                   validators,
+				Balances:                     balances,
+				RANDAOMixes:                  testRoots(65536, 4),
+				Slashings:                    make([]phase0.Gwei, 8192),
+				PreviousEpochParticipation:   participation,
+				CurrentEpochParticipation:    participation,
+				JustificationBits:            []byte{0x0f},
+				PreviousJustifiedCheckpoint:  &phase0.Checkpoint{},
+				CurrentJustifiedCheckpoint:   &phase0.Checkpoint{},
+				FinalizedCheckpoint:          &phase0.Checkpoint{Epoch: 5},
+				InactivityScores:             []uint64{1, 2, 3},
+				CurrentSyncCommittee:         testSyncCommittee(),
+				NextSyncCommittee:            testSyncCommittee(),
+				LatestExecutionPayloadHeader: &capella.ExecutionPayloadHeader{ExtraData: []byte{0x01, 0x02}},
+				HistoricalSummaries:          []*capella.HistoricalSummary{{}},
+			},
+			empty: &capella.BeaconState{},
+		},
+		{
+			name:    "Deneb",
+			version: spec.DataVersionDeneb,
+			state: &deneb.BeaconState{
+				Fork:                        &phase0.Fork{},
+				LatestBlockHeader:           &phase0.BeaconBlockHeader{},
+				BlockRoots:                  testRoots(8192, 1),
+				StateRoots:                  testRoots(8192, 2),
+				HistoricalRoots:             testRoots(3, 3),
+				ETH1Data:                    &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+				ETH1DataVotes:               votes,
+				Validators:                  validators,
+				Balances:                    balances,
+				RANDAOMixes:                 testRoots(65536, 4),
+				Slashings:                   make([]phase0.Gwei, 8192),
+				PreviousEpochParticipation:  participation,
+				CurrentEpochParticipation:   participation,
+				JustificationBits:           []byte{0x0f},
+				PreviousJustifiedCheckpoint: &phase0.Checkpoint{},
+				CurrentJustifiedCheckpoint:  &phase0.Checkpoint{},
+				FinalizedCheckpoint:         &phase0.Checkpoint{Epoch: 5},
+				InactivityScores:            []uint64{1, 2, 3},
+				CurrentSyncCommittee:        testSyncCommittee(),
+				NextSyncCommittee:           testSyncCommittee(),
+				LatestExecutionPayloadHeader: &deneb.ExecutionPayloadHeader{
+					ExtraData:     []byte{0x01, 0x02},
+					BaseFeePerGas: uint256.NewInt(7),
+				},
+				HistoricalSummaries: []*capella.HistoricalSummary{{}},
+			},
+			empty: &deneb.BeaconState{},
+		},
+		{
+			name:    "Electra",
+			version: spec.DataVersionElectra,
+			state: &electra.BeaconState{
+				Fork:                        &phase0.Fork{},
+				LatestBlockHeader:           &phase0.BeaconBlockHeader{},
+				BlockRoots:                  testRoots(8192, 1),
+				StateRoots:                  testRoots(8192, 2),
+				HistoricalRoots:             testRoots(3, 3),
+				ETH1Data:                    &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+				ETH1DataVotes:               votes,
+				Validators:                  validators,
+				Balances:                    balances,
+				RANDAOMixes:                 testRoots(65536, 4),
+				Slashings:                   make([]phase0.Gwei, 8192),
+				PreviousEpochParticipation:  participation,
+				CurrentEpochParticipation:   participation,
+				JustificationBits:           []byte{0x0f},
+				PreviousJustifiedCheckpoint: &phase0.Checkpoint{},
+				CurrentJustifiedCheckpoint:  &phase0.Checkpoint{},
+				FinalizedCheckpoint:         &phase0.Checkpoint{Epoch: 5},
+				InactivityScores:            []uint64{1, 2, 3},
+				CurrentSyncCommittee:        testSyncCommittee(),
+				NextSyncCommittee:           testSyncCommittee(),
+				LatestExecutionPayloadHeader: &electra.ExecutionPayloadHeader{
+					ExtraData:     []byte{0x01, 0x02},
+					BaseFeePerGas: uint256.NewInt(7),
+				},
+				HistoricalSummaries:       []*capella.HistoricalSummary{{}},
+				PendingBalanceDeposits:    []*electra.PendingBalanceDeposit{{Index: 1, Amount: 2}},
+				PendingPartialWithdrawals: []*electra.PendingPartialWithdrawal{{Index: 3, Amount: 4}},
+				PendingConsolidations:     []*electra.PendingConsolidation{{SourceIndex: 5, TargetIndex: 6}},
+			},
+			empty: &electra.BeaconState{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data, err := test.state.MarshalSSZ()
+			require.NoError(t, err)
+			require.NoError(t, test.empty.UnmarshalSSZ(data))
+
+			res, err := decodeBeaconStateSSZ(bytes.NewReader(data), test.version)
+			require.NoError(t, err)
+			require.Equal(t, test.version, res.Version)
+			var decoded sszState
+			switch test.version {
+			case spec.DataVersionPhase0:
+				decoded = res.Phase0
+			case spec.DataVersionAltair:
+				decoded = res.Altair
+			case spec.DataVersionBellatrix:
+				decoded = res.Bellatrix
+			case spec.DataVersionCapella:
+				decoded = res.Capella
+			case spec.DataVersionDeneb:
+				decoded = res.Deneb
+			case spec.DataVersionElectra:
+				decoded = res.Electra
+			}
+			require.Equal(t, test.empty, decoded)
+
+			_, err = decodeBeaconStateSSZ(bytes.NewReader(data[:len(data)/2]), test.version)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestDecodeBeaconStateSSZUnknownVersion(t *testing.T) {
+	_, err := decodeBeaconStateSSZ(bytes.NewReader([]byte{}), spec.DataVersionUnknown)
+	require.EqualError(t, err, "unhandled state version unknown")
+}
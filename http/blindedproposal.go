@@ -22,6 +22,7 @@ import (
 	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
 	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
 	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/pkg/errors"
 	"go.opentelemetry.io/otel"
@@ -127,6 +128,11 @@ func (s *Service) blindedProposalFromSSZ(res *httpResponse) (*api.Response[*api.
 		if err := response.Data.Deneb.UnmarshalSSZ(res.body); err != nil {
 			return nil, errors.Wrap(err, "failed to decode deneb blinded beacon block proposal")
 		}
+	case spec.DataVersionElectra:
+		response.Data.Electra = &apiv1electra.BlindedBeaconBlock{}
+		if err := response.Data.Electra.UnmarshalSSZ(res.body); err != nil {
+			return nil, errors.Wrap(err, "failed to decode electra blinded beacon block proposal")
+		}
 	default:
 		return nil, fmt.Errorf("unhandled block proposal version %s", res.consensusVersion)
 	}
@@ -149,6 +155,8 @@ func (s *Service) blindedProposalFromJSON(res *httpResponse) (*api.Response[*api
 		response.Data.Capella, response.Metadata, err = decodeJSONResponse(bytes.NewReader(res.body), &apiv1capella.BlindedBeaconBlock{})
 	case spec.DataVersionDeneb:
 		response.Data.Deneb, response.Metadata, err = decodeJSONResponse(bytes.NewReader(res.body), &apiv1deneb.BlindedBeaconBlock{})
+	case spec.DataVersionElectra:
+		response.Data.Electra, response.Metadata, err = decodeJSONResponse(bytes.NewReader(res.body), &apiv1electra.BlindedBeaconBlock{})
 	default:
 		return nil, fmt.Errorf("unsupported version %s", res.consensusVersion)
 	}
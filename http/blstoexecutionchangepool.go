@@ -0,0 +1,51 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/pkg/errors"
+)
+
+// BLSToExecutionChangePool obtains the BLS to execution change pool.
+func (s *Service) BLSToExecutionChangePool(ctx context.Context,
+	opts *api.BLSToExecutionChangePoolOpts,
+) (
+	*api.Response[[]*capella.SignedBLSToExecutionChange],
+	error,
+) {
+	if opts == nil {
+		return nil, errors.New("no options specified")
+	}
+
+	url := "/eth/v1/beacon/pool/bls_to_execution_changes"
+	httpResponse, err := s.get(ctx, url, &opts.Common)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request BLS to execution change pool")
+	}
+
+	data, metadata, err := decodeJSONResponse(bytes.NewReader(httpResponse.body), []*capella.SignedBLSToExecutionChange{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[[]*capella.SignedBLSToExecutionChange]{
+		Data:     data,
+		Metadata: metadata,
+	}, nil
+}
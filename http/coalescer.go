@@ -0,0 +1,99 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// coalescer allows concurrent identical requests to share a single in-flight request.
+// The zero value is ready for use.
+type coalescer struct {
+	mu    sync.Mutex
+	calls map[string]*coalescedCall
+}
+
+// coalescedCall is a request that is in flight.
+type coalescedCall struct {
+	done chan struct{}
+	res  *httpResponse
+	err  error
+}
+
+// do runs fn for the given key, unless a call for the same key is already in
+// flight in which case it waits for the result of that call.
+// fn is run with a context that is not cancelled when the calling context is,
+// as other callers may be waiting for its result; each caller stops waiting
+// when its own context is done.
+// The returned boolean is true if the response was obtained by another caller.
+// The returned response may be shared between callers, so must not be altered.
+func (c *coalescer) do(ctx context.Context,
+	key string,
+	fn func(ctx context.Context) (*httpResponse, error),
+) (
+	*httpResponse,
+	bool,
+	error,
+) {
+	c.mu.Lock()
+	if c.calls == nil {
+		c.calls = make(map[string]*coalescedCall)
+	}
+	call, exists := c.calls[key]
+	if !exists {
+		call = &coalescedCall{
+			done: make(chan struct{}),
+		}
+		c.calls[key] = call
+		go func() {
+			call.res, call.err = fn(detachedContext{ctx})
+			c.mu.Lock()
+			delete(c.calls, key)
+			c.mu.Unlock()
+			close(call.done)
+		}()
+	}
+	c.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.res, exists, call.err
+	case <-ctx.Done():
+		return nil, exists, ctx.Err()
+	}
+}
+
+// detachedContext is a context that retains the values of its parent but is
+// never cancelled.
+type detachedContext struct {
+	//nolint:containedctx
+	context.Context
+}
+
+// Deadline returns no deadline.
+func (detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+// Done returns a channel that is never closed.
+func (detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+// Err returns nil, as the context is never cancelled.
+func (detachedContext) Err() error {
+	return nil
+}
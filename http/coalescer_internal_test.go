@@ -0,0 +1,153 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	nethttp "net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// coalescingTestService returns a service connected to a server that does
+// not respond until released.
+func coalescingTestService(t *testing.T) (*Service, *int32, chan struct{}) {
+	t.Helper()
+
+	requests := int32(0)
+	release := make(chan struct{})
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		atomic.AddInt32(&requests, 1)
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	base, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	return &Service{
+		log:     zerolog.Nop(),
+		base:    base,
+		address: srv.URL,
+		client:  srv.Client(),
+		timeout: 5 * time.Second,
+	}, &requests, release
+}
+
+func TestGetCoalescing(t *testing.T) {
+	ctx := context.Background()
+	s, requests, release := coalescingTestService(t)
+
+	var wg sync.WaitGroup
+	responses := make([]*httpResponse, 8)
+	for i := range responses {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			res, err := s.get(ctx, "/eth/v1/test", &api.CommonOpts{})
+			require.NoError(t, err)
+			responses[i] = res
+		}(i)
+	}
+	// Allow all calls to join the in-flight request.
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	require.Equal(t, int32(1), atomic.LoadInt32(requests))
+	for i := range responses {
+		require.Equal(t, []byte(`{"data":{}}`), responses[i].body)
+	}
+
+	// Once complete, subsequent calls make a new request.
+	_, err := s.get(ctx, "/eth/v1/test", &api.CommonOpts{})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), atomic.LoadInt32(requests))
+}
+
+func TestGetCoalescingDisabled(t *testing.T) {
+	ctx := context.Background()
+	s, requests, release := coalescingTestService(t)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := s.get(ctx, "/eth/v1/test", &api.CommonOpts{DisableCoalescing: true})
+			require.NoError(t, err)
+		}()
+	}
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	require.Equal(t, int32(4), atomic.LoadInt32(requests))
+}
+
+func TestGetCoalescingCancel(t *testing.T) {
+	s, requests, release := coalescingTestService(t)
+
+	// The first caller gives up, but the request continues for the second caller.
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error)
+	go func() {
+		_, err := s.get(ctx, "/eth/v1/test", &api.CommonOpts{})
+		errCh <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+	resCh := make(chan *httpResponse)
+	go func() {
+		res, err := s.get(context.Background(), "/eth/v1/test", &api.CommonOpts{})
+		require.NoError(t, err)
+		resCh <- res
+	}()
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	require.ErrorIs(t, <-errCh, context.Canceled)
+	close(release)
+	require.NotNil(t, <-resCh)
+
+	require.Equal(t, int32(1), atomic.LoadInt32(requests))
+}
+
+func TestGetCoalescingTimeout(t *testing.T) {
+	ctx := context.Background()
+	s, requests, release := coalescingTestService(t)
+
+	// Calls with different timeouts do not share a request, so each is subject to its own timeout.
+	resCh := make(chan *httpResponse)
+	go func() {
+		res, err := s.get(ctx, "/eth/v1/test", &api.CommonOpts{})
+		require.NoError(t, err)
+		resCh <- res
+	}()
+	time.Sleep(50 * time.Millisecond)
+	_, err := s.get(ctx, "/eth/v1/test", &api.CommonOpts{Timeout: 50 * time.Millisecond})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	close(release)
+	require.NotNil(t, <-resCh)
+
+	require.Equal(t, int32(2), atomic.LoadInt32(requests))
+}
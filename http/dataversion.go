@@ -0,0 +1,62 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// forkEpochKeys are the spec keys for the epochs at which each fork activates.
+var forkEpochKeys = []struct {
+	key     string
+	version spec.DataVersion
+}{
+	{key: "ALTAIR_FORK_EPOCH", version: spec.DataVersionAltair},
+	{key: "BELLATRIX_FORK_EPOCH", version: spec.DataVersionBellatrix},
+	{key: "CAPELLA_FORK_EPOCH", version: spec.DataVersionCapella},
+	{key: "DENEB_FORK_EPOCH", version: spec.DataVersionDeneb},
+	{key: "ELECTRA_FORK_EPOCH", version: spec.DataVersionElectra},
+}
+
+// dataVersionAtSlot returns the data version of the chain at the given slot.
+// This is used for responses from endpoints that do not state their consensus version.
+func (s *Service) dataVersionAtSlot(ctx context.Context, slot phase0.Slot) (spec.DataVersion, error) {
+	specResponse, err := s.Spec(ctx, &api.SpecOpts{})
+	if err != nil {
+		return spec.DataVersionUnknown, errors.Wrap(err, "failed to obtain spec")
+	}
+
+	slotsPerEpoch, isUint := specResponse.Data["SLOTS_PER_EPOCH"].(uint64)
+	if !isUint || slotsPerEpoch == 0 {
+		return spec.DataVersionUnknown, errors.New("SLOTS_PER_EPOCH missing from spec")
+	}
+	epoch := uint64(slot) / slotsPerEpoch
+
+	version := spec.DataVersionPhase0
+	for _, fork := range forkEpochKeys {
+		forkEpoch, isUint := specResponse.Data[fork.key].(uint64)
+		if !isUint || epoch < forkEpoch {
+			// Fork not scheduled, or not yet reached.
+			break
+		}
+		version = fork.version
+	}
+
+	return version, nil
+}
@@ -0,0 +1,75 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+// DepositSnapshot provides the EIP-4881 snapshot of the finalized deposit tree.
+func (s *Service) DepositSnapshot(ctx context.Context,
+	opts *api.DepositSnapshotOpts,
+) (
+	*api.Response[*apiv1.DepositSnapshot],
+	error,
+) {
+	if opts == nil {
+		return nil, errors.New("no options specified")
+	}
+
+	url := "/eth/v1/beacon/deposit_snapshot"
+	httpResponse, err := s.get(ctx, url, &opts.Common)
+	if err != nil {
+		return nil, err
+	}
+
+	switch httpResponse.contentType {
+	case ContentTypeSSZ:
+		return s.depositSnapshotFromSSZ(httpResponse)
+	case ContentTypeJSON:
+		return s.depositSnapshotFromJSON(httpResponse)
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", httpResponse.contentType)
+	}
+}
+
+func (s *Service) depositSnapshotFromSSZ(res *httpResponse) (*api.Response[*apiv1.DepositSnapshot], error) {
+	response := &api.Response[*apiv1.DepositSnapshot]{
+		Data:     &apiv1.DepositSnapshot{},
+		Metadata: metadataFromHeaders(res.headers),
+	}
+	if err := response.Data.UnmarshalSSZ(res.body); err != nil {
+		return nil, errors.Wrap(err, "failed to decode deposit snapshot")
+	}
+
+	return response, nil
+}
+
+func (s *Service) depositSnapshotFromJSON(res *httpResponse) (*api.Response[*apiv1.DepositSnapshot], error) {
+	data, metadata, err := decodeJSONResponse(bytes.NewReader(res.body), apiv1.DepositSnapshot{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[*apiv1.DepositSnapshot]{
+		Data:     &data,
+		Metadata: metadata,
+	}, nil
+}
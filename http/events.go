@@ -33,6 +33,7 @@ import (
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/altair"
 	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/electra"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
@@ -61,12 +62,19 @@ func (s *Service) Events(ctx context.Context, topics []string, handler client.Ev
 	log.Trace().Str("url", url).Msg("GET request to events stream")
 
 	client := sse.NewClient(url)
-	client.Connection.Transport = &http.Transport{
+	transport := &http.Transport{
 		Dial: (&net.Dialer{
 			Timeout:   2 * time.Second,
 			KeepAlive: 2 * time.Second,
 		}).Dial,
 	}
+	if len(s.middleware) > 0 {
+		client.Connection.Transport = &middlewareTransport{
+			next: s.chain(transport.RoundTrip),
+		}
+	} else {
+		client.Connection.Transport = transport
+	}
 
 	go func() {
 		for {
@@ -128,14 +136,37 @@ func (s *Service) handleEvent(ctx context.Context, msg *sse.Event, handler clien
 		}
 		event.Data = blockEvent
 	case "attestation":
-		attestation := &phase0.Attestation{}
-		err := json.Unmarshal(msg.Data, attestation)
+		// Attestations from Electra onwards carry committee bits, and are
+		// supplied as electra.Attestation rather than phase0.Attestation.
+		type eventAttestationJSON struct {
+			CommitteeBits *string `json:"committee_bits"`
+		}
+		probe := &eventAttestationJSON{}
+		err := json.Unmarshal(msg.Data, probe)
 		if err != nil {
 			log.Error().Err(err).RawJSON("data", msg.Data).Msg("Failed to parse attestation")
 
 			return
 		}
-		event.Data = attestation
+		if probe.CommitteeBits != nil {
+			attestation := &electra.Attestation{}
+			err = json.Unmarshal(msg.Data, attestation)
+			if err != nil {
+				log.Error().Err(err).RawJSON("data", msg.Data).Msg("Failed to parse attestation")
+
+				return
+			}
+			event.Data = attestation
+		} else {
+			attestation := &phase0.Attestation{}
+			err = json.Unmarshal(msg.Data, attestation)
+			if err != nil {
+				log.Error().Err(err).RawJSON("data", msg.Data).Msg("Failed to parse attestation")
+
+				return
+			}
+			event.Data = attestation
+		}
 	case "voluntary_exit":
 		voluntaryExit := &phase0.SignedVoluntaryExit{}
 		err := json.Unmarshal(msg.Data, voluntaryExit)
@@ -99,6 +99,15 @@ func TestEventHandler(t *testing.T) {
 			handler: handler,
 			handled: true,
 		},
+		{
+			name: "AttestationElectraGood",
+			message: &sse.Event{
+				Event: []byte("attestation"),
+				Data:  []byte(`{"aggregation_bits":"0x00002840403040000000020008800040008042800000020220","data":{"slot":"4095945","index":"0","beacon_block_root":"0xff27c7551bf4cfe4dc4cce00920e7a5c5074860d1dbd8aa8b3b5f888523f51ff","source":{"epoch":"127997","root":"0x38758fb180459583bd5e8e1a31711eb09e63eb92be974485397e9a2c57de2783"},"target":{"epoch":"127998","root":"0x46d4629861bd81cfc94007501b4edb1b3ca9444b41d7a98681b6c2f4bdb978bd"}},"signature":"0xacb9f562a28c4ef5b60b88678068ea51573a3237d3331dda3b2d845a0d03bc56ab2994d2deb90d9f074a8bdab59945150d0a7717e74b1bf2627f8971c81091f724c211dfce8fa16fb839c6a1bfd341ddec5e7eb88472682fd1a170e373660534","committee_bits":"0x0010000000000000"}`),
+			},
+			handler: handler,
+			handled: true,
+		},
 		{
 			name: "VoluntaryExitGood",
 			message: &sse.Event{
@@ -0,0 +1,59 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/pkg/errors"
+)
+
+// ExpectedWithdrawals provides the withdrawals expected in the payload of a proposal.
+func (s *Service) ExpectedWithdrawals(ctx context.Context,
+	opts *api.ExpectedWithdrawalsOpts,
+) (
+	*api.Response[[]*capella.Withdrawal],
+	error,
+) {
+	if opts == nil {
+		return nil, errors.New("no options specified")
+	}
+	if opts.State == "" {
+		return nil, errors.New("no state specified")
+	}
+
+	url := fmt.Sprintf("/eth/v1/builder/states/%s/expected_withdrawals", opts.State)
+	if opts.ProposalSlot != nil {
+		url = fmt.Sprintf("%s?proposal_slot=%d", url, *opts.ProposalSlot)
+	}
+
+	httpResponse, err := s.get(ctx, url, &opts.Common)
+	if err != nil {
+		return nil, err
+	}
+
+	data, metadata, err := decodeJSONResponse(bytes.NewReader(httpResponse.body), []*capella.Withdrawal{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[[]*capella.Withdrawal]{
+		Metadata: metadata,
+		Data:     data,
+	}, nil
+}
@@ -23,6 +23,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/attestantio/go-eth2-client/api"
 	"github.com/attestantio/go-eth2-client/spec"
@@ -33,7 +34,63 @@ import (
 )
 
 // post sends an HTTP post request and returns the body.
+// The request is not retried, as it may alter the state of the node.
 func (s *Service) post(ctx context.Context, endpoint string, body io.Reader) (io.Reader, error) {
+	res, err := s.postAttempt(ctx, endpoint, body, s.timeout)
+
+	return res, withoutRetryAfter(err)
+}
+
+// idempotentPost sends an HTTP post request that does not alter the state of
+// the node, retrying according to the retry policy, and returns the body.
+func (s *Service) idempotentPost(ctx context.Context, endpoint string, body []byte, opts *api.CommonOpts) (io.Reader, error) {
+	timeout := s.timeout
+	if opts.Timeout != 0 {
+		timeout = opts.Timeout
+	}
+
+	return withRetries(ctx, s, endpoint, timeout, func(timeout time.Duration) (io.Reader, error) {
+		return s.postAttempt(ctx, endpoint, bytes.NewReader(body), timeout)
+	})
+}
+
+// idempotentPostStream sends an HTTP post request that does not alter the state
+// of the node, retrying according to the retry policy, and returns the body as a
+// reader, to allow large responses to be decoded without holding the entire body
+// in memory.
+// The caller must close the body reader once it is done with it.
+func (s *Service) idempotentPostStream(ctx context.Context, endpoint string, body []byte, opts *api.CommonOpts) (io.ReadCloser, error) {
+	timeout := s.timeout
+	if opts.Timeout != 0 {
+		timeout = opts.Timeout
+	}
+
+	return withRetries(ctx, s, endpoint, timeout, func(timeout time.Duration) (io.ReadCloser, error) {
+		return s.postStreamAttempt(ctx, endpoint, bytes.NewReader(body), timeout)
+	})
+}
+
+// postAttempt makes a single attempt to send an HTTP post request and returns the body.
+func (s *Service) postAttempt(ctx context.Context, endpoint string, body io.Reader, timeout time.Duration) (io.Reader, error) {
+	respBody, err := s.postStreamAttempt(ctx, endpoint, body, timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer respBody.Close()
+
+	data, err := io.ReadAll(respBody)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read POST response")
+	}
+	s.log.Trace().Str("endpoint", endpoint).Str("response", string(data)).Msg("POST response")
+
+	return bytes.NewReader(data), nil
+}
+
+// postStreamAttempt makes a single attempt to send an HTTP post request and returns
+// the body as a reader.
+// The caller must close the body reader once it is done with it.
+func (s *Service) postStreamAttempt(ctx context.Context, endpoint string, body io.Reader, timeout time.Duration) (io.ReadCloser, error) {
 	// #nosec G404
 	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Str("address", s.address).Str("endpoint", endpoint).Logger()
 	if e := log.Trace(); e.Enabled() {
@@ -51,7 +108,7 @@ func (s *Service) post(ctx context.Context, endpoint string, body io.Reader) (io
 		return nil, errors.Wrap(err, "invalid endpoint")
 	}
 
-	opCtx, cancel := context.WithTimeout(ctx, s.timeout)
+	opCtx, cancel := context.WithTimeout(ctx, timeout)
 	req, err := http.NewRequestWithContext(opCtx, http.MethodPost, url.String(), body)
 	if err != nil {
 		cancel()
@@ -65,41 +122,51 @@ func (s *Service) post(ctx context.Context, endpoint string, body io.Reader) (io
 		req.Header.Set("User-Agent", "go-eth2-client/0.19.10")
 	}
 
-	resp, err := s.client.Do(req)
+	priority := isPriority(http.MethodPost, endpoint)
+	release, err := s.acquireRequest(opCtx, endpoint, priority)
 	if err != nil {
 		cancel()
-		s.monitorPostComplete(ctx, url.Path, "failed")
 
-		return nil, errors.Wrap(err, "failed to call POST endpoint")
+		return nil, err
 	}
-	defer resp.Body.Close()
 
-	data, err := io.ReadAll(resp.Body)
+	resp, err := s.do(req, priority)
 	if err != nil {
+		release()
 		cancel()
+		s.monitorPostComplete(ctx, url.Path, "failed")
 
-		return nil, errors.Wrap(err, "failed to read POST response")
+		return nil, errors.Wrap(err, "failed to call POST endpoint")
+	}
+	respBody := &streamBody{
+		ReadCloser: resp.Body,
+		cancel: func() {
+			release()
+			cancel()
+		},
 	}
 
 	statusFamily := resp.StatusCode / 100
 	if statusFamily != 2 {
-		cancel()
+		defer respBody.Close()
+		data, err := io.ReadAll(respBody)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read POST response")
+		}
 		log.Trace().Int("status_code", resp.StatusCode).Str("data", string(data)).Msg("POST failed")
 		s.monitorPostComplete(ctx, url.Path, "failed")
 
-		return nil, &api.Error{
+		return nil, withRetryAfter(&api.Error{
 			Method:     http.MethodPost,
 			StatusCode: resp.StatusCode,
 			Endpoint:   endpoint,
 			Data:       data,
-		}
+		}, resp)
 	}
-	cancel()
 
-	log.Trace().Str("response", string(data)).Msg("POST response")
 	s.monitorPostComplete(ctx, url.Path, "succeeded")
 
-	return bytes.NewReader(data), nil
+	return respBody, nil
 }
 
 // post2 sends an HTTP post request and returns the body.
@@ -149,7 +216,16 @@ func (s *Service) post2(ctx context.Context,
 		req.Header.Set("User-Agent", "go-eth2-client/0.19.10")
 	}
 
-	resp, err := s.client.Do(req)
+	priority := isPriority(http.MethodPost, endpoint)
+	release, err := s.acquireRequest(opCtx, endpoint, priority)
+	if err != nil {
+		cancel()
+
+		return nil, err
+	}
+	defer release()
+
+	resp, err := s.do(req, priority)
 	if err != nil {
 		cancel()
 		s.monitorPostComplete(ctx, url.Path, "failed")
@@ -203,11 +279,76 @@ type httpResponse struct {
 	headers          map[string]string
 	consensusVersion spec.DataVersion
 	body             []byte
+	bodyReader       io.ReadCloser
 }
 
 // get sends an HTTP get request and returns the body.
 // If the response from the server is a 404 this will return nil for both the reader and the error.
+// Concurrent identical requests share a single request to the server, unless
+// disabled in the options, so the returned response must not be altered.
+// Requests are only identical if they have the same timeout, so that each
+// caller is subject to its own timeout.
 func (s *Service) get(ctx context.Context, endpoint string, opts *api.CommonOpts) (*httpResponse, error) {
+	if opts.DisableCoalescing {
+		return s.getOnce(ctx, endpoint, opts)
+	}
+
+	key := fmt.Sprintf("%s %s %s", s.acceptHeader(), opts.Timeout, endpoint)
+	res, shared, err := s.coalescer.do(ctx, key, func(ctx context.Context) (*httpResponse, error) {
+		return s.getOnce(ctx, endpoint, opts)
+	})
+	if shared {
+		s.log.Trace().Str("endpoint", endpoint).Msg("Shared response of in-flight GET request")
+	}
+
+	return res, err
+}
+
+// getOnce sends an HTTP get request and returns the body.
+func (s *Service) getOnce(ctx context.Context, endpoint string, opts *api.CommonOpts) (*httpResponse, error) {
+	res, err := s.getStream(ctx, endpoint, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer res.bodyReader.Close()
+
+	// Although it would be more efficient to keep the body as a Reader, that would
+	// require the calling function to be aware that it needs to clode the body
+	// once it is done with it.  To avoid that complexity, we read here and store the
+	// body as a byte array.  Calls that return large amounts of data use getStream
+	// directly.
+	res.body, err = io.ReadAll(res.bodyReader)
+	if err != nil {
+		s.log.Warn().Str("endpoint", endpoint).Err(err).Msg("Failed to read body")
+
+		return nil, errors.Wrap(err, "failed to read body")
+	}
+	res.bodyReader = nil
+
+	if err := populateConsensusVersionFromBody(res); err != nil {
+		return nil, errors.Wrap(err, "failed to parse consensus version")
+	}
+
+	return res, nil
+}
+
+// getStream sends an HTTP get request and returns the response with the body
+// available as a reader, to allow large responses to be decoded without holding
+// the entire body in memory.
+// The caller must close the body reader once it is done with it.
+func (s *Service) getStream(ctx context.Context, endpoint string, opts *api.CommonOpts) (*httpResponse, error) {
+	timeout := s.timeout
+	if opts.Timeout != 0 {
+		timeout = opts.Timeout
+	}
+
+	return withRetries(ctx, s, endpoint, timeout, func(timeout time.Duration) (*httpResponse, error) {
+		return s.getStreamAttempt(ctx, endpoint, timeout)
+	})
+}
+
+// getStreamAttempt makes a single attempt to send an HTTP get request.
+func (s *Service) getStreamAttempt(ctx context.Context, endpoint string, timeout time.Duration) (*httpResponse, error) {
 	ctx, span := otel.Tracer("attestantio.go-eth2-client.http").Start(ctx, "get2")
 	defer span.End()
 
@@ -220,13 +361,7 @@ func (s *Service) get(ctx context.Context, endpoint string, opts *api.CommonOpts
 		return nil, errors.Wrap(err, "invalid endpoint")
 	}
 
-	timeout := s.timeout
-	if opts.Timeout != 0 {
-		timeout = opts.Timeout
-	}
-
 	opCtx, cancel := context.WithTimeout(ctx, timeout)
-	defer cancel()
 	req, err := http.NewRequestWithContext(opCtx, http.MethodGet, url.String(), nil)
 	if err != nil {
 		cancel()
@@ -234,27 +369,37 @@ func (s *Service) get(ctx context.Context, endpoint string, opts *api.CommonOpts
 		return nil, errors.Wrap(err, "failed to create GET request")
 	}
 	s.addExtraHeaders(req)
-	if s.enforceJSON {
-		// JSON only.
-		req.Header.Set("Accept", "application/json")
-	} else {
-		// Prefer SSZ, JSON if not.
-		req.Header.Set("Accept", "application/octet-stream;q=1,application/json;q=0.9")
-	}
+	req.Header.Set("Accept", s.acceptHeader())
 	span.AddEvent("Sending request")
 
-	resp, err := s.client.Do(req)
+	priority := isPriority(http.MethodGet, endpoint)
+	release, err := s.acquireRequest(opCtx, endpoint, priority)
+	if err != nil {
+		cancel()
+
+		return nil, err
+	}
+
+	resp, err := s.do(req, priority)
 	if err != nil {
+		release()
+		cancel()
 		span.RecordError(errors.New("Request failed"))
 		s.monitorGetComplete(ctx, url.Path, "failed")
 
 		return nil, errors.Wrap(err, "failed to call GET endpoint")
 	}
-	defer resp.Body.Close()
 	log = log.With().Int("status_code", resp.StatusCode).Logger()
 
 	res := &httpResponse{
 		statusCode: resp.StatusCode,
+		bodyReader: &streamBody{
+			ReadCloser: resp.Body,
+			cancel: func() {
+				release()
+				cancel()
+			},
+		},
 	}
 	populateHeaders(res, resp)
 
@@ -267,31 +412,27 @@ func (s *Service) get(ctx context.Context, endpoint string, opts *api.CommonOpts
 		return res, nil
 	}
 
-	// Although it would be more efficient to keep the body as a Reader, that would
-	// require the calling function to be aware that it needs to clode the body
-	// once it is done with it.  To avoid that complexity, we read here and store the
-	// body as a byte array.
-	res.body, err = io.ReadAll(resp.Body)
-	if err != nil {
-		span.RecordError(err)
-		log.Warn().Err(err).Msg("Failed to read body")
-
-		return nil, errors.Wrap(err, "failed to read body")
-	}
-
 	statusFamily := resp.StatusCode / 100
 	if statusFamily != 2 {
+		defer res.bodyReader.Close()
 		span.SetStatus(codes.Error, fmt.Sprintf("Status code %d", resp.StatusCode))
-		trimmedResponse := bytes.ReplaceAll(bytes.ReplaceAll(res.body, []byte{0x0a}, []byte{}), []byte{0x0d}, []byte{})
+		data, err := io.ReadAll(res.bodyReader)
+		if err != nil {
+			span.RecordError(err)
+			log.Warn().Err(err).Msg("Failed to read body")
+
+			return nil, errors.Wrap(err, "failed to read body")
+		}
+		trimmedResponse := bytes.ReplaceAll(bytes.ReplaceAll(data, []byte{0x0a}, []byte{}), []byte{0x0d}, []byte{})
 		log.Debug().Int("status_code", resp.StatusCode).RawJSON("response", trimmedResponse).Msg("GET failed")
 		s.monitorGetComplete(ctx, url.Path, "failed")
 
-		return nil, &api.Error{
+		return nil, withRetryAfter(&api.Error{
 			Method:     http.MethodGet,
 			StatusCode: resp.StatusCode,
 			Endpoint:   endpoint,
-			Data:       res.body,
-		}
+			Data:       data,
+		}, resp)
 	}
 
 	if err := populateContentType(res, resp); err != nil {
@@ -302,6 +443,8 @@ func (s *Service) get(ctx context.Context, endpoint string, opts *api.CommonOpts
 	span.SetAttributes(attribute.String("content-type", res.contentType.String()))
 
 	if err := populateConsensusVersion(res, resp); err != nil {
+		res.bodyReader.Close()
+
 		return nil, errors.Wrap(err, "failed to parse consensus version")
 	}
 
@@ -310,22 +453,38 @@ func (s *Service) get(ctx context.Context, endpoint string, opts *api.CommonOpts
 	return res, nil
 }
 
+// acceptHeader returns the value of the Accept header for GET requests.
+func (s *Service) acceptHeader() string {
+	if s.enforceJSON {
+		// JSON only.
+		return "application/json"
+	}
+
+	// Prefer SSZ, JSON if not.
+	return "application/octet-stream;q=1,application/json;q=0.9"
+}
+
+// streamBody is the body of a streamed response.  It releases the resources
+// associated with the request, including its place in any rate limits, when closed.
+type streamBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+// Close closes the body and releases the resources associated with the request.
+func (b *streamBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+
+	return err
+}
+
+// populateConsensusVersion populates the consensus version from the response headers.
 func populateConsensusVersion(res *httpResponse, resp *http.Response) error {
 	res.consensusVersion = spec.DataVersionUnknown
 	respConsensusVersions, exists := resp.Header["Eth-Consensus-Version"]
 	if !exists {
-		// No consensus version supplied in response; obtain it from the body if possible.
-		if res.contentType != ContentTypeJSON {
-			// Not present here either.  Many responses do not provide this information, so assume
-			// this is one of them.
-			return nil
-		}
-		var metadata responseMetadata
-		if err := json.Unmarshal(res.body, &metadata); err != nil {
-			return errors.Wrap(err, "no consensus version header and failed to parse response")
-		}
-		res.consensusVersion = metadata.Version
-
+		// No consensus version supplied in response; it may be obtainable from the body.
 		return nil
 	}
 	if len(respConsensusVersions) != 1 {
@@ -338,6 +497,27 @@ func populateConsensusVersion(res *httpResponse, resp *http.Response) error {
 	return nil
 }
 
+// populateConsensusVersionFromBody populates the consensus version from the
+// response body, if it was not supplied in the response headers.
+func populateConsensusVersionFromBody(res *httpResponse) error {
+	if res.consensusVersion != spec.DataVersionUnknown {
+		// Already obtained from headers.
+		return nil
+	}
+	if res.contentType != ContentTypeJSON || len(res.body) == 0 {
+		// Not present here either.  Many responses do not provide this information, so assume
+		// this is one of them.
+		return nil
+	}
+	var metadata responseMetadata
+	if err := json.Unmarshal(res.body, &metadata); err != nil {
+		return errors.Wrap(err, "no consensus version header and failed to parse response")
+	}
+	res.consensusVersion = metadata.Version
+
+	return nil
+}
+
 func populateHeaders(res *httpResponse, resp *http.Response) {
 	res.headers = make(map[string]string, len(resp.Header))
 	for k, v := range resp.Header {
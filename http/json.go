@@ -2,6 +2,7 @@ package http
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
@@ -48,3 +49,86 @@ func decodeJSONResponse[T any](body io.Reader, res T) (T, map[string]any, error)
 
 	return data, metadata, nil
 }
+
+// decodeJSONResponseStream decodes a JSON response as it is read from the body,
+// handing the decoder to dataFunc to decode the value of the data field.  This
+// avoids holding the entire response in memory when decoding large responses.
+func decodeJSONResponseStream(body io.Reader, dataFunc func(decoder *json.Decoder) error) (map[string]any, error) {
+	if body == nil {
+		return nil, errors.New("no body to read")
+	}
+
+	decoder := json.NewDecoder(body)
+	if err := expectJSONDelim(decoder, '{'); err != nil {
+		return nil, errors.Wrap(err, "failed to parse JSON")
+	}
+
+	metadata := make(map[string]any)
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse JSON")
+		}
+		k, isString := token.(string)
+		if !isString {
+			return nil, errors.New("failed to parse JSON: invalid key")
+		}
+		switch k {
+		case "data":
+			if err := dataFunc(decoder); err != nil {
+				return nil, errors.Wrap(err, "failed to unmarshal data")
+			}
+		case "dependent_root":
+			var val phase0.Root
+			if err := decoder.Decode(&val); err != nil {
+				return nil, errors.Wrap(err, "failed to unmarshal dependent root")
+			}
+			metadata[k] = val
+		default:
+			var val any
+			if err := decoder.Decode(&val); err != nil {
+				return nil, errors.Wrapf(err, "failed to unmarshal metadata %s", k)
+			}
+			metadata[k] = val
+		}
+	}
+
+	if err := expectJSONDelim(decoder, '}'); err != nil {
+		return nil, errors.Wrap(err, "failed to parse JSON")
+	}
+
+	return metadata, nil
+}
+
+// decodeJSONArray decodes a JSON array an element at a time, passing each
+// element to the handler as it is decoded.
+func decodeJSONArray[T any](decoder *json.Decoder, handler func(*T) error) error {
+	if err := expectJSONDelim(decoder, '['); err != nil {
+		return err
+	}
+	for decoder.More() {
+		elem := new(T)
+		if err := decoder.Decode(elem); err != nil {
+			return err
+		}
+		if err := handler(elem); err != nil {
+			return err
+		}
+	}
+
+	return expectJSONDelim(decoder, ']')
+}
+
+// expectJSONDelim reads the next token from the decoder, returning an error
+// if it is not the supplied delimiter.
+func expectJSONDelim(decoder *json.Decoder, delim json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return fmt.Errorf("expected %v but found %v", delim, token)
+	}
+
+	return nil
+}
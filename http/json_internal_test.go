@@ -2,9 +2,11 @@ package http
 
 import (
 	"bytes"
+	"encoding/json"
 	"testing"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 )
 
@@ -52,3 +54,55 @@ func TestDecodeJSONArray(t *testing.T) {
 	require.Equal(t, expectedData, data)
 	require.Equal(t, expectedMetadata, metadata)
 }
+
+func TestDecodeJSONResponseStream(t *testing.T) {
+	input := []byte(`{"execution_optimistic":false,"data":[{"previous_version":"0x00000001","current_version":"0x00000002","epoch":"3"},{"previous_version":"0x00000002","current_version":"0x00000003","epoch":"4"}],"finalized":true}`)
+	expectedData := []*phase0.Fork{
+		{
+			PreviousVersion: phase0.Version{0x00, 0x00, 0x00, 0x01},
+			CurrentVersion:  phase0.Version{0x00, 0x00, 0x00, 0x02},
+			Epoch:           3,
+		},
+		{
+			PreviousVersion: phase0.Version{0x00, 0x00, 0x00, 0x02},
+			CurrentVersion:  phase0.Version{0x00, 0x00, 0x00, 0x03},
+			Epoch:           4,
+		},
+	}
+	expectedMetadata := map[string]any{
+		"execution_optimistic": false,
+		"finalized":            true,
+	}
+
+	data := make([]*phase0.Fork, 0)
+	metadata, err := decodeJSONResponseStream(bytes.NewReader(input), func(decoder *json.Decoder) error {
+		return decodeJSONArray(decoder, func(fork *phase0.Fork) error {
+			data = append(data, fork)
+
+			return nil
+		})
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedData, data)
+	require.Equal(t, expectedMetadata, metadata)
+}
+
+func TestDecodeJSONResponseStreamErrors(t *testing.T) {
+	arrayFunc := func(decoder *json.Decoder) error {
+		return decodeJSONArray(decoder, func(_ *phase0.Fork) error {
+			return errors.New("handler error")
+		})
+	}
+
+	_, err := decodeJSONResponseStream(nil, arrayFunc)
+	require.EqualError(t, err, "no body to read")
+
+	_, err = decodeJSONResponseStream(bytes.NewReader([]byte(`[]`)), arrayFunc)
+	require.EqualError(t, err, "failed to parse JSON: expected { but found [")
+
+	_, err = decodeJSONResponseStream(bytes.NewReader([]byte(`{"data":{}}`)), arrayFunc)
+	require.EqualError(t, err, "failed to unmarshal data: expected [ but found {")
+
+	_, err = decodeJSONResponseStream(bytes.NewReader([]byte(`{"data":[{"previous_version":"0x00000001","current_version":"0x00000002","epoch":"3"}]}`)), arrayFunc)
+	require.EqualError(t, err, "failed to unmarshal data: handler error")
+}
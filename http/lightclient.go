@@ -154,7 +154,7 @@ func (s *Service) lightClientUpdatesFromJSON(res *httpResponse) (
 ) {
 	response := &api.Response[[]*spec.VersionedLCUpdate]{
 		Metadata: map[string]any{
-			"version": string(res.consensusVersion),
+			"version": res.consensusVersion.String(),
 		} ,
 	}
 
@@ -0,0 +1,226 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// limiter limits the rate and concurrency of requests.
+// Priority requests are served ahead of other requests waiting on the limiter,
+// and are not subject to the limit on requests in flight.
+type limiter struct {
+	mu sync.Mutex
+
+	// rate is the number of requests per second; 0 if not limited.
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+
+	// maxInFlight is the maximum number of requests in flight; 0 if not limited.
+	maxInFlight int
+	inFlight    int
+
+	priorityWaiters []*limiterWaiter
+	waiters         []*limiterWaiter
+	timer           *time.Timer
+}
+
+// limiterWaiter is a request waiting on the limiter.
+type limiterWaiter struct {
+	priority bool
+	ready    chan struct{}
+	granted  bool
+}
+
+// newLimiter creates a new limiter.
+func newLimiter(limit *RateLimit) *limiter {
+	return &limiter{
+		rate:        limit.RequestsPerSecond,
+		burst:       float64(limit.Burst),
+		tokens:      float64(limit.Burst),
+		last:        time.Now(),
+		maxInFlight: limit.MaxInFlight,
+	}
+}
+
+// acquire waits until the request can proceed.
+// The returned function must be called once the request is complete.
+func (l *limiter) acquire(ctx context.Context, priority bool) (func(), error) {
+	l.mu.Lock()
+	if !l.queued(priority) && l.available(priority) {
+		l.take(priority)
+		l.mu.Unlock()
+
+		return l.releaseFunc(priority), nil
+	}
+
+	waiter := &limiterWaiter{
+		priority: priority,
+		ready:    make(chan struct{}),
+	}
+	if priority {
+		l.priorityWaiters = append(l.priorityWaiters, waiter)
+	} else {
+		l.waiters = append(l.waiters, waiter)
+	}
+	l.dispatch()
+	l.mu.Unlock()
+
+	select {
+	case <-waiter.ready:
+		return l.releaseFunc(priority), nil
+	case <-ctx.Done():
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		if waiter.granted {
+			// Granted as the context completed; hand it back.
+			l.untake(priority)
+		} else {
+			l.remove(waiter)
+		}
+		l.dispatch()
+
+		return nil, ctx.Err()
+	}
+}
+
+// releaseFunc returns the function to release a request.
+func (l *limiter) releaseFunc(priority bool) func() {
+	var once sync.Once
+
+	return func() {
+		once.Do(func() {
+			if priority || l.maxInFlight == 0 {
+				return
+			}
+			l.mu.Lock()
+			l.inFlight--
+			l.dispatch()
+			l.mu.Unlock()
+		})
+	}
+}
+
+// queued returns true if there are waiters that would be served before a
+// request of the given priority.
+// This assumes the lock is held.
+func (l *limiter) queued(priority bool) bool {
+	if priority {
+		return len(l.priorityWaiters) > 0
+	}
+
+	return len(l.priorityWaiters)+len(l.waiters) > 0
+}
+
+// available returns true if a request of the given priority can proceed.
+// This assumes the lock is held.
+func (l *limiter) available(priority bool) bool {
+	if !priority && l.maxInFlight > 0 && l.inFlight >= l.maxInFlight {
+		return false
+	}
+	if l.rate > 0 {
+		l.refill()
+		if l.tokens < 1 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// take takes the resources for a request of the given priority.
+// This assumes the lock is held.
+func (l *limiter) take(priority bool) {
+	if l.rate > 0 {
+		l.tokens--
+	}
+	if !priority && l.maxInFlight > 0 {
+		l.inFlight++
+	}
+}
+
+// untake returns the resources for a request of the given priority that did not proceed.
+// This assumes the lock is held.
+func (l *limiter) untake(priority bool) {
+	if l.rate > 0 {
+		l.tokens = math.Min(l.tokens+1, l.burst)
+	}
+	if !priority && l.maxInFlight > 0 {
+		l.inFlight--
+	}
+}
+
+// refill adds tokens accrued since the last refill.
+// This assumes the lock is held.
+func (l *limiter) refill() {
+	now := time.Now()
+	l.tokens = math.Min(l.tokens+now.Sub(l.last).Seconds()*l.rate, l.burst)
+	l.last = now
+}
+
+// remove removes a waiter from the queues.
+// This assumes the lock is held.
+func (l *limiter) remove(waiter *limiterWaiter) {
+	queue := &l.waiters
+	if waiter.priority {
+		queue = &l.priorityWaiters
+	}
+	for i := range *queue {
+		if (*queue)[i] == waiter {
+			*queue = append((*queue)[:i], (*queue)[i+1:]...)
+
+			return
+		}
+	}
+}
+
+// dispatch allows waiting requests to proceed, in order of priority, for as
+// long as resources are available.  If requests are waiting on the rate limit
+// it schedules a further dispatch for when the next token is available.
+// This assumes the lock is held.
+func (l *limiter) dispatch() {
+	for {
+		var waiter *limiterWaiter
+		switch {
+		case len(l.priorityWaiters) > 0:
+			waiter = l.priorityWaiters[0]
+		case len(l.waiters) > 0:
+			waiter = l.waiters[0]
+		default:
+			return
+		}
+		if !l.available(waiter.priority) {
+			break
+		}
+		l.take(waiter.priority)
+		l.remove(waiter)
+		waiter.granted = true
+		close(waiter.ready)
+	}
+
+	if l.rate > 0 && l.tokens < 1 && l.timer == nil {
+		delay := time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+		l.timer = time.AfterFunc(delay, func() {
+			l.mu.Lock()
+			l.timer = nil
+			l.dispatch()
+			l.mu.Unlock()
+		})
+	}
+}
@@ -0,0 +1,228 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	nethttp "net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimitCheck(t *testing.T) {
+	require.NoError(t, (&RateLimit{}).check())
+	require.NoError(t, (&RateLimit{RequestsPerSecond: 10, Burst: 1, MaxInFlight: 4}).check())
+	require.EqualError(t, (&RateLimit{RequestsPerSecond: -1}).check(), "rate limit requests per second cannot be negative")
+	require.EqualError(t, (&RateLimit{RequestsPerSecond: 10}).check(), "rate limit burst must be at least 1")
+	require.EqualError(t, (&RateLimit{MaxInFlight: -1}).check(), "rate limit max in flight cannot be negative")
+}
+
+func TestEndpointClass(t *testing.T) {
+	require.Equal(t, EndpointClassDuties, endpointClass("/eth/v1/validator/duties/attester/1"))
+	require.Equal(t, EndpointClassDuties, endpointClass("/eth/v1/validator/duties/proposer/1"))
+	require.Equal(t, EndpointClassStates, endpointClass("/eth/v2/debug/beacon/states/head"))
+	require.Equal(t, EndpointClassStates, endpointClass("/eth/v1/beacon/states/head/validators"))
+	require.Equal(t, EndpointClassPools, endpointClass("/eth/v1/beacon/pool/attestations"))
+	require.Equal(t, EndpointClass(""), endpointClass("/eth/v1/node/version"))
+}
+
+func TestIsPriority(t *testing.T) {
+	require.True(t, isPriority(nethttp.MethodGet, "/eth/v1/validator/attestation_data?slot=1&committee_index=0"))
+	require.True(t, isPriority(nethttp.MethodGet, "/eth/v3/validator/blocks/1?randao_reveal=0x00"))
+	require.True(t, isPriority(nethttp.MethodPost, "/eth/v2/beacon/blocks?broadcast_validation=gossip"))
+	require.True(t, isPriority(nethttp.MethodPost, "/eth/v1/beacon/pool/attestations"))
+	require.False(t, isPriority(nethttp.MethodGet, "/eth/v2/beacon/blocks/head"))
+	require.False(t, isPriority(nethttp.MethodGet, "/eth/v2/debug/beacon/states/head"))
+	require.False(t, isPriority(nethttp.MethodPost, "/eth/v1/beacon/pool/voluntary_exits"))
+}
+
+func TestLimiterMaxInFlight(t *testing.T) {
+	ctx := context.Background()
+	l := newLimiter(&RateLimit{MaxInFlight: 1})
+
+	release, err := l.acquire(ctx, false)
+	require.NoError(t, err)
+
+	// Second request waits for the first to complete.
+	acquired := make(chan func())
+	go func() {
+		release, err := l.acquire(ctx, false)
+		require.NoError(t, err)
+		acquired <- release
+	}()
+	select {
+	case <-acquired:
+		require.Fail(t, "request should wait")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// Priority requests are not subject to the limit.
+	priorityRelease, err := l.acquire(ctx, true)
+	require.NoError(t, err)
+	priorityRelease()
+
+	release()
+	// Multiple calls to release have no further effect.
+	release()
+	release = <-acquired
+	release()
+	require.Equal(t, 0, l.inFlight)
+}
+
+func TestLimiterRate(t *testing.T) {
+	ctx := context.Background()
+	l := newLimiter(&RateLimit{RequestsPerSecond: 20, Burst: 2})
+
+	started := time.Now()
+	for i := 0; i < 4; i++ {
+		release, err := l.acquire(ctx, false)
+		require.NoError(t, err)
+		release()
+	}
+	// Two requests are allowed immediately, the other two at 50ms intervals.
+	require.GreaterOrEqual(t, time.Since(started), 90*time.Millisecond)
+}
+
+func TestLimiterPriority(t *testing.T) {
+	ctx := context.Background()
+	l := newLimiter(&RateLimit{RequestsPerSecond: 20, Burst: 1})
+
+	// Use the only token.
+	release, err := l.acquire(ctx, false)
+	require.NoError(t, err)
+	release()
+
+	// Queue a normal request, then a priority request.
+	order := make(chan bool, 2)
+	go func() {
+		release, err := l.acquire(ctx, false)
+		require.NoError(t, err)
+		release()
+		order <- false
+	}()
+	time.Sleep(10 * time.Millisecond)
+	go func() {
+		release, err := l.acquire(ctx, true)
+		require.NoError(t, err)
+		release()
+		order <- true
+	}()
+
+	// The priority request is served first.
+	require.True(t, <-order)
+	require.False(t, <-order)
+}
+
+func TestLimiterCancel(t *testing.T) {
+	l := newLimiter(&RateLimit{MaxInFlight: 1})
+
+	release, err := l.acquire(context.Background(), false)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = l.acquire(ctx, false)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Empty(t, l.waiters)
+
+	release()
+	release, err = l.acquire(context.Background(), false)
+	require.NoError(t, err)
+	release()
+}
+
+func TestConnectionLimit(t *testing.T) {
+	require.Equal(t, 64, connectionLimit(&parameters{}))
+	require.Equal(t, 100, connectionLimit(&parameters{
+		rateLimit: &RateLimit{MaxInFlight: 100},
+	}))
+	require.Equal(t, 96, connectionLimit(&parameters{
+		rateLimit: &RateLimit{MaxInFlight: 32},
+		classRateLimits: map[EndpointClass]*RateLimit{
+			EndpointClassStates: {MaxInFlight: 48},
+			EndpointClassDuties: {MaxInFlight: 48},
+		},
+	}))
+}
+
+func TestPriorityRequestWhileSaturated(t *testing.T) {
+	ctx := context.Background()
+
+	release := make(chan struct{})
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if strings.HasPrefix(r.URL.Path, "/eth/v2/debug/beacon/states/") {
+			// Bulk requests do not complete until released.
+			<-release
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	base, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	limit := &RateLimit{MaxInFlight: 2}
+	s := &Service{
+		log:     zerolog.Nop(),
+		base:    base,
+		address: srv.URL,
+		client: &nethttp.Client{
+			Transport: newTransport(time.Second, 2),
+		},
+		priorityClient: &nethttp.Client{
+			Transport: newTransport(time.Second, priorityConnections),
+		},
+		timeout: 5 * time.Second,
+		limiter: newLimiter(limit),
+		classLimiters: map[EndpointClass]*limiter{
+			EndpointClassStates: newLimiter(limit),
+		},
+	}
+
+	// Saturate both the limits and the connections with bulk requests.
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = s.get(ctx, "/eth/v2/debug/beacon/states/head", &api.CommonOpts{DisableCoalescing: true})
+		}()
+	}
+	require.Eventually(t, func() bool {
+		s.limiter.mu.Lock()
+		defer s.limiter.mu.Unlock()
+
+		return s.limiter.inFlight == 2
+	}, time.Second, 10*time.Millisecond)
+
+	// A bulk request without priority cannot proceed.
+	opCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	_, err = s.get(opCtx, "/eth/v1/beacon/states/head/validators", &api.CommonOpts{})
+	require.Error(t, err)
+
+	// A priority request completes regardless.
+	res, err := s.get(ctx, "/eth/v1/validator/attestation_data?slot=1&committee_index=0", &api.CommonOpts{Timeout: time.Second})
+	require.NoError(t, err)
+	require.Equal(t, []byte(`{"data":{}}`), res.body)
+}
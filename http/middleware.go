@@ -0,0 +1,83 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"net/http"
+)
+
+// RequestFunc sends a request to the beacon node and returns its response.
+type RequestFunc func(req *http.Request) (*http.Response, error)
+
+// Middleware intercepts requests to the beacon node.  It is supplied with the
+// function that passes the request on, and returns the function to be used in
+// its place.
+//
+// Middleware can alter the request before passing it on, for example to add
+// headers or rewrite the URL; observe the response and the time taken to obtain
+// it; or return a response of its own without passing the request on.
+type Middleware func(next RequestFunc) RequestFunc
+
+// chain returns a function that passes requests through the middleware, in the
+// order in which it was supplied, before calling the final function.
+func (s *Service) chain(final RequestFunc) RequestFunc {
+	next := final
+	for i := len(s.middleware) - 1; i >= 0; i-- {
+		next = s.middleware[i](next)
+	}
+
+	return next
+}
+
+// do sends a request to the beacon node via the middleware.
+// Validator-critical requests are sent using their own connections.
+func (s *Service) do(req *http.Request, priority bool) (*http.Response, error) {
+	client := s.client
+	if priority && s.priorityClient != nil {
+		client = s.priorityClient
+	}
+
+	resp, err := s.chain(client.Do)(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Body == nil {
+		// Responses supplied by middleware may not have a body.
+		resp.Body = http.NoBody
+	}
+	if resp.Header == nil {
+		resp.Header = make(http.Header)
+	}
+
+	return resp, nil
+}
+
+// middlewareTransport is a transport that passes requests through the middleware.
+type middlewareTransport struct {
+	next RequestFunc
+}
+
+// RoundTrip passes the request through the middleware.
+func (t *middlewareTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Middleware may alter the request, which a transport must not do, so work on a copy.
+	resp, err := t.next(req.Clone(req.Context()))
+	if err != nil {
+		return nil, err
+	}
+	if resp.Body == nil {
+		resp.Body = http.NoBody
+	}
+
+	return resp, nil
+}
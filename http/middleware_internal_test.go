@@ -0,0 +1,185 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"io"
+	nethttp "net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// middlewareTestService returns a service connected to a server that echoes
+// the request path and the value of the X-Test header.
+func middlewareTestService(t *testing.T, middleware ...Middleware) *Service {
+	t.Helper()
+
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"path":"` + r.URL.Path + `","header":"` + r.Header.Get("X-Test") + `"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	base, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	return &Service{
+		log:        zerolog.Nop(),
+		base:       base,
+		address:    srv.URL,
+		client:     srv.Client(),
+		timeout:    5 * time.Second,
+		middleware: middleware,
+	}
+}
+
+func TestMiddlewareOrder(t *testing.T) {
+	ctx := context.Background()
+
+	calls := make([]string, 0)
+	record := func(name string) Middleware {
+		return func(next RequestFunc) RequestFunc {
+			return func(req *nethttp.Request) (*nethttp.Response, error) {
+				calls = append(calls, name+" request")
+				resp, err := next(req)
+				calls = append(calls, name+" response")
+
+				return resp, err
+			}
+		}
+	}
+	s := middlewareTestService(t, record("first"), record("second"))
+
+	_, err := s.get(ctx, "/eth/v1/test", &api.CommonOpts{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"first request", "second request", "second response", "first response"}, calls)
+}
+
+func TestMiddlewareAlterRequest(t *testing.T) {
+	ctx := context.Background()
+
+	s := middlewareTestService(t, func(next RequestFunc) RequestFunc {
+		return func(req *nethttp.Request) (*nethttp.Response, error) {
+			req.Header.Set("X-Test", "token")
+			req.URL.Path = "/eth/v1/rewritten"
+
+			return next(req)
+		}
+	})
+
+	res, err := s.get(ctx, "/eth/v1/test", &api.CommonOpts{})
+	require.NoError(t, err)
+	require.Equal(t, []byte(`{"path":"/eth/v1/rewritten","header":"token"}`), res.body)
+
+	reader, err := s.post(ctx, "/eth/v1/test", bytes.NewReader([]byte("{}")))
+	require.NoError(t, err)
+	data, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, []byte(`{"path":"/eth/v1/rewritten","header":"token"}`), data)
+
+	reader, err = s.post2(ctx, "/eth/v1/test", bytes.NewReader([]byte("{}")), ContentTypeJSON, nil)
+	require.NoError(t, err)
+	data, err = io.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, []byte(`{"path":"/eth/v1/rewritten","header":"token"}`), data)
+}
+
+func TestMiddlewareObserveResponse(t *testing.T) {
+	ctx := context.Background()
+
+	statusCode := 0
+	size := int64(0)
+	s := middlewareTestService(t, func(next RequestFunc) RequestFunc {
+		return func(req *nethttp.Request) (*nethttp.Response, error) {
+			resp, err := next(req)
+			if err != nil {
+				return nil, err
+			}
+			statusCode = resp.StatusCode
+			data, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			size = int64(len(data))
+			resp.Body = io.NopCloser(bytes.NewReader(data))
+
+			return resp, nil
+		}
+	})
+
+	res, err := s.get(ctx, "/eth/v1/test", &api.CommonOpts{})
+	require.NoError(t, err)
+	require.Equal(t, nethttp.StatusOK, statusCode)
+	require.Equal(t, int64(len(res.body)), size)
+}
+
+func TestMiddlewareShortCircuit(t *testing.T) {
+	ctx := context.Background()
+
+	s := middlewareTestService(t, func(_ RequestFunc) RequestFunc {
+		return func(_ *nethttp.Request) (*nethttp.Response, error) {
+			return &nethttp.Response{
+				StatusCode: nethttp.StatusServiceUnavailable,
+			}, nil
+		}
+	})
+	_, err := s.get(ctx, "/eth/v1/test", &api.CommonOpts{})
+	var apiErr *api.Error
+	require.True(t, errors.As(err, &apiErr))
+	require.Equal(t, nethttp.StatusServiceUnavailable, apiErr.StatusCode)
+
+	s = middlewareTestService(t, func(_ RequestFunc) RequestFunc {
+		return func(_ *nethttp.Request) (*nethttp.Response, error) {
+			return nil, errors.New("injected fault")
+		}
+	})
+	_, err = s.get(ctx, "/eth/v1/test", &api.CommonOpts{})
+	require.ErrorContains(t, err, "injected fault")
+}
+
+func TestMiddlewareTransport(t *testing.T) {
+	s := middlewareTestService(t, func(next RequestFunc) RequestFunc {
+		return func(req *nethttp.Request) (*nethttp.Response, error) {
+			req.Header.Set("X-Test", "token")
+
+			return next(req)
+		}
+	})
+	client := &nethttp.Client{
+		Transport: &middlewareTransport{
+			next: s.chain(s.client.Transport.RoundTrip),
+		},
+	}
+
+	req, err := nethttp.NewRequestWithContext(context.Background(), nethttp.MethodGet, s.base.String()+"/eth/v1/events", nil)
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, []byte(`{"path":"/eth/v1/events","header":"token"}`), data)
+
+	// The original request is unaltered.
+	require.Empty(t, req.Header.Get("X-Test"))
+}
@@ -0,0 +1,61 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+// NodeHealth provides the health of the node.
+func (s *Service) NodeHealth(ctx context.Context, opts *api.NodeHealthOpts) (*api.Response[apiv1.NodeHealth], error) {
+	if opts == nil {
+		return nil, errors.New("no options specified")
+	}
+
+	url := "/eth/v1/node/health"
+	httpResponse, err := s.get(ctx, url, &opts.Common)
+	if err != nil {
+		var apiErr *api.Error
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusServiceUnavailable {
+			// The node is up, but not initialized.
+			return &api.Response[apiv1.NodeHealth]{
+				Data:     apiv1.NodeHealthNotInitialized,
+				Metadata: make(map[string]any),
+			}, nil
+		}
+
+		return nil, err
+	}
+
+	var health apiv1.NodeHealth
+	switch httpResponse.statusCode {
+	case http.StatusOK:
+		health = apiv1.NodeHealthReady
+	case http.StatusPartialContent:
+		health = apiv1.NodeHealthSyncing
+	default:
+		return nil, fmt.Errorf("unexpected status code %d", httpResponse.statusCode)
+	}
+
+	return &api.Response[apiv1.NodeHealth]{
+		Data:     health,
+		Metadata: make(map[string]any),
+	}, nil
+}
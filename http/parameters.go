@@ -14,6 +14,7 @@
 package http
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/attestantio/go-eth2-client/metrics"
@@ -30,6 +31,10 @@ type parameters struct {
 	pubKeyChunkSize int
 	extraHeaders    map[string]string
 	enforceJSON     bool
+	retryPolicy     *RetryPolicy
+	rateLimit       *RateLimit
+	classRateLimits map[EndpointClass]*RateLimit
+	middleware      []Middleware
 }
 
 // Parameter is the interface for service parameters.
@@ -99,6 +104,39 @@ func WithEnforceJSON(enforceJSON bool) Parameter {
 	})
 }
 
+// WithRetryPolicy sets the policy for retrying requests that fail with transient errors.
+// If not supplied, requests are not retried.
+func WithRetryPolicy(policy *RetryPolicy) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.retryPolicy = policy
+	})
+}
+
+// WithRateLimit sets the limits for all requests to the beacon node.
+func WithRateLimit(limit *RateLimit) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.rateLimit = limit
+	})
+}
+
+// WithEndpointClassRateLimit sets the limits for requests to a class of endpoints.
+// These apply in addition to any limits for all requests.
+func WithEndpointClassRateLimit(class EndpointClass, limit *RateLimit) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.classRateLimits[class] = limit
+	})
+}
+
+// WithMiddleware sets the middleware through which all requests to the beacon
+// node pass, including the connection to the events stream.
+// Middleware is called in the order supplied, so the first middleware sees the
+// request first and the response last.
+func WithMiddleware(middleware ...Middleware) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.middleware = append(p.middleware, middleware...)
+	})
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
@@ -107,6 +145,7 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 		indexChunkSize:  -1,
 		pubKeyChunkSize: -1,
 		extraHeaders:    make(map[string]string),
+		classRateLimits: make(map[EndpointClass]*RateLimit),
 	}
 	for _, p := range params {
 		if params != nil {
@@ -126,6 +165,29 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	if parameters.pubKeyChunkSize == 0 {
 		return nil, errors.New("no public key chunk size specified")
 	}
+	if parameters.retryPolicy != nil {
+		if err := parameters.retryPolicy.check(); err != nil {
+			return nil, err
+		}
+	}
+	if parameters.rateLimit != nil {
+		if err := parameters.rateLimit.check(); err != nil {
+			return nil, err
+		}
+	}
+	for i := range parameters.middleware {
+		if parameters.middleware[i] == nil {
+			return nil, errors.New("nil middleware specified")
+		}
+	}
+	for class, limit := range parameters.classRateLimits {
+		if limit == nil {
+			return nil, fmt.Errorf("no rate limit specified for endpoint class %s", class)
+		}
+		if err := limit.check(); err != nil {
+			return nil, errors.Wrapf(err, "invalid rate limit for endpoint class %s", class)
+		}
+	}
 
 	return &parameters, nil
 }
@@ -16,10 +16,15 @@ package http
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
 
 	"github.com/attestantio/go-eth2-client/api"
 	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/altair"
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
@@ -46,31 +51,26 @@ func (s *Service) Proposal(ctx context.Context,
 		return nil, errors.New("no slot specified")
 	}
 
-	url := fmt.Sprintf("/eth/v2/validator/blocks/%d?randao_reveal=%#x&graffiti=%#x", opts.Slot, opts.RandaoReveal, opts.Graffiti)
-
-	if opts.SkipRandaoVerification {
-		if !opts.RandaoReveal.IsInfinity() {
-			return nil, errors.New("randao reveal must be point at infinity if skip randao verification is set")
-		}
-		url = fmt.Sprintf("%s&skip_randao_verification", url)
+	if opts.SkipRandaoVerification && !opts.RandaoReveal.IsInfinity() {
+		return nil, errors.New("randao reveal must be point at infinity if skip randao verification is set")
 	}
-
-	httpResponse, err := s.get(ctx, url, &opts.Common)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to request beacon block proposal")
+	if !opts.AllowBlinded && opts.BuilderBoostFactor != nil && *opts.BuilderBoostFactor != 0 {
+		return nil, errors.New("builder boost factor requires blinded proposals to be allowed")
 	}
 
-	var response *api.Response[*api.VersionedProposal]
-	switch httpResponse.contentType {
-	case ContentTypeSSZ:
-		response, err = s.beaconBlockProposalFromSSZ(httpResponse)
-	case ContentTypeJSON:
-		response, err = s.beaconBlockProposalFromJSON(httpResponse)
-	default:
-		return nil, fmt.Errorf("unhandled content type %v", httpResponse.contentType)
-	}
+	response, err := s.proposalV3(ctx, opts)
 	if err != nil {
-		return nil, err
+		var apiErr *api.Error
+		if !errors.As(err, &apiErr) ||
+			(apiErr.StatusCode != http.StatusNotFound && apiErr.StatusCode != http.StatusMethodNotAllowed) {
+			return nil, errors.Wrap(err, "failed to request beacon block proposal")
+		}
+
+		// Beacon node does not support the v3 endpoint; use v2 instead.
+		response, err = s.proposalV2(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// Ensure the data returned to us is as expected given our input.
@@ -105,6 +105,176 @@ func (s *Service) Proposal(ctx context.Context,
 	return response, nil
 }
 
+// proposalV2 fetches a proposal using the v2 endpoint, which only returns unblinded proposals.
+func (s *Service) proposalV2(ctx context.Context,
+	opts *api.ProposalOpts,
+) (
+	*api.Response[*api.VersionedProposal],
+	error,
+) {
+	url := fmt.Sprintf("/eth/v2/validator/blocks/%d?randao_reveal=%#x&graffiti=%#x", opts.Slot, opts.RandaoReveal, opts.Graffiti)
+	if opts.SkipRandaoVerification {
+		url = fmt.Sprintf("%s&skip_randao_verification", url)
+	}
+
+	httpResponse, err := s.get(ctx, url, &opts.Common)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request beacon block proposal")
+	}
+
+	switch httpResponse.contentType {
+	case ContentTypeSSZ:
+		return s.beaconBlockProposalFromSSZ(httpResponse)
+	case ContentTypeJSON:
+		return s.beaconBlockProposalFromJSON(httpResponse)
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", httpResponse.contentType)
+	}
+}
+
+// proposalV3 fetches a proposal using the v3 endpoint, which returns either
+// an unblinded or a blinded proposal.
+func (s *Service) proposalV3(ctx context.Context,
+	opts *api.ProposalOpts,
+) (
+	*api.Response[*api.VersionedProposal],
+	error,
+) {
+	url := fmt.Sprintf("/eth/v3/validator/blocks/%d?randao_reveal=%#x&graffiti=%#x", opts.Slot, opts.RandaoReveal, opts.Graffiti)
+	if opts.SkipRandaoVerification {
+		url = fmt.Sprintf("%s&skip_randao_verification", url)
+	}
+	switch {
+	case !opts.AllowBlinded:
+		// A builder boost factor of 0 asks the beacon node to always use a local payload.
+		url = fmt.Sprintf("%s&builder_boost_factor=0", url)
+	case opts.BuilderBoostFactor != nil:
+		url = fmt.Sprintf("%s&builder_boost_factor=%d", url, *opts.BuilderBoostFactor)
+	}
+
+	httpResponse, err := s.get(ctx, url, &opts.Common)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := proposalV3Metadata(httpResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	if metadata.blinded && !opts.AllowBlinded {
+		return nil, errors.New("beacon node returned a blinded proposal but blinded proposals are not allowed")
+	}
+
+	var response *api.Response[*api.VersionedProposal]
+	if metadata.blinded {
+		response, err = s.blindedBeaconBlockProposal(httpResponse)
+	} else {
+		switch httpResponse.contentType {
+		case ContentTypeSSZ:
+			response, err = s.beaconBlockProposalFromSSZ(httpResponse)
+		case ContentTypeJSON:
+			response, err = s.beaconBlockProposalFromJSON(httpResponse)
+		default:
+			return nil, fmt.Errorf("unhandled content type %v", httpResponse.contentType)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	response.Data.ExecutionValue = metadata.executionValue
+	response.Data.ConsensusValue = metadata.consensusValue
+
+	return response, nil
+}
+
+// blindedBeaconBlockProposal decodes a blinded proposal returned by the v3 endpoint.
+func (s *Service) blindedBeaconBlockProposal(res *httpResponse) (*api.Response[*api.VersionedProposal], error) {
+	var blindedResponse *api.Response[*api.VersionedBlindedProposal]
+	var err error
+	switch res.contentType {
+	case ContentTypeSSZ:
+		blindedResponse, err = s.blindedProposalFromSSZ(res)
+	case ContentTypeJSON:
+		blindedResponse, err = s.blindedProposalFromJSON(res)
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", res.contentType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[*api.VersionedProposal]{
+		Data: &api.VersionedProposal{
+			Version:          blindedResponse.Data.Version,
+			Blinded:          true,
+			BellatrixBlinded: blindedResponse.Data.Bellatrix,
+			CapellaBlinded:   blindedResponse.Data.Capella,
+			DenebBlinded:     blindedResponse.Data.Deneb,
+			ElectraBlinded:   blindedResponse.Data.Electra,
+		},
+		Metadata: blindedResponse.Metadata,
+	}, nil
+}
+
+// proposalV3MetadataJSON is the metadata returned in the body of a v3 proposal response.
+type proposalV3MetadataJSON struct {
+	ExecutionPayloadBlinded bool   `json:"execution_payload_blinded"`
+	ExecutionPayloadValue   string `json:"execution_payload_value"`
+	ConsensusBlockValue     string `json:"consensus_block_value"`
+}
+
+// proposalV3Info contains the metadata of a v3 proposal response.
+type proposalV3Info struct {
+	blinded        bool
+	executionValue *big.Int
+	consensusValue *big.Int
+}
+
+// proposalV3Metadata obtains the metadata of a v3 proposal response from its
+// headers, falling back to the body for JSON responses that do not supply them.
+func proposalV3Metadata(res *httpResponse) (*proposalV3Info, error) {
+	var bodyMetadata proposalV3MetadataJSON
+	if res.contentType == ContentTypeJSON {
+		if err := json.Unmarshal(res.body, &bodyMetadata); err != nil {
+			return nil, errors.Wrap(err, "failed to parse proposal metadata")
+		}
+	}
+
+	metadata := &proposalV3Info{
+		blinded: bodyMetadata.ExecutionPayloadBlinded,
+	}
+	if blinded, exists := res.headers["Eth-Execution-Payload-Blinded"]; exists {
+		metadata.blinded = strings.EqualFold(blinded, "true")
+	}
+
+	executionValue := bodyMetadata.ExecutionPayloadValue
+	if value, exists := res.headers["Eth-Execution-Payload-Value"]; exists {
+		executionValue = value
+	}
+	if executionValue != "" {
+		var success bool
+		metadata.executionValue, success = new(big.Int).SetString(executionValue, 10)
+		if !success {
+			return nil, fmt.Errorf("invalid execution payload value %s", executionValue)
+		}
+	}
+
+	consensusValue := bodyMetadata.ConsensusBlockValue
+	if value, exists := res.headers["Eth-Consensus-Block-Value"]; exists {
+		consensusValue = value
+	}
+	if consensusValue != "" {
+		var success bool
+		metadata.consensusValue, success = new(big.Int).SetString(consensusValue, 10)
+		if !success {
+			return nil, fmt.Errorf("invalid consensus block value %s", consensusValue)
+		}
+	}
+
+	return metadata, nil
+}
+
 func (s *Service) beaconBlockProposalFromSSZ(res *httpResponse) (*api.Response[*api.VersionedProposal], error) {
 	response := &api.Response[*api.VersionedProposal]{
 		Data: &api.VersionedProposal{
@@ -139,6 +309,11 @@ func (s *Service) beaconBlockProposalFromSSZ(res *httpResponse) (*api.Response[*
 		if err := response.Data.Deneb.UnmarshalSSZ(res.body); err != nil {
 			return nil, errors.Wrap(err, "failed to decode deneb beacon block proposal")
 		}
+	case spec.DataVersionElectra:
+		response.Data.Electra = &apiv1electra.BlockContents{}
+		if err := response.Data.Electra.UnmarshalSSZ(res.body); err != nil {
+			return nil, errors.Wrap(err, "failed to decode electra beacon block proposal")
+		}
 	default:
 		return nil, fmt.Errorf("unhandled block proposal version %s", res.consensusVersion)
 	}
@@ -165,6 +340,8 @@ func (s *Service) beaconBlockProposalFromJSON(res *httpResponse) (*api.Response[
 		response.Data.Capella, response.Metadata, err = decodeJSONResponse(bytes.NewReader(res.body), &capella.BeaconBlock{})
 	case spec.DataVersionDeneb:
 		response.Data.Deneb, response.Metadata, err = decodeJSONResponse(bytes.NewReader(res.body), &apiv1deneb.BlockContents{})
+	case spec.DataVersionElectra:
+		response.Data.Electra, response.Metadata, err = decodeJSONResponse(bytes.NewReader(res.body), &apiv1electra.BlockContents{})
 	default:
 		err = fmt.Errorf("unsupported version %s", res.consensusVersion)
 	}
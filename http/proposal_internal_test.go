@@ -0,0 +1,174 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"math/big"
+	nethttp "net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProposalV3Metadata(t *testing.T) {
+	tests := []struct {
+		name     string
+		res      *httpResponse
+		expected *proposalV3Info
+		err      string
+	}{
+		{
+			name: "Headers",
+			res: &httpResponse{
+				contentType: ContentTypeSSZ,
+				headers: map[string]string{
+					"Eth-Execution-Payload-Blinded": "true",
+					"Eth-Execution-Payload-Value":   "12345678901234567890",
+					"Eth-Consensus-Block-Value":     "123",
+				},
+			},
+			expected: &proposalV3Info{
+				blinded:        true,
+				executionValue: big.NewInt(0).SetUint64(12345678901234567890),
+				consensusValue: big.NewInt(123),
+			},
+		},
+		{
+			name: "Body",
+			res: &httpResponse{
+				contentType: ContentTypeJSON,
+				headers:     map[string]string{},
+				body:        []byte(`{"version":"deneb","execution_payload_blinded":false,"execution_payload_value":"1","consensus_block_value":"2","data":{}}`),
+			},
+			expected: &proposalV3Info{
+				blinded:        false,
+				executionValue: big.NewInt(1),
+				consensusValue: big.NewInt(2),
+			},
+		},
+		{
+			name: "HeadersOverrideBody",
+			res: &httpResponse{
+				contentType: ContentTypeJSON,
+				headers: map[string]string{
+					"Eth-Execution-Payload-Blinded": "false",
+				},
+				body: []byte(`{"version":"deneb","execution_payload_blinded":true,"data":{}}`),
+			},
+			expected: &proposalV3Info{},
+		},
+		{
+			name: "ExecutionValueInvalid",
+			res: &httpResponse{
+				contentType: ContentTypeSSZ,
+				headers: map[string]string{
+					"Eth-Execution-Payload-Value": "invalid",
+				},
+			},
+			err: "invalid execution payload value invalid",
+		},
+		{
+			name: "ConsensusValueInvalid",
+			res: &httpResponse{
+				contentType: ContentTypeSSZ,
+				headers: map[string]string{
+					"Eth-Consensus-Block-Value": "0x01",
+				},
+			},
+			err: "invalid consensus block value 0x01",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := proposalV3Metadata(test.res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+			}
+		})
+	}
+}
+
+func TestProposalAllowBlinded(t *testing.T) {
+	tests := []struct {
+		name               string
+		opts               *api.ProposalOpts
+		builderBoostFactor string
+		err                string
+	}{
+		{
+			name: "NotAllowed",
+			opts: &api.ProposalOpts{
+				Slot: 1,
+			},
+			builderBoostFactor: "0",
+			err:                "failed to request beacon block proposal: beacon node returned a blinded proposal but blinded proposals are not allowed",
+		},
+		{
+			name: "BuilderBoostFactorNotAllowed",
+			opts: &api.ProposalOpts{
+				Slot:               1,
+				BuilderBoostFactor: func() *uint64 { v := uint64(50); return &v }(),
+			},
+			err: "builder boost factor requires blinded proposals to be allowed",
+		},
+		{
+			name: "Allowed",
+			opts: &api.ProposalOpts{
+				Slot:               1,
+				AllowBlinded:       true,
+				BuilderBoostFactor: func() *uint64 { v := uint64(50); return &v }(),
+			},
+			builderBoostFactor: "50",
+			// The blinded proposal is accepted, and fails to decode as the server does not supply one.
+			err: "failed to request beacon block proposal: failed to unmarshal data",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			builderBoostFactor := ""
+			srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+				builderBoostFactor = r.URL.Query().Get("builder_boost_factor")
+				w.Header().Set("Content-Type", "application/json")
+				w.Header().Set("Eth-Consensus-Version", "deneb")
+				w.Header().Set("Eth-Execution-Payload-Blinded", "true")
+				_, _ = w.Write([]byte(`{"version":"deneb","execution_payload_blinded":true,"data":[]}`))
+			}))
+			defer srv.Close()
+			base, err := url.Parse(srv.URL)
+			require.NoError(t, err)
+			s := &Service{
+				log:     zerolog.Nop(),
+				base:    base,
+				address: srv.URL,
+				client:  srv.Client(),
+				timeout: 5 * time.Second,
+			}
+
+			_, err = s.Proposal(context.Background(), test.opts)
+			require.ErrorContains(t, err, test.err)
+			require.Equal(t, test.builderBoostFactor, builderBoostFactor)
+		})
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// RateLimit defines limits on the requests made to the beacon node.
+type RateLimit struct {
+	// RequestsPerSecond is the sustained number of requests per second.
+	// If 0 the rate of requests is not limited.
+	RequestsPerSecond float64
+	// Burst is the number of requests that can be made at once above the sustained rate.
+	// It must be at least 1 if the rate of requests is limited.
+	Burst int
+	// MaxInFlight is the maximum number of requests in flight at any time.
+	// If 0 the number of requests in flight is not limited.
+	MaxInFlight int
+}
+
+// check checks that the rate limit is valid.
+func (l *RateLimit) check() error {
+	if l.RequestsPerSecond < 0 {
+		return errors.New("rate limit requests per second cannot be negative")
+	}
+	if l.RequestsPerSecond > 0 && l.Burst < 1 {
+		return errors.New("rate limit burst must be at least 1")
+	}
+	if l.MaxInFlight < 0 {
+		return errors.New("rate limit max in flight cannot be negative")
+	}
+
+	return nil
+}
+
+// EndpointClass is a class of endpoints that can be rate limited together.
+type EndpointClass string
+
+const (
+	// EndpointClassDuties is the class of validator duty endpoints.
+	EndpointClassDuties EndpointClass = "duties"
+	// EndpointClassStates is the class of endpoints that obtain data from beacon states.
+	EndpointClassStates EndpointClass = "states"
+	// EndpointClassPools is the class of operation pool endpoints.
+	EndpointClassPools EndpointClass = "pools"
+)
+
+// endpointClass returns the class of the endpoint, or an empty string if
+// it does not have one.
+func endpointClass(endpoint string) EndpointClass {
+	switch {
+	case strings.Contains(endpoint, "/validator/duties/"):
+		return EndpointClassDuties
+	case strings.Contains(endpoint, "/beacon/states/"):
+		return EndpointClassStates
+	case strings.Contains(endpoint, "/beacon/pool/"):
+		return EndpointClassPools
+	default:
+		return ""
+	}
+}
+
+// priorityEndpoints are the endpoints of validator-critical requests, by method.
+var priorityEndpoints = map[string][]string{
+	http.MethodGet: {
+		"/eth/v1/validator/attestation_data",
+		"/eth/v1/validator/aggregate_attestation",
+		"/eth/v1/validator/sync_committee_contribution",
+		"/eth/v1/validator/blinded_blocks/",
+		"/eth/v2/validator/blocks/",
+		"/eth/v3/validator/blocks/",
+	},
+	http.MethodPost: {
+		"/eth/v1/beacon/blocks",
+		"/eth/v2/beacon/blocks",
+		"/eth/v1/beacon/blinded_blocks",
+		"/eth/v2/beacon/blinded_blocks",
+		"/eth/v1/beacon/pool/attestations",
+		"/eth/v1/beacon/pool/sync_committees",
+		"/eth/v1/validator/aggregate_and_proofs",
+		"/eth/v1/validator/contribution_and_proofs",
+	},
+}
+
+// isPriority returns true if the request is validator-critical.
+func isPriority(method string, endpoint string) bool {
+	for _, prefix := range priorityEndpoints[method] {
+		if strings.HasPrefix(endpoint, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// acquireRequest waits until the rate limits allow the request to proceed.
+// Validator-critical requests are served ahead of other requests, and are not
+// subject to limits on requests in flight.
+// The returned function must be called once the request is complete.
+func (s *Service) acquireRequest(ctx context.Context, endpoint string, priority bool) (func(), error) {
+	if s.limiter == nil && len(s.classLimiters) == 0 {
+		return func() {}, nil
+	}
+
+	releases := make([]func(), 0, 2)
+	release := func() {
+		for i := len(releases) - 1; i >= 0; i-- {
+			releases[i]()
+		}
+	}
+
+	if classLimiter, exists := s.classLimiters[endpointClass(endpoint)]; exists {
+		classRelease, err := classLimiter.acquire(ctx, priority)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to pass endpoint class rate limit")
+		}
+		releases = append(releases, classRelease)
+	}
+	if s.limiter != nil {
+		globalRelease, err := s.limiter.acquire(ctx, priority)
+		if err != nil {
+			release()
+
+			return nil, errors.Wrap(err, "failed to pass rate limit")
+		}
+		releases = append(releases, globalRelease)
+	}
+
+	return release, nil
+}
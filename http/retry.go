@@ -0,0 +1,208 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/pkg/errors"
+)
+
+// RetryPolicy defines how requests that fail with transient errors are retried.
+// Only GET requests and POST requests that do not alter the state of the node are retried.
+// Requests are retried on network errors and responses with status 429 or 5xx.
+// Each attempt is recorded separately in the requests_total metric.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts for a request, including the first.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry.
+	InitialBackoff time.Duration
+	// MaxBackoff is the maximum delay before a retry.
+	MaxBackoff time.Duration
+	// Multiplier is the factor by which the delay increases after each retry.
+	Multiplier float64
+	// Jitter is the proportion of each delay that is randomised, between 0 and 1.
+	Jitter float64
+}
+
+// DefaultRetryPolicy returns a retry policy suitable for most uses.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     2 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+	}
+}
+
+// check checks that the retry policy is valid.
+func (p *RetryPolicy) check() error {
+	if p.MaxAttempts < 1 {
+		return errors.New("retry policy max attempts must be at least 1")
+	}
+	if p.InitialBackoff < 0 {
+		return errors.New("retry policy initial backoff cannot be negative")
+	}
+	if p.MaxBackoff < p.InitialBackoff {
+		return errors.New("retry policy max backoff cannot be less than initial backoff")
+	}
+	if p.Multiplier < 1 {
+		return errors.New("retry policy multiplier must be at least 1")
+	}
+	if p.Jitter < 0 || p.Jitter > 1 {
+		return errors.New("retry policy jitter must be between 0 and 1")
+	}
+
+	return nil
+}
+
+// backoff returns the delay before the given retry, starting at 1.
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	delay := float64(p.InitialBackoff) * math.Pow(p.Multiplier, float64(retry-1))
+	if delay > float64(p.MaxBackoff) {
+		delay = float64(p.MaxBackoff)
+	}
+	// #nosec G404
+	delay -= delay * p.Jitter * rand.Float64()
+
+	return time.Duration(delay)
+}
+
+// retryAfterError is an error response that includes a Retry-After header.
+type retryAfterError struct {
+	err        error
+	retryAfter time.Duration
+}
+
+// Error returns the error of the response.
+func (e *retryAfterError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the error of the response.
+func (e *retryAfterError) Unwrap() error {
+	return e.err
+}
+
+// withRetryAfter adds the delay requested by the Retry-After header of
+// the response, if present, to the error.
+func withRetryAfter(err error, resp *http.Response) error {
+	header := resp.Header.Get("Retry-After")
+	if header == "" {
+		return err
+	}
+
+	if seconds, parseErr := strconv.ParseUint(header, 10, 32); parseErr == nil {
+		return &retryAfterError{
+			err:        err,
+			retryAfter: time.Duration(seconds) * time.Second,
+		}
+	}
+	if date, parseErr := http.ParseTime(header); parseErr == nil {
+		return &retryAfterError{
+			err:        err,
+			retryAfter: time.Until(date),
+		}
+	}
+
+	return err
+}
+
+// isRetryable returns true if the error from an attempt is transient.
+func isRetryable(err error) bool {
+	var apiErr *api.Error
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode/100 == 5
+	}
+
+	// Network errors are reported by the HTTP client as URL errors.
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return !errors.Is(urlErr, context.Canceled)
+	}
+
+	return false
+}
+
+// withoutRetryAfter removes the Retry-After information from the error, if present.
+func withoutRetryAfter(err error) error {
+	var retryAfterErr *retryAfterError
+	if errors.As(err, &retryAfterErr) {
+		return retryAfterErr.err
+	}
+
+	return err
+}
+
+// withRetries makes attempts at a request according to the retry policy.
+// Each attempt is supplied with the time remaining until the request times out,
+// after which no further attempts are made.
+func withRetries[T any](ctx context.Context,
+	s *Service,
+	endpoint string,
+	timeout time.Duration,
+	attempt func(timeout time.Duration) (T, error),
+) (
+	T,
+	error,
+) {
+	if s.retryPolicy == nil {
+		res, err := attempt(timeout)
+
+		return res, withoutRetryAfter(err)
+	}
+
+	deadline := time.Now().Add(timeout)
+	if ctxDeadline, exists := ctx.Deadline(); exists && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+
+	for retry := 1; ; retry++ {
+		res, err := attempt(time.Until(deadline))
+		if err == nil {
+			return res, nil
+		}
+		if retry >= s.retryPolicy.MaxAttempts || ctx.Err() != nil || !isRetryable(err) {
+			return res, withoutRetryAfter(err)
+		}
+
+		delay := s.retryPolicy.backoff(retry)
+		var retryAfterErr *retryAfterError
+		if errors.As(err, &retryAfterErr) && retryAfterErr.retryAfter > delay {
+			delay = retryAfterErr.retryAfter
+		}
+		if time.Now().Add(delay).After(deadline) {
+			// Not enough time for another attempt.
+			return res, withoutRetryAfter(err)
+		}
+
+		s.log.Debug().Str("endpoint", endpoint).Int("attempt", retry).Dur("delay", delay).Err(err).Msg("Request failed; retrying")
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+
+			return res, withoutRetryAfter(err)
+		}
+	}
+}
@@ -0,0 +1,247 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"io"
+	nethttp "net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// retryTestService returns a service connected to a server that responds
+// with the given status codes in turn, and 200 thereafter.
+func retryTestService(t *testing.T,
+	policy *RetryPolicy,
+	statusCodes []int,
+	headers map[string]string,
+) (
+	*Service,
+	*int32,
+) {
+	t.Helper()
+
+	requests := int32(0)
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		request := int(atomic.AddInt32(&requests, 1))
+		for k, v := range headers {
+			w.Header().Set(k, v)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if request <= len(statusCodes) {
+			w.WriteHeader(statusCodes[request-1])
+		}
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	base, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	return &Service{
+		log:         zerolog.Nop(),
+		base:        base,
+		address:     srv.URL,
+		client:      srv.Client(),
+		timeout:     5 * time.Second,
+		retryPolicy: policy,
+	}, &requests
+}
+
+func testRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     10 * time.Millisecond,
+		Multiplier:     2,
+		Jitter:         0.5,
+	}
+}
+
+func TestRetryPolicyCheck(t *testing.T) {
+	require.NoError(t, DefaultRetryPolicy().check())
+
+	policy := testRetryPolicy()
+	policy.MaxAttempts = 0
+	require.EqualError(t, policy.check(), "retry policy max attempts must be at least 1")
+
+	policy = testRetryPolicy()
+	policy.MaxBackoff = 0
+	require.EqualError(t, policy.check(), "retry policy max backoff cannot be less than initial backoff")
+
+	policy = testRetryPolicy()
+	policy.Multiplier = 0.5
+	require.EqualError(t, policy.check(), "retry policy multiplier must be at least 1")
+
+	policy = testRetryPolicy()
+	policy.Jitter = 2
+	require.EqualError(t, policy.check(), "retry policy jitter must be between 0 and 1")
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := &RetryPolicy{
+		MaxAttempts:    5,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     300 * time.Millisecond,
+		Multiplier:     2,
+	}
+	require.Equal(t, 100*time.Millisecond, policy.backoff(1))
+	require.Equal(t, 200*time.Millisecond, policy.backoff(2))
+	require.Equal(t, 300*time.Millisecond, policy.backoff(3))
+
+	policy.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		delay := policy.backoff(1)
+		require.GreaterOrEqual(t, delay, 50*time.Millisecond)
+		require.LessOrEqual(t, delay, 100*time.Millisecond)
+	}
+}
+
+func TestGetRetries(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		policy      *RetryPolicy
+		statusCodes []int
+		headers     map[string]string
+		timeout     time.Duration
+		requests    int32
+		statusCode  int
+	}{
+		{
+			name:        "NoPolicy",
+			statusCodes: []int{nethttp.StatusServiceUnavailable},
+			requests:    1,
+			statusCode:  nethttp.StatusServiceUnavailable,
+		},
+		{
+			name:        "ServerError",
+			policy:      testRetryPolicy(),
+			statusCodes: []int{nethttp.StatusServiceUnavailable, nethttp.StatusInternalServerError},
+			requests:    3,
+		},
+		{
+			name:        "TooManyRequests",
+			policy:      testRetryPolicy(),
+			statusCodes: []int{nethttp.StatusTooManyRequests},
+			requests:    2,
+		},
+		{
+			name:        "ClientError",
+			policy:      testRetryPolicy(),
+			statusCodes: []int{nethttp.StatusBadRequest},
+			requests:    1,
+			statusCode:  nethttp.StatusBadRequest,
+		},
+		{
+			name:        "MaxAttempts",
+			policy:      testRetryPolicy(),
+			statusCodes: []int{nethttp.StatusBadGateway, nethttp.StatusBadGateway, nethttp.StatusBadGateway},
+			requests:    3,
+			statusCode:  nethttp.StatusBadGateway,
+		},
+		{
+			name:        "RetryAfterBeyondTimeout",
+			policy:      testRetryPolicy(),
+			statusCodes: []int{nethttp.StatusTooManyRequests},
+			headers:     map[string]string{"Retry-After": "10"},
+			timeout:     time.Second,
+			requests:    1,
+			statusCode:  nethttp.StatusTooManyRequests,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, requests := retryTestService(t, test.policy, test.statusCodes, test.headers)
+			res, err := s.get(ctx, "/eth/v1/test", &api.CommonOpts{Timeout: test.timeout})
+			require.Equal(t, test.requests, atomic.LoadInt32(requests))
+			if test.statusCode == 0 {
+				require.NoError(t, err)
+				require.Equal(t, []byte(`{"data":{}}`), res.body)
+			} else {
+				var apiErr *api.Error
+				require.True(t, errors.As(err, &apiErr))
+				require.Equal(t, test.statusCode, apiErr.StatusCode)
+				// Error is returned as-is to the caller.
+				_, isAPIErr := err.(*api.Error)
+				require.True(t, isAPIErr)
+			}
+		})
+	}
+}
+
+func TestGetRetryAfter(t *testing.T) {
+	ctx := context.Background()
+	s, requests := retryTestService(t, testRetryPolicy(), []int{nethttp.StatusTooManyRequests}, map[string]string{"Retry-After": "1"})
+
+	started := time.Now()
+	_, err := s.get(ctx, "/eth/v1/test", &api.CommonOpts{})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), atomic.LoadInt32(requests))
+	require.GreaterOrEqual(t, time.Since(started), time.Second)
+}
+
+func TestGetRetryNetworkError(t *testing.T) {
+	ctx := context.Background()
+	s, _ := retryTestService(t, testRetryPolicy(), nil, nil)
+	attempts := 0
+	s.client.Transport = roundTripperFunc(func(req *nethttp.Request) (*nethttp.Response, error) {
+		attempts++
+		if attempts == 1 {
+			return nil, errors.New("connection reset")
+		}
+
+		return nethttp.DefaultTransport.RoundTrip(req)
+	})
+
+	_, err := s.get(ctx, "/eth/v1/test", &api.CommonOpts{})
+	require.NoError(t, err)
+	require.Equal(t, 2, attempts)
+}
+
+func TestPostRetries(t *testing.T) {
+	ctx := context.Background()
+
+	// Idempotent requests are retried, with the same body.
+	s, requests := retryTestService(t, testRetryPolicy(), []int{nethttp.StatusServiceUnavailable}, nil)
+	res, err := s.idempotentPost(ctx, "/eth/v1/test", []byte(`["1"]`), &api.CommonOpts{})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), atomic.LoadInt32(requests))
+	data, err := io.ReadAll(res)
+	require.NoError(t, err)
+	require.Equal(t, []byte(`{"data":{}}`), data)
+
+	// Other requests are not.
+	s, requests = retryTestService(t, testRetryPolicy(), []int{nethttp.StatusServiceUnavailable}, nil)
+	_, err = s.post(ctx, "/eth/v1/test", nil)
+	require.Error(t, err)
+	require.Equal(t, int32(1), atomic.LoadInt32(requests))
+}
+
+type roundTripperFunc func(req *nethttp.Request) (*nethttp.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *nethttp.Request) (*nethttp.Response, error) {
+	return f(req)
+}
@@ -43,6 +43,21 @@ type Service struct {
 	client  *http.Client
 	timeout time.Duration
 
+	// priorityClient is used for validator-critical requests; if nil then
+	// client is used.
+	priorityClient *http.Client
+
+	// retryPolicy is the policy for retrying requests; nil if requests are not retried.
+	retryPolicy *RetryPolicy
+
+	// limiter limits all requests; nil if requests are not limited.
+	limiter *limiter
+	// classLimiters limit requests to classes of endpoints.
+	classLimiters map[EndpointClass]*limiter
+
+	// middleware intercepts requests to the beacon node.
+	middleware []Middleware
+
 	// Various information from the node that does not change during the
 	// lifetime of a beacon node.
 	genesis              *apiv1.Genesis
@@ -56,6 +71,9 @@ type Service struct {
 	nodeVersion          string
 	nodeVersionMutex     sync.RWMutex
 
+	// coalescer allows concurrent identical GET requests to share a single request.
+	coalescer coalescer
+
 	// User-specified chunk sizes.
 	userIndexChunkSize  int
 	userPubKeyChunkSize int
@@ -85,18 +103,16 @@ func New(ctx context.Context, params ...Parameter) (eth2client.Service, error) {
 		}
 	}
 
+	// Ensure that the connection pool does not restrict the number of requests
+	// in flight below that allowed by the rate limits.
+	maxConns := connectionLimit(parameters)
 	client := &http.Client{
-		Transport: &http.Transport{
-			DialContext: (&net.Dialer{
-				Timeout:   parameters.timeout,
-				KeepAlive: 30 * time.Second,
-				DualStack: true,
-			}).DialContext,
-			MaxIdleConns:        64,
-			MaxConnsPerHost:     64,
-			MaxIdleConnsPerHost: 64,
-			IdleConnTimeout:     600 * time.Second,
-		},
+		Transport: newTransport(parameters.timeout, maxConns),
+	}
+	// Validator-critical requests use their own connections, so that they are
+	// never queued behind other requests waiting for a connection.
+	priorityClient := &http.Client{
+		Transport: newTransport(parameters.timeout, priorityConnections),
 	}
 
 	address := parameters.address
@@ -116,11 +132,21 @@ func New(ctx context.Context, params ...Parameter) (eth2client.Service, error) {
 		base:                base,
 		address:             parameters.address,
 		client:              client,
+		priorityClient:      priorityClient,
 		timeout:             parameters.timeout,
 		userIndexChunkSize:  parameters.indexChunkSize,
 		userPubKeyChunkSize: parameters.pubKeyChunkSize,
 		extraHeaders:        parameters.extraHeaders,
 		enforceJSON:         parameters.enforceJSON,
+		retryPolicy:         parameters.retryPolicy,
+		classLimiters:       make(map[EndpointClass]*limiter),
+		middleware:          parameters.middleware,
+	}
+	if parameters.rateLimit != nil {
+		s.limiter = newLimiter(parameters.rateLimit)
+	}
+	for class, limit := range parameters.classRateLimits {
+		s.classLimiters[class] = newLimiter(limit)
 	}
 
 	// Fetch static values to confirm the connection is good.
@@ -228,5 +254,41 @@ func (s *Service) Address() string {
 }
 
 // close closes the service, freeing up resources.
+// priorityConnections is the number of connections available to validator-critical requests.
+const priorityConnections = 16
+
+// connectionLimit returns the number of connections to the beacon node required
+// to allow the number of requests in flight permitted by the rate limits.
+func connectionLimit(parameters *parameters) int {
+	maxConns := 64
+	if parameters.rateLimit != nil && parameters.rateLimit.MaxInFlight > maxConns {
+		maxConns = parameters.rateLimit.MaxInFlight
+	}
+	classConns := 0
+	for _, limit := range parameters.classRateLimits {
+		classConns += limit.MaxInFlight
+	}
+	if classConns > maxConns {
+		maxConns = classConns
+	}
+
+	return maxConns
+}
+
+// newTransport returns a transport with the given number of connections.
+func newTransport(timeout time.Duration, maxConns int) *http.Transport {
+	return &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout:   timeout,
+			KeepAlive: 30 * time.Second,
+			DualStack: true,
+		}).DialContext,
+		MaxIdleConns:        maxConns,
+		MaxConnsPerHost:     maxConns,
+		MaxIdleConnsPerHost: maxConns,
+		IdleConnTimeout:     600 * time.Second,
+	}
+}
+
 func (s *Service) close() {
 }
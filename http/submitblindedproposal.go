@@ -17,6 +17,8 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/attestantio/go-eth2-client/api"
@@ -32,6 +34,9 @@ func (s *Service) SubmitBlindedProposal(ctx context.Context, proposal *api.Versi
 	if proposal == nil {
 		return errors.New("no blinded proposal supplied")
 	}
+	if err := proposal.AssertPresent(); err != nil {
+		return errors.Wrap(err, "invalid blinded proposal")
+	}
 
 	switch proposal.Version {
 	case spec.DataVersionPhase0:
@@ -44,6 +49,8 @@ func (s *Service) SubmitBlindedProposal(ctx context.Context, proposal *api.Versi
 		specJSON, err = json.Marshal(proposal.Capella)
 	case spec.DataVersionDeneb:
 		specJSON, err = json.Marshal(proposal.Deneb)
+	case spec.DataVersionElectra:
+		specJSON, err = json.Marshal(proposal.Electra)
 	default:
 		err = errors.New("unknown proposal version")
 	}
@@ -60,3 +67,101 @@ func (s *Service) SubmitBlindedProposal(ctx context.Context, proposal *api.Versi
 
 	return nil
 }
+
+// SubmitBlindedProposalWithOpts submits a blinded proposal with the given options.
+func (s *Service) SubmitBlindedProposalWithOpts(ctx context.Context, opts *api.SubmitBlindedProposalOpts) error {
+	if opts == nil {
+		return errors.New("no options specified")
+	}
+	if opts.Proposal == nil {
+		return errors.New("no blinded proposal supplied")
+	}
+	if err := opts.BroadcastValidation.Validate(); err != nil {
+		return err
+	}
+	if err := opts.Proposal.AssertPresent(); err != nil {
+		return errors.Wrap(err, "invalid blinded proposal")
+	}
+
+	url := fmt.Sprintf("/eth/v2/beacon/blinded_blocks?broadcast_validation=%s", opts.BroadcastValidation.String())
+	headers := make(map[string]string)
+	headers["Eth-Consensus-Version"] = strings.ToLower(opts.Proposal.Version.String())
+
+	if !s.enforceJSON {
+		body, err := signedBlindedProposalSSZ(opts.Proposal)
+		if err != nil {
+			return err
+		}
+		_, err = s.post2(ctx, url, bytes.NewReader(body), ContentTypeSSZ, headers)
+		if err == nil {
+			return nil
+		}
+		var apiErr *api.Error
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnsupportedMediaType {
+			return errors.Wrap(err, "failed to submit blinded proposal")
+		}
+		// Beacon node does not accept SSZ; fall back to JSON.
+	}
+
+	body, err := signedBlindedProposalJSON(opts.Proposal)
+	if err != nil {
+		return err
+	}
+	if _, err := s.post2(ctx, url, bytes.NewReader(body), ContentTypeJSON, headers); err != nil {
+		return errors.Wrap(err, "failed to submit blinded proposal")
+	}
+
+	return nil
+}
+
+func signedBlindedProposalSSZ(proposal *api.VersionedSignedBlindedProposal) ([]byte, error) {
+	var data []byte
+	var err error
+	switch proposal.Version {
+	case spec.DataVersionPhase0:
+		err = errors.New("blinded phase0 proposals not supported")
+	case spec.DataVersionAltair:
+		err = errors.New("blinded altair proposals not supported")
+	case spec.DataVersionBellatrix:
+		data, err = proposal.Bellatrix.MarshalSSZ()
+	case spec.DataVersionCapella:
+		data, err = proposal.Capella.MarshalSSZ()
+	case spec.DataVersionDeneb:
+		data, err = proposal.Deneb.MarshalSSZ()
+	case spec.DataVersionElectra:
+		data, err = proposal.Electra.MarshalSSZ()
+	default:
+		err = errors.New("unknown proposal version")
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal SSZ")
+	}
+
+	return data, nil
+}
+
+func signedBlindedProposalJSON(proposal *api.VersionedSignedBlindedProposal) ([]byte, error) {
+	var data []byte
+	var err error
+	switch proposal.Version {
+	case spec.DataVersionPhase0:
+		err = errors.New("blinded phase0 proposals not supported")
+	case spec.DataVersionAltair:
+		err = errors.New("blinded altair proposals not supported")
+	case spec.DataVersionBellatrix:
+		data, err = json.Marshal(proposal.Bellatrix)
+	case spec.DataVersionCapella:
+		data, err = json.Marshal(proposal.Capella)
+	case spec.DataVersionDeneb:
+		data, err = json.Marshal(proposal.Deneb)
+	case spec.DataVersionElectra:
+		data, err = json.Marshal(proposal.Electra)
+	default:
+		err = errors.New("unknown proposal version")
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal JSON")
+	}
+
+	return data, nil
+}
@@ -0,0 +1,142 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	nethttp "net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// submitTestService returns a service connected to a server that rejects SSZ
+// submissions, and records the content types of the submissions it receives.
+func submitTestService(t *testing.T) (*Service, *[]string) {
+	t.Helper()
+
+	contentTypes := make([]string, 0)
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		contentTypes = append(contentTypes, r.Header.Get("Content-Type"))
+		if r.Header.Get("Content-Type") == ContentTypeSSZ.MediaType() {
+			w.WriteHeader(nethttp.StatusUnsupportedMediaType)
+
+			return
+		}
+		w.WriteHeader(nethttp.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	base, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	return &Service{
+		log:     zerolog.Nop(),
+		base:    base,
+		address: srv.URL,
+		client:  srv.Client(),
+		timeout: 5 * time.Second,
+	}, &contentTypes
+}
+
+func TestSubmitProposalWithOptsJSONFallback(t *testing.T) {
+	s, contentTypes := submitTestService(t)
+
+	err := s.SubmitProposalWithOpts(context.Background(), &api.SubmitProposalOpts{
+		Proposal: &api.VersionedSignedProposal{
+			Version: spec.DataVersionPhase0,
+			Phase0: &phase0.SignedBeaconBlock{
+				Message: &phase0.BeaconBlock{
+					Body: &phase0.BeaconBlockBody{
+						ETH1Data: &phase0.ETH1Data{
+							BlockHash: make([]byte, 32),
+						},
+					},
+				},
+			},
+		},
+		BroadcastValidation: apiv1.BroadcastValidationGossip,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{ContentTypeSSZ.MediaType(), ContentTypeJSON.MediaType()}, *contentTypes)
+}
+
+func TestSubmitProposalMissingData(t *testing.T) {
+	s, contentTypes := submitTestService(t)
+	ctx := context.Background()
+
+	err := s.SubmitProposal(ctx, &api.VersionedSignedProposal{
+		Version: spec.DataVersionDeneb,
+	})
+	require.EqualError(t, err, "invalid proposal: data missing")
+
+	err = s.SubmitProposalWithOpts(ctx, &api.SubmitProposalOpts{
+		Proposal: &api.VersionedSignedProposal{
+			Version: spec.DataVersionDeneb,
+			Deneb:   &apiv1deneb.SignedBlockContents{},
+		},
+	})
+	require.EqualError(t, err, "invalid proposal: data missing")
+
+	err = s.SubmitBlindedProposal(ctx, &api.VersionedSignedBlindedProposal{
+		Version: spec.DataVersionCapella,
+	})
+	require.EqualError(t, err, "invalid blinded proposal: data missing")
+
+	err = s.SubmitBlindedProposalWithOpts(ctx, &api.SubmitBlindedProposalOpts{
+		Proposal: &api.VersionedSignedBlindedProposal{
+			Version: spec.DataVersionPhase0,
+		},
+	})
+	require.EqualError(t, err, "invalid blinded proposal: unsupported version")
+
+	// Nothing was sent to the beacon node.
+	require.Empty(t, *contentTypes)
+}
+
+func TestSubmitProposalUnknownBroadcastValidation(t *testing.T) {
+	s, contentTypes := submitTestService(t)
+	ctx := context.Background()
+
+	err := s.SubmitProposalWithOpts(ctx, &api.SubmitProposalOpts{
+		Proposal: &api.VersionedSignedProposal{
+			Version: spec.DataVersionPhase0,
+			Phase0:  &phase0.SignedBeaconBlock{},
+		},
+		BroadcastValidation: apiv1.BroadcastValidation(3),
+	})
+	require.EqualError(t, err, "unknown broadcast validation 3")
+
+	err = s.SubmitBlindedProposalWithOpts(ctx, &api.SubmitBlindedProposalOpts{
+		Proposal: &api.VersionedSignedBlindedProposal{
+			Version:   spec.DataVersionBellatrix,
+			Bellatrix: &apiv1bellatrix.SignedBlindedBeaconBlock{},
+		},
+		BroadcastValidation: apiv1.BroadcastValidation(-1),
+	})
+	require.EqualError(t, err, "unknown broadcast validation -1")
+
+	// Nothing was sent to the beacon node.
+	require.Empty(t, *contentTypes)
+}
@@ -117,3 +117,37 @@ func TestSubmitProposal(t *testing.T) {
 		})
 	}
 }
+
+func TestSubmitProposalWithOpts(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	service, err := http.New(ctx,
+		http.WithTimeout(timeout),
+		http.WithAddress(os.Getenv("HTTP_ADDRESS")),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		opts *api.SubmitProposalOpts
+		err  string
+	}{
+		{
+			name: "NilOpts",
+			err:  "no options specified",
+		},
+		{
+			name: "NilProposal",
+			opts: &api.SubmitProposalOpts{},
+			err:  "no proposal supplied",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := service.(client.ProposalWithOptsSubmitter).SubmitProposalWithOpts(ctx, test.opts)
+			require.EqualError(t, err, test.err)
+		})
+	}
+}
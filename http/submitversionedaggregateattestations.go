@@ -0,0 +1,103 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/pkg/errors"
+)
+
+// SubmitVersionedAggregateAttestations submits aggregate attestations.
+func (s *Service) SubmitVersionedAggregateAttestations(ctx context.Context, opts *api.SubmitAggregateAttestationsOpts) error {
+	if opts == nil {
+		return errors.New("no options specified")
+	}
+	if len(opts.SignedAggregateAndProofs) == 0 {
+		return errors.New("no signed aggregate and proofs specified")
+	}
+
+	version, specJSON, err := signedAggregateAndProofsJSON(opts.SignedAggregateAndProofs)
+	if err != nil {
+		return err
+	}
+
+	headers := make(map[string]string)
+	headers["Eth-Consensus-Version"] = strings.ToLower(version.String())
+	_, err = s.post2(ctx, "/eth/v2/validator/aggregate_and_proofs", bytes.NewReader(specJSON), ContentTypeJSON, headers)
+	if err != nil {
+		var apiErr *api.Error
+		if !errors.As(err, &apiErr) ||
+			(apiErr.StatusCode != http.StatusNotFound && apiErr.StatusCode != http.StatusMethodNotAllowed) ||
+			version >= spec.DataVersionElectra {
+			return errors.Wrap(err, "failed to submit aggregate and proofs")
+		}
+
+		// Beacon node does not support the v2 endpoint; use v1 instead.
+		if _, err := s.post(ctx, "/eth/v1/validator/aggregate_and_proofs", bytes.NewReader(specJSON)); err != nil {
+			return errors.Wrap(err, "failed to submit aggregate and proofs")
+		}
+	}
+
+	return nil
+}
+
+// signedAggregateAndProofsJSON returns the version and JSON encoding of the signed aggregate and proofs.
+// All signed aggregate and proofs must be of the same version.
+func signedAggregateAndProofsJSON(aggregateAndProofs []*spec.VersionedSignedAggregateAndProof) (spec.DataVersion, []byte, error) {
+	var version spec.DataVersion
+	data := make([]any, len(aggregateAndProofs))
+	for i, aggregateAndProof := range aggregateAndProofs {
+		if aggregateAndProof == nil {
+			return spec.DataVersionUnknown, nil, fmt.Errorf("signed aggregate and proof %d is nil", i)
+		}
+		if i == 0 {
+			version = aggregateAndProof.Version
+		}
+		if aggregateAndProof.Version != version {
+			return spec.DataVersionUnknown, nil, errors.New("signed aggregate and proofs have differing versions")
+		}
+		if _, err := aggregateAndProof.AggregatorIndex(); err != nil {
+			return spec.DataVersionUnknown, nil, errors.Wrapf(err, "invalid signed aggregate and proof %d", i)
+		}
+		switch aggregateAndProof.Version {
+		case spec.DataVersionPhase0:
+			data[i] = aggregateAndProof.Phase0
+		case spec.DataVersionAltair:
+			data[i] = aggregateAndProof.Altair
+		case spec.DataVersionBellatrix:
+			data[i] = aggregateAndProof.Bellatrix
+		case spec.DataVersionCapella:
+			data[i] = aggregateAndProof.Capella
+		case spec.DataVersionDeneb:
+			data[i] = aggregateAndProof.Deneb
+		case spec.DataVersionElectra:
+			data[i] = aggregateAndProof.Electra
+		}
+	}
+
+	specJSON, err := json.Marshal(data)
+	if err != nil {
+		return spec.DataVersionUnknown, nil, errors.Wrap(err, "failed to marshal JSON")
+	}
+
+	return version, specJSON, nil
+}
@@ -0,0 +1,103 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/pkg/errors"
+)
+
+// SubmitVersionedAttestations submits attestations.
+func (s *Service) SubmitVersionedAttestations(ctx context.Context, opts *api.SubmitAttestationsOpts) error {
+	if opts == nil {
+		return errors.New("no options specified")
+	}
+	if len(opts.Attestations) == 0 {
+		return errors.New("no attestations specified")
+	}
+
+	version, specJSON, err := attestationsJSON(opts.Attestations)
+	if err != nil {
+		return err
+	}
+
+	headers := make(map[string]string)
+	headers["Eth-Consensus-Version"] = strings.ToLower(version.String())
+	_, err = s.post2(ctx, "/eth/v2/beacon/pool/attestations", bytes.NewReader(specJSON), ContentTypeJSON, headers)
+	if err != nil {
+		var apiErr *api.Error
+		if !errors.As(err, &apiErr) ||
+			(apiErr.StatusCode != http.StatusNotFound && apiErr.StatusCode != http.StatusMethodNotAllowed) ||
+			version >= spec.DataVersionElectra {
+			return errors.Wrap(err, "failed to submit beacon attestations")
+		}
+
+		// Beacon node does not support the v2 endpoint; use v1 instead.
+		if _, err := s.post(ctx, "/eth/v1/beacon/pool/attestations", bytes.NewReader(specJSON)); err != nil {
+			return errors.Wrap(err, "failed to submit beacon attestations")
+		}
+	}
+
+	return nil
+}
+
+// attestationsJSON returns the version and JSON encoding of the attestations.
+// All attestations must be of the same version.
+func attestationsJSON(attestations []*spec.VersionedAttestation) (spec.DataVersion, []byte, error) {
+	var version spec.DataVersion
+	data := make([]any, len(attestations))
+	for i, attestation := range attestations {
+		if attestation == nil {
+			return spec.DataVersionUnknown, nil, fmt.Errorf("attestation %d is nil", i)
+		}
+		if i == 0 {
+			version = attestation.Version
+		}
+		if attestation.Version != version {
+			return spec.DataVersionUnknown, nil, errors.New("attestations have differing versions")
+		}
+		if _, err := attestation.Data(); err != nil {
+			return spec.DataVersionUnknown, nil, errors.Wrapf(err, "invalid attestation %d", i)
+		}
+		switch attestation.Version {
+		case spec.DataVersionPhase0:
+			data[i] = attestation.Phase0
+		case spec.DataVersionAltair:
+			data[i] = attestation.Altair
+		case spec.DataVersionBellatrix:
+			data[i] = attestation.Bellatrix
+		case spec.DataVersionCapella:
+			data[i] = attestation.Capella
+		case spec.DataVersionDeneb:
+			data[i] = attestation.Deneb
+		case spec.DataVersionElectra:
+			data[i] = attestation.Electra
+		}
+	}
+
+	specJSON, err := json.Marshal(data)
+	if err != nil {
+		return spec.DataVersionUnknown, nil, errors.Wrap(err, "failed to marshal JSON")
+	}
+
+	return version, specJSON, nil
+}
@@ -56,7 +56,7 @@ func (s *Service) SyncCommitteeDuties(ctx context.Context,
 	}
 
 	url := fmt.Sprintf("/eth/v1/validator/duties/sync/%d", opts.Epoch)
-	respBodyReader, err := s.post(ctx, url, &reqBodyReader)
+	respBodyReader, err := s.idempotentPost(ctx, url, reqBodyReader.Bytes(), &opts.Common)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request sync committee duties")
 	}
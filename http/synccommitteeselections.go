@@ -0,0 +1,64 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+// SyncCommitteeSelections exchanges partial sync committee selections for aggregated sync committee selections.
+// This is only supported when connected to distributed validator middleware.
+func (s *Service) SyncCommitteeSelections(ctx context.Context,
+	opts *api.SyncCommitteeSelectionsOpts,
+) (
+	*api.Response[[]*apiv1.SyncCommitteeSelection],
+	error,
+) {
+	if opts == nil {
+		return nil, errors.New("no options specified")
+	}
+	if len(opts.Selections) == 0 {
+		return nil, errors.New("no selections specified")
+	}
+	if !s.connectedToDVTMiddleware {
+		return nil, errors.New("sync committee selections require distributed validator middleware")
+	}
+
+	reqData, err := json.Marshal(opts.Selections)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal request data")
+	}
+
+	url := "/eth/v1/validator/sync_committee_selections"
+	respBodyReader, err := s.post(ctx, url, bytes.NewReader(reqData))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request sync committee selections")
+	}
+
+	data, metadata, err := decodeJSONResponse(respBodyReader, []*apiv1.SyncCommitteeSelection{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[[]*apiv1.SyncCommitteeSelection]{
+		Data:     data,
+		Metadata: metadata,
+	}, nil
+}
@@ -14,9 +14,10 @@
 package http
 
 import (
-	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/attestantio/go-eth2-client/api"
@@ -39,6 +40,54 @@ func (s *Service) ValidatorBalances(ctx context.Context,
 		return nil, errors.New("no state specified")
 	}
 
+	if len(opts.Indices) == 0 {
+		// Request is for all validators; there is nothing to be gained from POST.
+		return s.validatorBalancesViaGet(ctx, opts)
+	}
+
+	response, err := s.validatorBalancesViaPost(ctx, opts)
+	if err != nil {
+		if postEndpointUnsupported(err) {
+			// Beacon node does not support the POST endpoint; use GET instead.
+			return s.validatorBalancesViaGet(ctx, opts)
+		}
+
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// validatorBalancesViaPost fetches the validator balances in a single request using the POST endpoint.
+func (s *Service) validatorBalancesViaPost(ctx context.Context,
+	opts *api.ValidatorBalancesOpts,
+) (
+	*api.Response[map[phase0.ValidatorIndex]phase0.Gwei],
+	error,
+) {
+	reqData, err := validatorIDsJSON(opts.Indices, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("/eth/v1/beacon/states/%s/validator_balances", opts.State)
+	respBody, err := s.idempotentPostStream(ctx, url, reqData, &opts.Common)
+	if err != nil {
+		return nil, err
+	}
+	defer respBody.Close()
+
+	return validatorBalancesFromJSON(respBody, opts)
+}
+
+// validatorBalancesViaGet fetches the validator balances using the GET endpoint,
+// splitting the request in to chunks if required.
+func (s *Service) validatorBalancesViaGet(ctx context.Context,
+	opts *api.ValidatorBalancesOpts,
+) (
+	*api.Response[map[phase0.ValidatorIndex]phase0.Gwei],
+	error,
+) {
 	if len(opts.Indices) > s.indexChunkSize(ctx) {
 		return s.chunkedValidatorBalances(ctx, opts)
 	}
@@ -52,14 +101,15 @@ func (s *Service) ValidatorBalances(ctx context.Context,
 		url = fmt.Sprintf("%s?id=%s", url, strings.Join(ids, ","))
 	}
 
-	httpResponse, err := s.get(ctx, url, &opts.Common)
+	httpResponse, err := s.getStream(ctx, url, &opts.Common)
 	if err != nil {
 		return nil, err
 	}
+	defer httpResponse.bodyReader.Close()
 
 	switch httpResponse.contentType {
 	case ContentTypeJSON:
-		return s.validatorBalancesFromJSON(ctx, httpResponse)
+		return validatorBalancesFromJSON(httpResponse.bodyReader, opts)
 	default:
 		return nil, fmt.Errorf("unhandled content type %v", httpResponse.contentType)
 	}
@@ -72,7 +122,9 @@ func (s *Service) chunkedValidatorBalances(ctx context.Context,
 	*api.Response[map[phase0.ValidatorIndex]phase0.Gwei],
 	error,
 ) {
-	response := &api.Response[map[phase0.ValidatorIndex]phase0.Gwei]{}
+	response := &api.Response[map[phase0.ValidatorIndex]phase0.Gwei]{
+		Data: make(map[phase0.ValidatorIndex]phase0.Gwei),
+	}
 
 	chunkSize := s.indexChunkSize(ctx)
 	for i := 0; i < len(opts.Indices); i += chunkSize {
@@ -82,10 +134,12 @@ func (s *Service) chunkedValidatorBalances(ctx context.Context,
 			chunkEnd = len(opts.Indices)
 		}
 		chunkOpts := &api.ValidatorBalancesOpts{
-			State:   opts.State,
-			Indices: opts.Indices[chunkStart:chunkEnd],
+			Common:      opts.Common,
+			State:       opts.State,
+			Indices:     opts.Indices[chunkStart:chunkEnd],
+			BalanceFunc: opts.BalanceFunc,
 		}
-		chunkResponse, err := s.ValidatorBalances(ctx, chunkOpts)
+		chunkResponse, err := s.validatorBalancesViaGet(ctx, chunkOpts)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to obtain chunk")
 		}
@@ -98,25 +152,33 @@ func (s *Service) chunkedValidatorBalances(ctx context.Context,
 	return response, nil
 }
 
-func (s *Service) validatorBalancesFromJSON(_ context.Context,
-	httpResponse *httpResponse,
+// validatorBalancesFromJSON decodes the validator balances as they are read from
+// the body, passing them to the user-supplied function if present.
+func validatorBalancesFromJSON(body io.Reader,
+	opts *api.ValidatorBalancesOpts,
 ) (
 	*api.Response[map[phase0.ValidatorIndex]phase0.Gwei],
 	error,
 ) {
-	data, metadata, err := decodeJSONResponse(bytes.NewReader(httpResponse.body), []*apiv1.ValidatorBalance{})
-	if err != nil {
-		return nil, err
-	}
+	res := make(map[phase0.ValidatorIndex]phase0.Gwei)
+	handler := func(balance *apiv1.ValidatorBalance) error {
+		if opts.BalanceFunc != nil {
+			return opts.BalanceFunc(balance.Index, balance.Balance)
+		}
+		res[balance.Index] = balance.Balance
 
-	response := &api.Response[map[phase0.ValidatorIndex]phase0.Gwei]{
-		Data:     make(map[phase0.ValidatorIndex]phase0.Gwei),
-		Metadata: metadata,
+		return nil
 	}
 
-	for _, datum := range data {
-		response.Data[datum.Index] = datum.Balance
+	metadata, err := decodeJSONResponseStream(body, func(decoder *json.Decoder) error {
+		return decodeJSONArray(decoder, handler)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	return &api.Response[map[phase0.ValidatorIndex]phase0.Gwei]{
+		Data:     res,
+		Metadata: metadata,
+	}, nil
 }
@@ -72,6 +72,17 @@ func TestValidatorBalances(t *testing.T) {
 				123: 32000000000,
 			},
 		},
+		{
+			name: "MultipleGenesisIndices",
+			opts: &api.ValidatorBalancesOpts{
+				State:   "0",
+				Indices: []phase0.ValidatorIndex{1, 123},
+			},
+			expected: map[phase0.ValidatorIndex]phase0.Gwei{
+				1:   32000000000,
+				123: 32000000000,
+			},
+		},
 		{
 			name: "AllGenesis",
 			opts: &api.ValidatorBalancesOpts{
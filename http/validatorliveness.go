@@ -0,0 +1,100 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+// ValidatorLiveness provides the liveness of validators for the given options.
+func (s *Service) ValidatorLiveness(ctx context.Context,
+	opts *api.ValidatorLivenessOpts,
+) (
+	*api.Response[[]*apiv1.ValidatorLiveness],
+	error,
+) {
+	if opts == nil {
+		return nil, errors.New("no options specified")
+	}
+	if len(opts.Indices) == 0 {
+		return nil, errors.New("no validator indices specified")
+	}
+
+	if len(opts.Indices) > s.indexChunkSize(ctx) {
+		return s.chunkedValidatorLiveness(ctx, opts)
+	}
+
+	reqBody, err := validatorIDsJSON(opts.Indices, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("/eth/v1/validator/liveness/%d", opts.Epoch)
+	respBodyReader, err := s.idempotentPost(ctx, url, reqBody, &opts.Common)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request validator liveness")
+	}
+
+	data, metadata, err := decodeJSONResponse(respBodyReader, []*apiv1.ValidatorLiveness{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[[]*apiv1.ValidatorLiveness]{
+		Data:     data,
+		Metadata: metadata,
+	}, nil
+}
+
+// chunkedValidatorLiveness obtains the validator liveness a chunk at a time.
+func (s *Service) chunkedValidatorLiveness(ctx context.Context,
+	opts *api.ValidatorLivenessOpts,
+) (
+	*api.Response[[]*apiv1.ValidatorLiveness],
+	error,
+) {
+	data := make([]*apiv1.ValidatorLiveness, 0, len(opts.Indices))
+	metadata := make(map[string]any)
+	indexChunkSize := s.indexChunkSize(ctx)
+	for i := 0; i < len(opts.Indices); i += indexChunkSize {
+		chunkStart := i
+		chunkEnd := i + indexChunkSize
+		if len(opts.Indices) < chunkEnd {
+			chunkEnd = len(opts.Indices)
+		}
+		chunk := opts.Indices[chunkStart:chunkEnd]
+		chunkRes, err := s.ValidatorLiveness(ctx, &api.ValidatorLivenessOpts{
+			Common:  opts.Common,
+			Epoch:   opts.Epoch,
+			Indices: chunk,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain chunk")
+		}
+		data = append(data, chunkRes.Data...)
+		for k, v := range chunkRes.Metadata {
+			metadata[k] = v
+		}
+	}
+
+	return &api.Response[[]*apiv1.ValidatorLiveness]{
+		Data:     data,
+		Metadata: metadata,
+	}, nil
+}
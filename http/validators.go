@@ -14,9 +14,10 @@
 package http
 
 import (
-	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/attestantio/go-eth2-client/api"
@@ -111,6 +112,31 @@ func (s *Service) pubKeyChunkSize(ctx context.Context) int {
 	}
 }
 
+// validatorIDs provides the API representation of validator IDs, either as
+// indices or as public keys.
+func validatorIDs(indices []phase0.ValidatorIndex, pubKeys []phase0.BLSPubKey) []string {
+	ids := make([]string, 0, len(indices)+len(pubKeys))
+	for i := range indices {
+		ids = append(ids, fmt.Sprintf("%d", indices[i]))
+	}
+	for i := range pubKeys {
+		ids = append(ids, pubKeys[i].String())
+	}
+
+	return ids
+}
+
+// validatorIDsJSON provides the JSON body for endpoints that accept a list of
+// validator IDs, either as indices or as public keys.
+func validatorIDsJSON(indices []phase0.ValidatorIndex, pubKeys []phase0.BLSPubKey) ([]byte, error) {
+	data, err := json.Marshal(validatorIDs(indices, pubKeys))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal validator IDs")
+	}
+
+	return data, nil
+}
+
 // Validators provides the validators, with their balance and status, for the given options.
 func (s *Service) Validators(ctx context.Context,
 	opts *api.ValidatorsOpts,
@@ -133,6 +159,98 @@ func (s *Service) Validators(ctx context.Context,
 		return s.validatorsFromState(ctx, opts)
 	}
 
+	response, err := s.validatorsViaPost(ctx, opts)
+	if err != nil {
+		if postEndpointUnsupported(err) {
+			// Beacon node does not support the POST endpoint; use GET instead.
+			return s.validatorsViaGet(ctx, opts)
+		}
+
+		return nil, errors.Wrap(err, "failed to request validators")
+	}
+
+	return response, nil
+}
+
+// postEndpointUnsupported returns true if the error from a POST request shows that
+// the beacon node does not support the POST endpoint.
+func postEndpointUnsupported(err error) bool {
+	var apiErr *api.Error
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	switch apiErr.StatusCode {
+	case http.StatusMethodNotAllowed:
+		return true
+	case http.StatusNotFound:
+		// A 404 is also returned if the state is not found, in which case the
+		// GET endpoint would fail in the same way.
+		return !isStateNotFound(apiErr.Data)
+	default:
+		return false
+	}
+}
+
+// isStateNotFound returns true if the body of an error response is a beacon API
+// error stating that the requested state was not found.
+func isStateNotFound(data []byte) bool {
+	var errorResponse struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(data, &errorResponse); err != nil {
+		return false
+	}
+
+	return errorResponse.Code == http.StatusNotFound &&
+		strings.Contains(strings.ToLower(errorResponse.Message), "state")
+}
+
+// validatorsViaPost fetches the validators in a single request using the POST endpoint.
+func (s *Service) validatorsViaPost(ctx context.Context,
+	opts *api.ValidatorsOpts,
+) (
+	*api.Response[map[phase0.ValidatorIndex]*apiv1.Validator],
+	error,
+) {
+	reqData, err := json.Marshal(&validatorsRequestJSON{
+		IDs:      validatorIDs(opts.Indices, opts.PubKeys),
+		Statuses: validatorStatesStrings(opts.Statuses),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal request data")
+	}
+
+	url := fmt.Sprintf("/eth/v1/beacon/states/%s/validators", opts.State)
+	respBody, err := s.idempotentPostStream(ctx, url, reqData, &opts.Common)
+	if err != nil {
+		return nil, err
+	}
+	defer respBody.Close()
+
+	res := make(map[phase0.ValidatorIndex]*apiv1.Validator)
+	metadata, err := decodeJSONResponseStream(respBody, func(decoder *json.Decoder) error {
+		return decodeJSONArray(decoder, validatorHandler(opts, res))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Response[map[phase0.ValidatorIndex]*apiv1.Validator]{
+		Data:     res,
+		Metadata: metadata,
+	}, nil
+}
+
+// validatorsViaGet fetches the validators using the GET endpoint, splitting
+// the request in to chunks if required.
+func (s *Service) validatorsViaGet(ctx context.Context,
+	opts *api.ValidatorsOpts,
+) (
+	*api.Response[map[phase0.ValidatorIndex]*apiv1.Validator],
+	error,
+) {
 	if len(opts.Indices) > indexChunkSizes["default"]*2 || len(opts.PubKeys) > pubKeyChunkSizes["default"]*2 {
 		// Request is for multiple pages of validators; fetch from state.
 		return s.validatorsFromState(ctx, opts)
@@ -143,43 +261,84 @@ func (s *Service) Validators(ctx context.Context,
 	}
 
 	url := fmt.Sprintf("/eth/v1/beacon/states/%s/validators", opts.State)
+	additionalFields := make([]string, 0, 2)
 	switch {
 	case len(opts.Indices) > 0:
 		ids := make([]string, len(opts.Indices))
 		for i := range opts.Indices {
 			ids[i] = fmt.Sprintf("%d", opts.Indices[i])
 		}
-		url = fmt.Sprintf("%s?id=%s", url, strings.Join(ids, ","))
+		additionalFields = append(additionalFields, fmt.Sprintf("id=%s", strings.Join(ids, ",")))
 	case len(opts.PubKeys) > 0:
 		ids := make([]string, len(opts.PubKeys))
 		for i := range opts.PubKeys {
 			ids[i] = opts.PubKeys[i].String()
 		}
-		url = fmt.Sprintf("%s?id=%s", url, strings.Join(ids, ","))
+		additionalFields = append(additionalFields, fmt.Sprintf("id=%s", strings.Join(ids, ",")))
+	}
+	if len(opts.Statuses) > 0 {
+		additionalFields = append(additionalFields, fmt.Sprintf("status=%s", strings.Join(validatorStatesStrings(opts.Statuses), ",")))
+	}
+	if len(additionalFields) > 0 {
+		url = fmt.Sprintf("%s?%s", url, strings.Join(additionalFields, "&"))
 	}
 
-	httpResponse, err := s.get(ctx, url, &opts.Common)
+	httpResponse, err := s.getStream(ctx, url, &opts.Common)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request validators")
 	}
+	defer httpResponse.bodyReader.Close()
 
-	data, metadata, err := decodeJSONResponse(bytes.NewReader(httpResponse.body), []*apiv1.Validator{})
+	res := make(map[phase0.ValidatorIndex]*apiv1.Validator)
+	metadata, err := decodeJSONResponseStream(httpResponse.bodyReader, func(decoder *json.Decoder) error {
+		return decodeJSONArray(decoder, validatorHandler(opts, res))
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	// Data is returned as an array but we want it as a map.
-	mapData := make(map[phase0.ValidatorIndex]*apiv1.Validator)
-	for _, validator := range data {
-		mapData[validator.Index] = validator
-	}
-
 	return &api.Response[map[phase0.ValidatorIndex]*apiv1.Validator]{
-		Data:     mapData,
+		Data:     res,
 		Metadata: metadata,
 	}, nil
 }
 
+// validatorsRequestJSON is the body of a POST request for validators.
+type validatorsRequestJSON struct {
+	IDs      []string `json:"ids,omitempty"`
+	Statuses []string `json:"statuses,omitempty"`
+}
+
+// validatorStatesStrings provides the API representation of validator states.
+func validatorStatesStrings(states []apiv1.ValidatorState) []string {
+	if len(states) == 0 {
+		return nil
+	}
+
+	res := make([]string, len(states))
+	for i := range states {
+		res[i] = states[i].String()
+	}
+
+	return res
+}
+
+// validatorHandler returns a function to handle each validator as it is decoded,
+// either passing it to the user-supplied function or adding it to the results.
+func validatorHandler(opts *api.ValidatorsOpts,
+	res map[phase0.ValidatorIndex]*apiv1.Validator,
+) func(*apiv1.Validator) error {
+	if opts.ValidatorFunc != nil {
+		return opts.ValidatorFunc
+	}
+
+	return func(validator *apiv1.Validator) error {
+		res[validator.Index] = validator
+
+		return nil
+	}
+}
+
 // validatorsFromState fetches all validators from state.
 // This is more efficient than fetching the validators endpoint, as validators uses JSON only,
 // whereas state can be provided using SSZ.
@@ -231,8 +390,13 @@ func (s *Service) validatorsFromState(ctx context.Context,
 	for _, pubkey := range opts.PubKeys {
 		pubkeys[pubkey] = struct{}{}
 	}
+	states := make(map[apiv1.ValidatorState]struct{})
+	for _, state := range opts.Statuses {
+		states[state] = struct{}{}
+	}
 
-	res := make(map[phase0.ValidatorIndex]*apiv1.Validator, len(validators))
+	res := make(map[phase0.ValidatorIndex]*apiv1.Validator)
+	handler := validatorHandler(opts, res)
 	for i, validator := range validators {
 		if len(pubkeys) > 0 {
 			if _, exists := pubkeys[validator.PublicKey]; !exists {
@@ -250,11 +414,20 @@ func (s *Service) validatorsFromState(ctx context.Context,
 		}
 
 		state := apiv1.ValidatorToState(validator, &balances[i], epoch, farFutureEpoch)
-		res[index] = &apiv1.Validator{
+		if len(states) > 0 {
+			if _, exists := states[state]; !exists {
+				// We want specific states, and this isn't one of them.  Ignore.
+				continue
+			}
+		}
+
+		if err := handler(&apiv1.Validator{
 			Index:     index,
 			Balance:   balances[i],
 			Status:    state,
 			Validator: validator,
+		}); err != nil {
+			return nil, err
 		}
 	}
 
@@ -295,7 +468,13 @@ func (s *Service) chunkedValidatorsByIndex(ctx context.Context,
 			chunkEnd = len(opts.Indices)
 		}
 		chunk := opts.Indices[chunkStart:chunkEnd]
-		chunkRes, err := s.Validators(ctx, &api.ValidatorsOpts{State: opts.State, Indices: chunk})
+		chunkRes, err := s.validatorsViaGet(ctx, &api.ValidatorsOpts{
+			Common:        opts.Common,
+			State:         opts.State,
+			Indices:       chunk,
+			Statuses:      opts.Statuses,
+			ValidatorFunc: opts.ValidatorFunc,
+		})
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to obtain chunk")
 		}
@@ -330,7 +509,13 @@ func (s *Service) chunkedValidatorsByPubkey(ctx context.Context,
 			chunkEnd = len(opts.PubKeys)
 		}
 		chunk := opts.PubKeys[chunkStart:chunkEnd]
-		chunkRes, err := s.Validators(ctx, &api.ValidatorsOpts{State: opts.State, PubKeys: chunk})
+		chunkRes, err := s.validatorsViaGet(ctx, &api.ValidatorsOpts{
+			Common:        opts.Common,
+			State:         opts.State,
+			PubKeys:       chunk,
+			Statuses:      opts.Statuses,
+			ValidatorFunc: opts.ValidatorFunc,
+		})
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to obtain chunk")
 		}
@@ -0,0 +1,185 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// streamingTestService returns a service connected to a server that sends the
+// first item of its response, then waits until released before sending the rest.
+func streamingTestService(t *testing.T, first string, rest string) (*Service, chan struct{}) {
+	t.Helper()
+
+	release := make(chan struct{})
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.Method != nethttp.MethodPost {
+			w.WriteHeader(nethttp.StatusMethodNotAllowed)
+
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"execution_optimistic":false,"data":[` + first))
+		w.(nethttp.Flusher).Flush()
+		<-release
+		_, _ = w.Write([]byte(rest + `]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	base, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	return &Service{
+		log:     zerolog.Nop(),
+		base:    base,
+		address: srv.URL,
+		client:  srv.Client(),
+		timeout: 5 * time.Second,
+	}, release
+}
+
+func validatorJSON(index int) string {
+	return fmt.Sprintf(`{"index":"%d","balance":"32000000000","status":"active_ongoing","validator":{"pubkey":"0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b","withdrawal_credentials":"0x00ec7ef7780c9d151597924036262dd28dc60e1228f4da6fecf9d402cb3f3594","effective_balance":"32000000000","slashed":false,"activation_eligibility_epoch":"0","activation_epoch":"0","exit_epoch":"18446744073709551615","withdrawable_epoch":"18446744073709551615"}}`, index)
+}
+
+func TestValidatorsViaPostStreams(t *testing.T) {
+	ctx := context.Background()
+	s, release := streamingTestService(t, validatorJSON(1), ","+validatorJSON(2))
+
+	indices := make([]phase0.ValidatorIndex, 0)
+	response, err := s.Validators(ctx, &api.ValidatorsOpts{
+		State:   "head",
+		Indices: []phase0.ValidatorIndex{1, 2},
+		ValidatorFunc: func(validator *apiv1.Validator) error {
+			if validator.Index == 1 {
+				// The first validator is handled before the server has sent the rest of the response.
+				close(release)
+			}
+			indices = append(indices, validator.Index)
+
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []phase0.ValidatorIndex{1, 2}, indices)
+	require.Empty(t, response.Data)
+	require.Equal(t, false, response.Metadata["execution_optimistic"])
+}
+
+func TestValidatorBalancesViaPostStreams(t *testing.T) {
+	ctx := context.Background()
+	s, release := streamingTestService(t,
+		`{"index":"1","balance":"32000000000"}`,
+		`,{"index":"2","balance":"31000000000"}`,
+	)
+
+	balances := make(map[phase0.ValidatorIndex]phase0.Gwei)
+	_, err := s.ValidatorBalances(ctx, &api.ValidatorBalancesOpts{
+		State:   "head",
+		Indices: []phase0.ValidatorIndex{1, 2},
+		BalanceFunc: func(index phase0.ValidatorIndex, balance phase0.Gwei) error {
+			if index == 1 {
+				close(release)
+			}
+			balances[index] = balance
+
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[phase0.ValidatorIndex]phase0.Gwei{1: 32000000000, 2: 31000000000}, balances)
+}
+
+func TestValidatorsPostFallback(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		postStatus int
+		postBody   string
+		gets       int
+		err        string
+	}{
+		{
+			name:       "MethodNotAllowed",
+			postStatus: nethttp.StatusMethodNotAllowed,
+			gets:       1,
+		},
+		{
+			name:       "EndpointNotFound",
+			postStatus: nethttp.StatusNotFound,
+			postBody:   `404 page not found`,
+			gets:       1,
+		},
+		{
+			name:       "StateNotFound",
+			postStatus: nethttp.StatusNotFound,
+			postBody:   `{"code":404,"message":"State not found"}`,
+			err:        `failed to request validators: POST failed with status 404: {"code":404,"message":"State not found"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gets := 0
+			srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+				if r.Method == nethttp.MethodPost {
+					w.WriteHeader(test.postStatus)
+					_, _ = w.Write([]byte(test.postBody))
+
+					return
+				}
+				if r.URL.Path == "/eth/v1/beacon/states/head/validators" {
+					gets++
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"execution_optimistic":false,"data":[` + validatorJSON(1) + `]}`))
+			}))
+			defer srv.Close()
+			base, err := url.Parse(srv.URL)
+			require.NoError(t, err)
+			s := &Service{
+				log:     zerolog.Nop(),
+				base:    base,
+				address: srv.URL,
+				client:  srv.Client(),
+				timeout: 5 * time.Second,
+			}
+
+			response, err := s.Validators(ctx, &api.ValidatorsOpts{
+				State:   "head",
+				Indices: []phase0.ValidatorIndex{1},
+			})
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Len(t, response.Data, 1)
+			}
+			require.Equal(t, test.gets, gets)
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 
 	client "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/http"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/stretchr/testify/require"
@@ -70,6 +71,21 @@ func TestValidators(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "ActiveOngoing",
+			opts: &api.ValidatorsOpts{
+				State:    "head",
+				Statuses: []apiv1.ValidatorState{apiv1.ValidatorStateActiveOngoing},
+			},
+		},
+		{
+			name: "IndicesActiveOngoing",
+			opts: &api.ValidatorsOpts{
+				State:    "head",
+				Indices:  []phase0.ValidatorIndex{0, 1, 2, 3},
+				Statuses: []apiv1.ValidatorState{apiv1.ValidatorStateActiveOngoing},
+			},
+		},
 		{
 			name: "ManyValidatorPubkeys",
 			opts: &api.ValidatorsOpts{
@@ -0,0 +1,126 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// VersionedAggregateAttestation fetches the aggregate attestation for the given options.
+func (s *Service) VersionedAggregateAttestation(ctx context.Context,
+	opts *api.AggregateAttestationOpts,
+) (
+	*api.Response[*spec.VersionedAttestation],
+	error,
+) {
+	if opts == nil {
+		return nil, errors.New("no options specified")
+	}
+	if opts.AttestationDataRoot.IsZero() {
+		return nil, errors.New("no attestation data root specified")
+	}
+
+	url := fmt.Sprintf("/eth/v2/validator/aggregate_attestation?slot=%d&attestation_data_root=%#x&committee_index=%d",
+		opts.Slot, opts.AttestationDataRoot, opts.CommitteeIndex)
+	httpResponse, err := s.get(ctx, url, &opts.Common)
+	if err != nil {
+		var apiErr *api.Error
+		if !errors.As(err, &apiErr) ||
+			(apiErr.StatusCode != http.StatusNotFound && apiErr.StatusCode != http.StatusMethodNotAllowed) {
+			return nil, err
+		}
+
+		// Beacon node does not support the v2 endpoint; use v1 instead.
+		url = fmt.Sprintf("/eth/v1/validator/aggregate_attestation?slot=%d&attestation_data_root=%#x", opts.Slot, opts.AttestationDataRoot)
+		httpResponse, err = s.get(ctx, url, &opts.Common)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	version := httpResponse.consensusVersion
+	if version == spec.DataVersionUnknown {
+		// The v1 endpoint does not supply a version, so obtain it from the slot.
+		version, err = s.dataVersionAtSlot(ctx, opts.Slot)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	response := &api.Response[*spec.VersionedAttestation]{
+		Data: &spec.VersionedAttestation{
+			Version: version,
+		},
+	}
+	switch version {
+	case spec.DataVersionPhase0:
+		response.Data.Phase0, response.Metadata, err = decodeJSONResponse(bytes.NewReader(httpResponse.body), &phase0.Attestation{})
+	case spec.DataVersionAltair:
+		response.Data.Altair, response.Metadata, err = decodeJSONResponse(bytes.NewReader(httpResponse.body), &phase0.Attestation{})
+	case spec.DataVersionBellatrix:
+		response.Data.Bellatrix, response.Metadata, err = decodeJSONResponse(bytes.NewReader(httpResponse.body), &phase0.Attestation{})
+	case spec.DataVersionCapella:
+		response.Data.Capella, response.Metadata, err = decodeJSONResponse(bytes.NewReader(httpResponse.body), &phase0.Attestation{})
+	case spec.DataVersionDeneb:
+		response.Data.Deneb, response.Metadata, err = decodeJSONResponse(bytes.NewReader(httpResponse.body), &phase0.Attestation{})
+	case spec.DataVersionElectra:
+		response.Data.Electra, response.Metadata, err = decodeJSONResponse(bytes.NewReader(httpResponse.body), &electra.Attestation{})
+	default:
+		return nil, fmt.Errorf("unhandled version %s", version)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := verifyVersionedAggregateAttestation(opts, response.Data); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func verifyVersionedAggregateAttestation(opts *api.AggregateAttestationOpts, attestation *spec.VersionedAttestation) error {
+	data, err := attestation.Data()
+	if err != nil {
+		return err
+	}
+	if data == nil {
+		return errors.New("aggregate attestation has no data")
+	}
+
+	// Confirm the attestation is for the requested slot.
+	if data.Slot != opts.Slot {
+		return fmt.Errorf("received aggregate attestation for slot %d; expected %d", data.Slot, opts.Slot)
+	}
+
+	// Confirm the attestation data is correct.
+	dataRoot, err := data.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain hash tree root of aggregate attestation data")
+	}
+	if !bytes.Equal(dataRoot[:], opts.AttestationDataRoot[:]) {
+		return errors.New("aggregate attestation not for requested data root")
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// VersionedAttestationPool obtains the attestation pool for the given options.
+func (s *Service) VersionedAttestationPool(ctx context.Context,
+	opts *api.AttestationPoolOpts,
+) (
+	*api.Response[[]*spec.VersionedAttestation],
+	error,
+) {
+	if opts == nil {
+		return nil, errors.New("no options specified")
+	}
+
+	query := fmt.Sprintf("slot=%d", opts.Slot)
+	if opts.CommitteeIndex != nil {
+		query = fmt.Sprintf("%s&committee_index=%d", query, *opts.CommitteeIndex)
+	}
+
+	httpResponse, err := s.get(ctx, fmt.Sprintf("/eth/v2/beacon/pool/attestations?%s", query), &opts.Common)
+	if err != nil {
+		var apiErr *api.Error
+		if !errors.As(err, &apiErr) ||
+			(apiErr.StatusCode != http.StatusNotFound && apiErr.StatusCode != http.StatusMethodNotAllowed) {
+			return nil, err
+		}
+
+		// Beacon node does not support the v2 endpoint; use v1 instead.
+		httpResponse, err = s.get(ctx, fmt.Sprintf("/eth/v1/beacon/pool/attestations?%s", query), &opts.Common)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch httpResponse.contentType {
+	case ContentTypeJSON:
+		return s.versionedAttestationPoolFromJSON(ctx, opts, httpResponse)
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", httpResponse.contentType)
+	}
+}
+
+func (s *Service) versionedAttestationPoolFromJSON(ctx context.Context,
+	opts *api.AttestationPoolOpts,
+	httpResponse *httpResponse,
+) (
+	*api.Response[[]*spec.VersionedAttestation],
+	error,
+) {
+	version := httpResponse.consensusVersion
+	if version == spec.DataVersionUnknown {
+		// The v1 endpoint does not supply a version, so obtain it from the slot.
+		var err error
+		version, err = s.dataVersionAtSlot(ctx, opts.Slot)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	response := &api.Response[[]*spec.VersionedAttestation]{}
+	switch version {
+	case spec.DataVersionPhase0,
+		spec.DataVersionAltair,
+		spec.DataVersionBellatrix,
+		spec.DataVersionCapella,
+		spec.DataVersionDeneb:
+		data, metadata, err := decodeJSONResponse(bytes.NewReader(httpResponse.body), []*phase0.Attestation{})
+		if err != nil {
+			return nil, err
+		}
+		response.Data = spec.NewVersionedAttestations(version, data)
+		response.Metadata = metadata
+	case spec.DataVersionElectra:
+		data, metadata, err := decodeJSONResponse(bytes.NewReader(httpResponse.body), []*electra.Attestation{})
+		if err != nil {
+			return nil, err
+		}
+		response.Data = spec.NewVersionedElectraAttestations(data)
+		response.Metadata = metadata
+	default:
+		return nil, fmt.Errorf("unhandled version %s", version)
+	}
+
+	if err := verifyVersionedAttestationPool(opts, response.Data); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func verifyVersionedAttestationPool(opts *api.AttestationPoolOpts, data []*spec.VersionedAttestation) error {
+	for _, datum := range data {
+		attestationData, err := datum.Data()
+		if err != nil {
+			return err
+		}
+		if attestationData == nil {
+			return errors.New("attestation has no data")
+		}
+		if attestationData.Slot != opts.Slot {
+			return errors.New("attestation data not for requested slot")
+		}
+	}
+
+	return nil
+}
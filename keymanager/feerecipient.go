@@ -0,0 +1,69 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type feeRecipientJSON struct {
+	PubKey     *phase0.BLSPubKey          `json:"pubkey,omitempty"`
+	EthAddress bellatrix.ExecutionAddress `json:"ethaddress"`
+}
+
+// FeeRecipient obtains the fee recipient for the given validator.
+func (s *Service) FeeRecipient(ctx context.Context, pubKey phase0.BLSPubKey) (bellatrix.ExecutionAddress, error) {
+	data, err := s.do(ctx, http.MethodGet, fmt.Sprintf("/eth/v1/validator/%#x/feerecipient", pubKey), nil)
+	if err != nil {
+		return bellatrix.ExecutionAddress{}, errors.Wrap(err, "failed to request fee recipient")
+	}
+
+	res, err := decodeData[*feeRecipientJSON](data)
+	if err != nil {
+		return bellatrix.ExecutionAddress{}, err
+	}
+	if res == nil {
+		return bellatrix.ExecutionAddress{}, errors.New("fee recipient missing")
+	}
+
+	return res.EthAddress, nil
+}
+
+// SetFeeRecipient sets the fee recipient for the given validator.
+func (s *Service) SetFeeRecipient(ctx context.Context, pubKey phase0.BLSPubKey, feeRecipient bellatrix.ExecutionAddress) error {
+	_, err := s.do(ctx, http.MethodPost, fmt.Sprintf("/eth/v1/validator/%#x/feerecipient", pubKey), &feeRecipientJSON{
+		EthAddress: feeRecipient,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to set fee recipient")
+	}
+
+	return nil
+}
+
+// DeleteFeeRecipient removes the fee recipient for the given validator, reverting it to the default.
+func (s *Service) DeleteFeeRecipient(ctx context.Context, pubKey phase0.BLSPubKey) error {
+	_, err := s.do(ctx, http.MethodDelete, fmt.Sprintf("/eth/v1/validator/%#x/feerecipient", pubKey), nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to delete fee recipient")
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeRecipient(t *testing.T) {
+	ctx := context.Background()
+	pubKey := testBLSPubKey(t)
+	address := bellatrix.ExecutionAddress{0xab, 0xcf, 0x8e, 0x0d, 0x4e, 0x95, 0x87, 0x36, 0x9b, 0x23, 0x01, 0xd0, 0x79, 0x03, 0x47, 0x32, 0x03, 0x02, 0xcc, 0x09}
+
+	service, req := newTestService(t, 200, `{"data":{"pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","ethaddress":"0xabcf8e0d4e9587369b2301d0790347320302cc09"}}`)
+	res, err := service.FeeRecipient(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, address, res)
+	require.Equal(t, "GET", req.method)
+	require.Equal(t, "/eth/v1/validator/0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c/feerecipient", req.path)
+
+	service, req = newTestService(t, 202, "")
+	require.NoError(t, service.SetFeeRecipient(ctx, pubKey, address))
+	require.Equal(t, "POST", req.method)
+	require.JSONEq(t, `{"ethaddress":"0xAbcF8e0d4e9587369b2301D0790347320302cc09"}`, req.body)
+
+	service, req = newTestService(t, 204, "")
+	require.NoError(t, service.DeleteFeeRecipient(ctx, pubKey))
+	require.Equal(t, "DELETE", req.method)
+
+	service, _ = newTestService(t, 404, `{"message":"not found"}`)
+	_, err = service.FeeRecipient(ctx, pubKey)
+	require.EqualError(t, err, `failed to request fee recipient: GET failed with status 404: {"message":"not found"}`)
+}
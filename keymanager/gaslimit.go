@@ -0,0 +1,73 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type gasLimitJSON struct {
+	PubKey   *phase0.BLSPubKey `json:"pubkey,omitempty"`
+	GasLimit string            `json:"gas_limit"`
+}
+
+// GasLimit obtains the gas limit for the given validator.
+func (s *Service) GasLimit(ctx context.Context, pubKey phase0.BLSPubKey) (uint64, error) {
+	data, err := s.do(ctx, http.MethodGet, fmt.Sprintf("/eth/v1/validator/%#x/gas_limit", pubKey), nil)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to request gas limit")
+	}
+
+	res, err := decodeData[*gasLimitJSON](data)
+	if err != nil {
+		return 0, err
+	}
+	if res == nil || res.GasLimit == "" {
+		return 0, errors.New("gas limit missing")
+	}
+	gasLimit, err := strconv.ParseUint(res.GasLimit, 10, 64)
+	if err != nil {
+		return 0, errors.Wrap(err, "invalid value for gas limit")
+	}
+
+	return gasLimit, nil
+}
+
+// SetGasLimit sets the gas limit for the given validator.
+func (s *Service) SetGasLimit(ctx context.Context, pubKey phase0.BLSPubKey, gasLimit uint64) error {
+	_, err := s.do(ctx, http.MethodPost, fmt.Sprintf("/eth/v1/validator/%#x/gas_limit", pubKey), &gasLimitJSON{
+		GasLimit: strconv.FormatUint(gasLimit, 10),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to set gas limit")
+	}
+
+	return nil
+}
+
+// DeleteGasLimit removes the gas limit for the given validator, reverting it to the default.
+func (s *Service) DeleteGasLimit(ctx context.Context, pubKey phase0.BLSPubKey) error {
+	_, err := s.do(ctx, http.MethodDelete, fmt.Sprintf("/eth/v1/validator/%#x/gas_limit", pubKey), nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to delete gas limit")
+	}
+
+	return nil
+}
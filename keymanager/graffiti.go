@@ -0,0 +1,72 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type graffitiJSON struct {
+	PubKey   *phase0.BLSPubKey `json:"pubkey,omitempty"`
+	Graffiti string            `json:"graffiti"`
+}
+
+// Graffiti obtains the graffiti for the given validator.
+func (s *Service) Graffiti(ctx context.Context, pubKey phase0.BLSPubKey) (string, error) {
+	data, err := s.do(ctx, http.MethodGet, fmt.Sprintf("/eth/v1/validator/%#x/graffiti", pubKey), nil)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to request graffiti")
+	}
+
+	res, err := decodeData[*graffitiJSON](data)
+	if err != nil {
+		return "", err
+	}
+	if res == nil {
+		return "", errors.New("graffiti missing")
+	}
+
+	return res.Graffiti, nil
+}
+
+// SetGraffiti sets the graffiti for the given validator.
+func (s *Service) SetGraffiti(ctx context.Context, pubKey phase0.BLSPubKey, graffiti string) error {
+	if len(graffiti) > 32 {
+		return errors.New("graffiti too long")
+	}
+
+	_, err := s.do(ctx, http.MethodPost, fmt.Sprintf("/eth/v1/validator/%#x/graffiti", pubKey), &graffitiJSON{
+		Graffiti: graffiti,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to set graffiti")
+	}
+
+	return nil
+}
+
+// DeleteGraffiti removes the graffiti for the given validator, reverting it to the default.
+func (s *Service) DeleteGraffiti(ctx context.Context, pubKey phase0.BLSPubKey) error {
+	_, err := s.do(ctx, http.MethodDelete, fmt.Sprintf("/eth/v1/validator/%#x/graffiti", pubKey), nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to delete graffiti")
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraffiti(t *testing.T) {
+	ctx := context.Background()
+	pubKey := testBLSPubKey(t)
+
+	service, req := newTestService(t, 200, `{"data":{"pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","graffiti":"hello"}}`)
+	res, err := service.Graffiti(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, "hello", res)
+	require.Equal(t, "/eth/v1/validator/0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c/graffiti", req.path)
+
+	service, req = newTestService(t, 202, "")
+	require.EqualError(t, service.SetGraffiti(ctx, pubKey, strings.Repeat("x", 33)), "graffiti too long")
+	require.NoError(t, service.SetGraffiti(ctx, pubKey, "hello"))
+	require.Equal(t, "POST", req.method)
+	require.JSONEq(t, `{"graffiti":"hello"}`, req.body)
+
+	service, req = newTestService(t, 204, "")
+	require.NoError(t, service.DeleteGraffiti(ctx, pubKey))
+	require.Equal(t, "DELETE", req.method)
+}
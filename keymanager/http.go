@@ -0,0 +1,107 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/pkg/errors"
+)
+
+// dataResponseJSON is the standard wrapper for keymanager API responses.
+type dataResponseJSON[T any] struct {
+	Data T `json:"data"`
+}
+
+// do carries out a request against the keymanager API, returning the body of a successful response.
+func (s *Service) do(ctx context.Context, method string, endpoint string, reqBody any) ([]byte, error) {
+	log := s.log.With().Str("address", s.address).Str("method", method).Str("endpoint", endpoint).Logger()
+
+	var body io.Reader
+	if reqBody != nil {
+		data, err := json.Marshal(reqBody)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal request")
+		}
+		body = bytes.NewReader(data)
+	}
+
+	url, err := url.Parse(fmt.Sprintf("%s%s", strings.TrimSuffix(s.base.String(), "/"), endpoint))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid endpoint")
+	}
+
+	opCtx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(opCtx, method, url.String(), body)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to create %s request", method))
+	}
+	for k, v := range s.extraHeaders {
+		req.Header.Add(k, v)
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", s.token))
+	req.Header.Set("Accept", "application/json")
+	if reqBody != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", "go-eth2-client/0.19.10")
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to call %s endpoint", method))
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read response")
+	}
+
+	if resp.StatusCode/100 != 2 {
+		log.Debug().Int("status_code", resp.StatusCode).Str("data", string(data)).Msg("Request failed")
+
+		return nil, &api.Error{
+			Method:     method,
+			StatusCode: resp.StatusCode,
+			Endpoint:   endpoint,
+			Data:       data,
+		}
+	}
+	log.Trace().Int("status_code", resp.StatusCode).Str("response", string(data)).Msg("Request succeeded")
+
+	return data, nil
+}
+
+// decodeData decodes the data field of a keymanager API response.
+func decodeData[T any](data []byte) (T, error) {
+	var res dataResponseJSON[T]
+	if err := json.Unmarshal(data, &res); err != nil {
+		var empty T
+
+		return empty, errors.Wrap(err, "failed to parse response")
+	}
+
+	return res.Data, nil
+}
@@ -0,0 +1,118 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// Keystore is a local keystore held by the validator client.
+type Keystore struct {
+	// ValidatingPubKey is the public key of the validator.
+	ValidatingPubKey phase0.BLSPubKey `json:"validating_pubkey"`
+	// DerivationPath is the derivation path of the key, if known.
+	DerivationPath string `json:"derivation_path,omitempty"`
+	// ReadOnly is true if the key cannot be deleted.
+	ReadOnly bool `json:"readonly,omitempty"`
+}
+
+// DeleteKeystoresResponse is the response to a request to delete keystores.
+type DeleteKeystoresResponse struct {
+	// Statuses are the results of the deletions, in the order of the request.
+	Statuses []*OperationStatus
+	// SlashingProtection is the EIP-3076 slashing protection data for the deleted keys.
+	SlashingProtection string
+}
+
+type importKeystoresRequestJSON struct {
+	Keystores          []string `json:"keystores"`
+	Passwords          []string `json:"passwords"`
+	SlashingProtection string   `json:"slashing_protection,omitempty"`
+}
+
+type pubKeysRequestJSON struct {
+	PubKeys []phase0.BLSPubKey `json:"pubkeys"`
+}
+
+type deleteKeystoresResponseJSON struct {
+	Data               []*OperationStatus `json:"data"`
+	SlashingProtection string             `json:"slashing_protection"`
+}
+
+// ListKeystores lists the local keystores held by the validator client.
+func (s *Service) ListKeystores(ctx context.Context) ([]*Keystore, error) {
+	data, err := s.do(ctx, http.MethodGet, "/eth/v1/keystores", nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request keystores")
+	}
+
+	return decodeData[[]*Keystore](data)
+}
+
+// ImportKeystores imports EIP-2335 keystores, with their passwords and optional EIP-3076 slashing protection data.
+func (s *Service) ImportKeystores(ctx context.Context,
+	keystores []string,
+	passwords []string,
+	slashingProtection string,
+) (
+	[]*OperationStatus,
+	error,
+) {
+	if len(keystores) == 0 {
+		return nil, errors.New("no keystores specified")
+	}
+	if len(keystores) != len(passwords) {
+		return nil, errors.New("number of keystores and passwords differ")
+	}
+
+	data, err := s.do(ctx, http.MethodPost, "/eth/v1/keystores", &importKeystoresRequestJSON{
+		Keystores:          keystores,
+		Passwords:          passwords,
+		SlashingProtection: slashingProtection,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to import keystores")
+	}
+
+	return decodeData[[]*OperationStatus](data)
+}
+
+// DeleteKeystores deletes local keystores, returning slashing protection data for the deleted keys.
+func (s *Service) DeleteKeystores(ctx context.Context, pubKeys []phase0.BLSPubKey) (*DeleteKeystoresResponse, error) {
+	if len(pubKeys) == 0 {
+		return nil, errors.New("no public keys specified")
+	}
+
+	data, err := s.do(ctx, http.MethodDelete, "/eth/v1/keystores", &pubKeysRequestJSON{
+		PubKeys: pubKeys,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to delete keystores")
+	}
+
+	var resp deleteKeystoresResponseJSON
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse response")
+	}
+
+	return &DeleteKeystoresResponse{
+		Statuses:           resp.Data,
+		SlashingProtection: resp.SlashingProtection,
+	}, nil
+}
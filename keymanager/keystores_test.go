@@ -0,0 +1,158 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/keymanager"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+const testPubKey = "0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c"
+
+func testBLSPubKey(t *testing.T) phase0.BLSPubKey {
+	t.Helper()
+
+	var pubKey phase0.BLSPubKey
+	require.NoError(t, pubKey.UnmarshalJSON([]byte(`"`+testPubKey+`"`)))
+
+	return pubKey
+}
+
+func TestListKeystores(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		expected   []*keymanager.Keystore
+		err        string
+	}{
+		{
+			name:       "Good",
+			statusCode: 200,
+			body:       `{"data":[{"validating_pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","derivation_path":"m/12381/3600/0/0/0","readonly":true}]}`,
+			expected: []*keymanager.Keystore{
+				{
+					ValidatingPubKey: testBLSPubKey(t),
+					DerivationPath:   "m/12381/3600/0/0/0",
+					ReadOnly:         true,
+				},
+			},
+		},
+		{
+			name:       "Empty",
+			statusCode: 200,
+			body:       `{"data":[]}`,
+			expected:   []*keymanager.Keystore{},
+		},
+		{
+			name:       "Error",
+			statusCode: 500,
+			body:       `{"message":"internal error"}`,
+			err:        `failed to request keystores: GET failed with status 500: {"message":"internal error"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service, req := newTestService(t, test.statusCode, test.body)
+			res, err := service.ListKeystores(ctx)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+				require.Equal(t, "GET", req.method)
+				require.Equal(t, "/eth/v1/keystores", req.path)
+			}
+		})
+	}
+}
+
+func TestImportKeystores(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name               string
+		keystores          []string
+		passwords          []string
+		slashingProtection string
+		expectedBody       string
+		expected           []*keymanager.OperationStatus
+		err                string
+	}{
+		{
+			name: "KeystoresMissing",
+			err:  "no keystores specified",
+		},
+		{
+			name:      "PasswordsMismatch",
+			keystores: []string{"{}"},
+			err:       "number of keystores and passwords differ",
+		},
+		{
+			name:               "Good",
+			keystores:          []string{"{}"},
+			passwords:          []string{"secret"},
+			slashingProtection: "{}",
+			expectedBody:       `{"keystores":["{}"],"passwords":["secret"],"slashing_protection":"{}"}`,
+			expected: []*keymanager.OperationStatus{
+				{
+					Status: "imported",
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service, req := newTestService(t, 200, `{"data":[{"status":"imported"}]}`)
+			res, err := service.ImportKeystores(ctx, test.keystores, test.passwords, test.slashingProtection)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+				require.Equal(t, "POST", req.method)
+				require.JSONEq(t, test.expectedBody, req.body)
+			}
+		})
+	}
+}
+
+func TestDeleteKeystores(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := (&keymanager.Service{}).DeleteKeystores(ctx, nil)
+	require.EqualError(t, err, "no public keys specified")
+
+	service, req := newTestService(t, 200, `{"data":[{"status":"deleted"}],"slashing_protection":"{\"metadata\":{}}"}`)
+	res, err := service.DeleteKeystores(ctx, []phase0.BLSPubKey{testBLSPubKey(t)})
+	require.NoError(t, err)
+	require.Equal(t, &keymanager.DeleteKeystoresResponse{
+		Statuses: []*keymanager.OperationStatus{
+			{
+				Status: "deleted",
+			},
+		},
+		SlashingProtection: `{"metadata":{}}`,
+	}, res)
+	require.Equal(t, "DELETE", req.method)
+	require.JSONEq(t, `{"pubkeys":["0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c"]}`, req.body)
+}
@@ -0,0 +1,90 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// RemoteKey is a key held by a remote signer.
+type RemoteKey struct {
+	// PubKey is the public key of the validator.
+	PubKey phase0.BLSPubKey `json:"pubkey"`
+	// URL is the URL of the remote signer.
+	URL string `json:"url,omitempty"`
+	// ReadOnly is true if the key cannot be deleted.
+	ReadOnly bool `json:"readonly,omitempty"`
+}
+
+type importRemoteKeysRequestJSON struct {
+	RemoteKeys []*RemoteKey `json:"remote_keys"`
+}
+
+// ListRemoteKeys lists the remote keys known to the validator client.
+func (s *Service) ListRemoteKeys(ctx context.Context) ([]*RemoteKey, error) {
+	data, err := s.do(ctx, http.MethodGet, "/eth/v1/remotekeys", nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request remote keys")
+	}
+
+	return decodeData[[]*RemoteKey](data)
+}
+
+// ImportRemoteKeys imports remote keys.
+func (s *Service) ImportRemoteKeys(ctx context.Context, keys []*RemoteKey) ([]*OperationStatus, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("no remote keys specified")
+	}
+
+	reqKeys := make([]*RemoteKey, len(keys))
+	for i := range keys {
+		if keys[i] == nil {
+			return nil, errors.New("nil remote key specified")
+		}
+		// Read-only is not a valid field for import.
+		reqKeys[i] = &RemoteKey{
+			PubKey: keys[i].PubKey,
+			URL:    keys[i].URL,
+		}
+	}
+
+	data, err := s.do(ctx, http.MethodPost, "/eth/v1/remotekeys", &importRemoteKeysRequestJSON{
+		RemoteKeys: reqKeys,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to import remote keys")
+	}
+
+	return decodeData[[]*OperationStatus](data)
+}
+
+// DeleteRemoteKeys deletes remote keys.
+func (s *Service) DeleteRemoteKeys(ctx context.Context, pubKeys []phase0.BLSPubKey) ([]*OperationStatus, error) {
+	if len(pubKeys) == 0 {
+		return nil, errors.New("no public keys specified")
+	}
+
+	data, err := s.do(ctx, http.MethodDelete, "/eth/v1/remotekeys", &pubKeysRequestJSON{
+		PubKeys: pubKeys,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to delete remote keys")
+	}
+
+	return decodeData[[]*OperationStatus](data)
+}
@@ -0,0 +1,98 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/keymanager"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListRemoteKeys(t *testing.T) {
+	ctx := context.Background()
+
+	service, req := newTestService(t, 200, `{"data":[{"pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","url":"https://signer.example.com","readonly":false}]}`)
+	res, err := service.ListRemoteKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []*keymanager.RemoteKey{
+		{
+			PubKey: testBLSPubKey(t),
+			URL:    "https://signer.example.com",
+		},
+	}, res)
+	require.Equal(t, "GET", req.method)
+	require.Equal(t, "/eth/v1/remotekeys", req.path)
+}
+
+func TestImportRemoteKeys(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		keys         []*keymanager.RemoteKey
+		expectedBody string
+		err          string
+	}{
+		{
+			name: "KeysMissing",
+			err:  "no remote keys specified",
+		},
+		{
+			name: "KeyNil",
+			keys: []*keymanager.RemoteKey{nil},
+			err:  "nil remote key specified",
+		},
+		{
+			name: "Good",
+			keys: []*keymanager.RemoteKey{
+				{
+					PubKey:   testBLSPubKey(t),
+					URL:      "https://signer.example.com",
+					ReadOnly: true,
+				},
+			},
+			expectedBody: `{"remote_keys":[{"pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","url":"https://signer.example.com"}]}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service, req := newTestService(t, 200, `{"data":[{"status":"imported"}]}`)
+			res, err := service.ImportRemoteKeys(ctx, test.keys)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Len(t, res, 1)
+				require.Equal(t, "imported", res[0].Status)
+				require.JSONEq(t, test.expectedBody, req.body)
+			}
+		})
+	}
+}
+
+func TestDeleteRemoteKeys(t *testing.T) {
+	ctx := context.Background()
+
+	service, req := newTestService(t, 200, `{"data":[{"status":"not_found","message":"unknown key"}]}`)
+	res, err := service.DeleteRemoteKeys(ctx, []phase0.BLSPubKey{testBLSPubKey(t)})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.Equal(t, "not_found: unknown key", res[0].String())
+	require.Equal(t, "DELETE", req.method)
+	require.JSONEq(t, `{"pubkeys":["0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c"]}`, req.body)
+}
@@ -0,0 +1,75 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/keymanager"
+	"github.com/stretchr/testify/require"
+)
+
+const testToken = "api-token-0x1234"
+
+// testRequest records a request received by the test server.
+type testRequest struct {
+	method string
+	path   string
+	query  string
+	body   string
+}
+
+// newTestService starts a keymanager API stand-in that checks authentication, records
+// each request and responds with the given status and body.
+func newTestService(t *testing.T, statusCode int, respBody string) (*keymanager.Service, *testRequest) {
+	t.Helper()
+
+	received := &testRequest{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+testToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message":"unauthorized"}`))
+
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		received.method = r.Method
+		received.path = r.URL.Path
+		received.query = r.URL.RawQuery
+		received.body = string(body)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		_, _ = w.Write([]byte(respBody))
+	}))
+	t.Cleanup(server.Close)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	service, err := keymanager.New(ctx,
+		keymanager.WithAddress(server.URL),
+		keymanager.WithTimeout(5*time.Second),
+		keymanager.WithToken(testToken),
+	)
+	require.NoError(t, err)
+
+	return service, received
+}
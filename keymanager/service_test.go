@@ -0,0 +1,107 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/keymanager"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tests := []struct {
+		name       string
+		parameters []keymanager.Parameter
+		err        string
+	}{
+		{
+			name: "Nil",
+			err:  "problem with parameters: no address specified",
+		},
+		{
+			name: "TimeoutZero",
+			parameters: []keymanager.Parameter{
+				keymanager.WithAddress("localhost:5062"),
+				keymanager.WithTimeout(0),
+				keymanager.WithToken(testToken),
+			},
+			err: "problem with parameters: no timeout specified",
+		},
+		{
+			name: "TokenMissing",
+			parameters: []keymanager.Parameter{
+				keymanager.WithAddress("localhost:5062"),
+				keymanager.WithTimeout(5 * time.Second),
+			},
+			err: "problem with parameters: no token specified",
+		},
+		{
+			name: "AddressInvalid",
+			parameters: []keymanager.Parameter{
+				keymanager.WithAddress(string([]byte{0x01})),
+				keymanager.WithTimeout(5 * time.Second),
+				keymanager.WithToken(testToken),
+			},
+			err: `invalid URL: parse "http://\x01/": net/url: invalid control character in URL`,
+		},
+		{
+			name: "Good",
+			parameters: []keymanager.Parameter{
+				keymanager.WithAddress("localhost:5062"),
+				keymanager.WithTimeout(5 * time.Second),
+				keymanager.WithToken(testToken),
+				keymanager.WithExtraHeaders(map[string]string{"X-Test": "test"}),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service, err := keymanager.New(ctx, test.parameters...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "Keymanager (HTTP)", service.Name())
+				require.Equal(t, "localhost:5062", service.Address())
+			}
+		})
+	}
+}
+
+func TestUnauthorized(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	good, _ := newTestService(t, 200, `{"data":[]}`)
+
+	service, err := keymanager.New(ctx,
+		keymanager.WithAddress(good.Address()),
+		keymanager.WithTimeout(5*time.Second),
+		keymanager.WithToken("bad"),
+	)
+	require.NoError(t, err)
+
+	_, err = service.ListKeystores(ctx)
+	var apiErr *api.Error
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, 401, apiErr.StatusCode)
+}
@@ -0,0 +1,34 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager
+
+import "fmt"
+
+// OperationStatus is the result of an operation on an individual key.
+type OperationStatus struct {
+	// Status is the status of the operation, for example "imported", "duplicate", "deleted",
+	// "not_active", "not_found" or "error".
+	Status string `json:"status"`
+	// Message provides additional information, typically on error.
+	Message string `json:"message,omitempty"`
+}
+
+// String returns a string version of the structure.
+func (o *OperationStatus) String() string {
+	if o.Message == "" {
+		return o.Status
+	}
+
+	return fmt.Sprintf("%s: %s", o.Status, o.Message)
+}
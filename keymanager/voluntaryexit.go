@@ -0,0 +1,47 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// VoluntaryExit obtains a signed voluntary exit for the given validator.
+// If epoch is nil the validator client will use the current epoch.
+func (s *Service) VoluntaryExit(ctx context.Context, pubKey phase0.BLSPubKey, epoch *phase0.Epoch) (*phase0.SignedVoluntaryExit, error) {
+	endpoint := fmt.Sprintf("/eth/v1/validator/%#x/voluntary_exit", pubKey)
+	if epoch != nil {
+		endpoint = fmt.Sprintf("%s?epoch=%d", endpoint, *epoch)
+	}
+
+	data, err := s.do(ctx, http.MethodPost, endpoint, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request voluntary exit")
+	}
+
+	res, err := decodeData[*phase0.SignedVoluntaryExit](data)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, errors.New("voluntary exit missing")
+	}
+
+	return res, nil
+}
@@ -0,0 +1,42 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanager_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVoluntaryExit(t *testing.T) {
+	ctx := context.Background()
+	pubKey := testBLSPubKey(t)
+	epoch := phase0.Epoch(100)
+
+	service, req := newTestService(t, 200, `{"data":{"message":{"epoch":"100","validator_index":"5"},"signature":"0xb7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7b7"}}`)
+	res, err := service.VoluntaryExit(ctx, pubKey, &epoch)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Epoch(100), res.Message.Epoch)
+	require.Equal(t, phase0.ValidatorIndex(5), res.Message.ValidatorIndex)
+	require.Equal(t, "POST", req.method)
+	require.Equal(t, "/eth/v1/validator/0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c/voluntary_exit", req.path)
+	require.Equal(t, "epoch=100", req.query)
+
+	service, req = newTestService(t, 200, `{"data":null}`)
+	_, err = service.VoluntaryExit(ctx, pubKey, nil)
+	require.EqualError(t, err, "voluntary exit missing")
+	require.Empty(t, req.query)
+}
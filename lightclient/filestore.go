@@ -0,0 +1,95 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lightclient
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// FileStore is a store that holds light client state as JSON in a file.
+type FileStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFileStore creates a new store that persists state to the given path.
+func NewFileStore(path string) *FileStore {
+	return &FileStore{
+		path: path,
+	}
+}
+
+// State returns the persisted state, or nil if no state has been persisted.
+func (s *FileStore) State(_ context.Context) (*State, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, errors.Wrap(err, "failed to read state")
+	}
+
+	state := &State{}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, errors.Wrap(err, "failed to parse state")
+	}
+
+	return state, nil
+}
+
+// SetState persists the state.
+func (s *FileStore) SetState(_ context.Context, state *State) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal state")
+	}
+
+	// Write to a temporary file and rename it, so that an interrupted write
+	// does not leave a corrupt state behind.
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return errors.Wrap(err, "failed to create temporary state file")
+	}
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+
+		return errors.Wrap(err, "failed to write state")
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+
+		return errors.Wrap(err, "failed to close temporary state file")
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		_ = os.Remove(tmp.Name())
+
+		return errors.Wrap(err, "failed to rename temporary state file")
+	}
+
+	return nil
+}
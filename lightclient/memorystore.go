@@ -0,0 +1,54 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lightclient
+
+import (
+	"context"
+	"sync"
+)
+
+// MemoryStore is a store that holds light client state in memory.
+type MemoryStore struct {
+	mu    sync.RWMutex
+	state *State
+}
+
+// NewMemoryStore creates a new in-memory store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{}
+}
+
+// State returns the persisted state, or nil if no state has been persisted.
+func (s *MemoryStore) State(_ context.Context) (*State, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.state == nil {
+		return nil, nil
+	}
+	state := *s.state
+
+	return &state, nil
+}
+
+// SetState persists the state.
+func (s *MemoryStore) SetState(_ context.Context, state *State) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored := *state
+	s.state = &stored
+
+	return nil
+}
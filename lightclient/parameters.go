@@ -0,0 +1,100 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lightclient
+
+import (
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel          zerolog.Level
+	client            consensusclient.Service
+	store             Store
+	signatureVerifier SignatureVerifier
+	trustedBlockRoot  *phase0.Root
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithClient sets the client from which light client data is obtained.
+func WithClient(client consensusclient.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.client = client
+	})
+}
+
+// WithStore sets the store used to persist light client state.
+func WithStore(store Store) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.store = store
+	})
+}
+
+// WithSignatureVerifier sets the verifier for sync committee signatures.
+func WithSignatureVerifier(verifier SignatureVerifier) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.signatureVerifier = verifier
+	})
+}
+
+// WithTrustedBlockRoot sets the root of the block from which the light client bootstraps.
+// This is only used if the store does not already hold light client state.
+func WithTrustedBlockRoot(root phase0.Root) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.trustedBlockRoot = &root
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel: zerolog.GlobalLevel(),
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.client == nil {
+		return nil, errors.New("no client specified")
+	}
+	if parameters.signatureVerifier == nil {
+		return nil, errors.New("no signature verifier specified")
+	}
+	if parameters.store == nil {
+		parameters.store = NewMemoryStore()
+	}
+
+	return &parameters, nil
+}
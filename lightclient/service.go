@@ -0,0 +1,483 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lightclient
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// maxUpdatesPerRequest is the maximum number of updates requested from the client at a time.
+const maxUpdatesPerRequest = 128
+
+// Provider is the interface for obtaining light client data.
+type Provider interface {
+	// LightClientBootstrap provides the light client bootstrap for a given block.
+	LightClientBootstrap(ctx context.Context,
+		opts *api.LightClientBootstrapOpts,
+	) (
+		*api.Response[*spec.VersionedLCBootstrap],
+		error,
+	)
+
+	// LightClientUpdates provides the light client updates for a range of sync committee periods.
+	LightClientUpdates(ctx context.Context,
+		opts *api.LightClientUpdatesOpts,
+	) (
+		*api.Response[[]*spec.VersionedLCUpdate],
+		error,
+	)
+
+	// LightClientFinalityUpdate provides the latest light client finality update.
+	LightClientFinalityUpdate(ctx context.Context,
+		opts *api.CommonOpts,
+	) (
+		*api.Response[*spec.VersionedLCFinalityUpdate],
+		error,
+	)
+
+	// LightClientOptimisticUpdate provides the latest light client optimistic update.
+	LightClientOptimisticUpdate(ctx context.Context,
+		opts *api.CommonOpts,
+	) (
+		*api.Response[*spec.VersionedLCOptimisticUpdate],
+		error,
+	)
+}
+
+// Service is a light client that tracks the chain by verifying light client data.
+type Service struct {
+	// log is a service-wide logger.
+	log zerolog.Logger
+
+	client            consensusclient.Service
+	provider          Provider
+	store             Store
+	signatureVerifier SignatureVerifier
+
+	genesisTime                  time.Time
+	genesisValidatorsRoot        phase0.Root
+	forkSchedule                 []*phase0.Fork
+	slotDuration                 time.Duration
+	slotsPerEpoch                uint64
+	epochsPerSyncCommitteePeriod uint64
+	capellaForkEpoch             phase0.Epoch
+	denebForkEpoch               phase0.Epoch
+	domainSyncCommittee          phase0.DomainType
+
+	stateMu         sync.RWMutex
+	state           *State
+	bestValidUpdate *update
+}
+
+// New creates a new light client.
+// If the store does not contain any state the light client bootstraps from the
+// trusted block root, which must then be supplied.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "client").Str("impl", "lightclient").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	provider, isProvider := parameters.client.(Provider)
+	if !isProvider {
+		return nil, errors.New("client does not provide light client data")
+	}
+
+	s := &Service{
+		log:               log,
+		client:            parameters.client,
+		provider:          provider,
+		store:             parameters.store,
+		signatureVerifier: parameters.signatureVerifier,
+	}
+
+	if err := s.fetchChainInfo(ctx); err != nil {
+		return nil, err
+	}
+
+	state, err := s.store.State(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain state from store")
+	}
+	if state == nil {
+		if parameters.trustedBlockRoot == nil {
+			return nil, errors.New("no state in store and no trusted block root specified")
+		}
+		state, err = s.bootstrap(ctx, *parameters.trustedBlockRoot)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.store.SetState(ctx, state); err != nil {
+			return nil, errors.Wrap(err, "failed to store state")
+		}
+	}
+	s.state = state
+
+	return s, nil
+}
+
+// fetchChainInfo fetches the chain information required to verify light client data.
+func (s *Service) fetchChainInfo(ctx context.Context) error {
+	genesisProvider, isProvider := s.client.(consensusclient.GenesisProvider)
+	if !isProvider {
+		return errors.New("client does not provide genesis")
+	}
+	genesisResponse, err := genesisProvider.Genesis(ctx, &api.GenesisOpts{})
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain genesis")
+	}
+	s.genesisTime = genesisResponse.Data.GenesisTime
+	s.genesisValidatorsRoot = genesisResponse.Data.GenesisValidatorsRoot
+
+	forkScheduleProvider, isProvider := s.client.(consensusclient.ForkScheduleProvider)
+	if !isProvider {
+		return errors.New("client does not provide fork schedule")
+	}
+	forkScheduleResponse, err := forkScheduleProvider.ForkSchedule(ctx, &api.ForkScheduleOpts{})
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain fork schedule")
+	}
+	s.forkSchedule = forkScheduleResponse.Data
+
+	specProvider, isProvider := s.client.(consensusclient.SpecProvider)
+	if !isProvider {
+		return errors.New("client does not provide spec")
+	}
+	specResponse, err := specProvider.Spec(ctx, &api.SpecOpts{})
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain spec")
+	}
+	if s.slotDuration, err = specValue[time.Duration](specResponse.Data, "SECONDS_PER_SLOT"); err != nil {
+		return err
+	}
+	if s.slotsPerEpoch, err = specValue[uint64](specResponse.Data, "SLOTS_PER_EPOCH"); err != nil {
+		return err
+	}
+	if s.epochsPerSyncCommitteePeriod, err = specValue[uint64](specResponse.Data, "EPOCHS_PER_SYNC_COMMITTEE_PERIOD"); err != nil {
+		return err
+	}
+	if s.domainSyncCommittee, err = specValue[phase0.DomainType](specResponse.Data, "DOMAIN_SYNC_COMMITTEE"); err != nil {
+		return err
+	}
+	capellaForkEpoch, err := specValue[uint64](specResponse.Data, "CAPELLA_FORK_EPOCH")
+	if err != nil {
+		return err
+	}
+	s.capellaForkEpoch = phase0.Epoch(capellaForkEpoch)
+	denebForkEpoch, err := specValue[uint64](specResponse.Data, "DENEB_FORK_EPOCH")
+	if err != nil {
+		return err
+	}
+	s.denebForkEpoch = phase0.Epoch(denebForkEpoch)
+
+	return nil
+}
+
+// specValue obtains a typed value from the spec.
+func specValue[T any](data map[string]any, key string) (T, error) {
+	var res T
+	tmp, exists := data[key]
+	if !exists {
+		return res, fmt.Errorf("%s not found in spec", key)
+	}
+	res, isType := tmp.(T)
+	if !isType {
+		return res, fmt.Errorf("%s of unexpected type", key)
+	}
+
+	return res, nil
+}
+
+// bootstrap creates the initial state from the bootstrap of the trusted block.
+func (s *Service) bootstrap(ctx context.Context, root phase0.Root) (*State, error) {
+	response, err := s.provider.LightClientBootstrap(ctx, &api.LightClientBootstrapOpts{
+		Block: fmt.Sprintf("%#x", root),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain bootstrap")
+	}
+	if response == nil {
+		return nil, errors.New("bootstrap not available")
+	}
+	b, err := bootstrapFromVersioned(response.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid bootstrap")
+	}
+	if b.currentSyncCommittee == nil {
+		return nil, errors.New("bootstrap current sync committee missing")
+	}
+
+	valid, err := s.isValidHeader(b.header)
+	if err != nil {
+		return nil, err
+	}
+	if !valid {
+		return nil, errors.New("invalid bootstrap header")
+	}
+	headerRoot, err := b.header.beacon.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain bootstrap header root")
+	}
+	if headerRoot != root {
+		return nil, errors.New("bootstrap header does not match trusted block root")
+	}
+	syncCommitteeRoot, err := b.currentSyncCommittee.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain bootstrap sync committee root")
+	}
+	if !isValidMerkleBranch(syncCommitteeRoot, b.currentSyncCommitteeBranch, currentSyncCommitteeGindex, b.header.beacon.StateRoot) {
+		return nil, errors.New("invalid bootstrap current sync committee branch")
+	}
+
+	return &State{
+		FinalizedHeader:      b.header.beacon,
+		OptimisticHeader:     b.header.beacon,
+		CurrentSyncCommittee: b.currentSyncCommittee,
+	}, nil
+}
+
+// currentSlot returns the current slot according to the wall clock.
+func (s *Service) currentSlot() phase0.Slot {
+	if time.Now().Before(s.genesisTime) {
+		return 0
+	}
+
+	return phase0.Slot(uint64(time.Since(s.genesisTime) / s.slotDuration))
+}
+
+// FinalizedHeader returns the header of the latest verified finalized block.
+func (s *Service) FinalizedHeader() *phase0.BeaconBlockHeader {
+	s.stateMu.RLock()
+	defer s.stateMu.RUnlock()
+
+	return s.state.FinalizedHeader
+}
+
+// OptimisticHeader returns the header of the latest verified block attested
+// to by the sync committee.
+func (s *Service) OptimisticHeader() *phase0.BeaconBlockHeader {
+	s.stateMu.RLock()
+	defer s.stateMu.RUnlock()
+
+	return s.state.OptimisticHeader
+}
+
+// State returns a copy of the current state of the light client.
+func (s *Service) State() *State {
+	s.stateMu.RLock()
+	defer s.stateMu.RUnlock()
+
+	state := *s.state
+
+	return &state
+}
+
+// Sync brings the light client up to date by fetching and verifying updates
+// for each sync committee period up to the current one, followed by the
+// latest finality and optimistic updates.
+func (s *Service) Sync(ctx context.Context) error {
+	currentPeriod := s.syncCommitteePeriod(s.currentSlot())
+	for {
+		s.stateMu.RLock()
+		startPeriod := s.syncCommitteePeriod(s.state.FinalizedHeader.Slot)
+		s.stateMu.RUnlock()
+		if startPeriod > currentPeriod {
+			break
+		}
+		count := currentPeriod - startPeriod + 1
+		if count > maxUpdatesPerRequest {
+			count = maxUpdatesPerRequest
+		}
+
+		response, err := s.provider.LightClientUpdates(ctx, &api.LightClientUpdatesOpts{
+			StartPeriod: startPeriod,
+			Count:       count,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to obtain updates")
+		}
+		if response == nil || len(response.Data) == 0 {
+			break
+		}
+		for _, data := range response.Data {
+			if err := s.ProcessUpdate(ctx, data); err != nil {
+				s.log.Debug().Err(err).Msg("Update not applied")
+			}
+		}
+
+		s.stateMu.RLock()
+		endPeriod := s.syncCommitteePeriod(s.state.FinalizedHeader.Slot)
+		s.stateMu.RUnlock()
+		if endPeriod == startPeriod {
+			// No progress made; nothing more to obtain.
+			break
+		}
+	}
+
+	finalityResponse, err := s.provider.LightClientFinalityUpdate(ctx, &api.CommonOpts{})
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain finality update")
+	}
+	if finalityResponse != nil {
+		if err := s.ProcessFinalityUpdate(ctx, finalityResponse.Data); err != nil {
+			s.log.Debug().Err(err).Msg("Finality update not applied")
+		}
+	}
+
+	optimisticResponse, err := s.provider.LightClientOptimisticUpdate(ctx, &api.CommonOpts{})
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain optimistic update")
+	}
+	if optimisticResponse != nil {
+		if err := s.ProcessOptimisticUpdate(ctx, optimisticResponse.Data); err != nil {
+			s.log.Debug().Err(err).Msg("Optimistic update not applied")
+		}
+	}
+
+	return s.ForceUpdate(ctx)
+}
+
+// Start syncs the light client and then keeps it up to date using light
+// client events from the client.
+func (s *Service) Start(ctx context.Context) error {
+	if err := s.Sync(ctx); err != nil {
+		return err
+	}
+
+	eventsProvider, isProvider := s.client.(consensusclient.EventsProvider)
+	if !isProvider {
+		return errors.New("client does not provide events")
+	}
+
+	return eventsProvider.Events(ctx,
+		[]string{"light_client_finality_update", "light_client_optimistic_update"},
+		s.handleEvent,
+	)
+}
+
+// handleEvent handles light client events.
+func (s *Service) handleEvent(event *apiv1.Event) {
+	ctx := context.Background()
+
+	var err error
+	switch data := event.Data.(type) {
+	case *spec.VersionedLCFinalityUpdate:
+		err = s.ProcessFinalityUpdate(ctx, data)
+	case *spec.VersionedLCOptimisticUpdate:
+		err = s.ProcessOptimisticUpdate(ctx, data)
+	default:
+		s.log.Debug().Str("topic", event.Topic).Msg("Ignoring unexpected event")
+
+		return
+	}
+	if err != nil {
+		s.log.Debug().Str("topic", event.Topic).Err(err).Msg("Event not applied")
+
+		return
+	}
+	if err := s.ForceUpdate(ctx); err != nil {
+		s.log.Warn().Err(err).Msg("Failed to force update")
+	}
+}
+
+// ProcessUpdate verifies a light client update and applies it to the state.
+func (s *Service) ProcessUpdate(ctx context.Context, data *spec.VersionedLCUpdate) error {
+	u, err := updateFromVersioned(data)
+	if err != nil {
+		return errors.Wrap(err, "invalid update")
+	}
+
+	return s.process(ctx, u)
+}
+
+// ProcessFinalityUpdate verifies a light client finality update and applies it to the state.
+func (s *Service) ProcessFinalityUpdate(ctx context.Context, data *spec.VersionedLCFinalityUpdate) error {
+	u, err := updateFromVersionedFinalityUpdate(data)
+	if err != nil {
+		return errors.Wrap(err, "invalid finality update")
+	}
+
+	return s.process(ctx, u)
+}
+
+// ProcessOptimisticUpdate verifies a light client optimistic update and applies it to the state.
+func (s *Service) ProcessOptimisticUpdate(ctx context.Context, data *spec.VersionedLCOptimisticUpdate) error {
+	u, err := updateFromVersionedOptimisticUpdate(data)
+	if err != nil {
+		return errors.Wrap(err, "invalid optimistic update")
+	}
+
+	return s.process(ctx, u)
+}
+
+// ForceUpdate applies the best valid update seen if there has been no
+// finalized update for a full sync committee period.
+func (s *Service) ForceUpdate(ctx context.Context) error {
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+
+	changed, err := s.processForceUpdate(s.currentSlot())
+	if err != nil {
+		return err
+	}
+	if !changed {
+		return nil
+	}
+
+	return s.saveState(ctx)
+}
+
+// process processes an update and persists the state if it changed.
+func (s *Service) process(ctx context.Context, u *update) error {
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+
+	changed, err := s.processUpdate(u, s.currentSlot())
+	if err != nil {
+		return err
+	}
+	if !changed {
+		return nil
+	}
+
+	return s.saveState(ctx)
+}
+
+// saveState persists the state.
+// This assumes that the state lock is held.
+func (s *Service) saveState(ctx context.Context) error {
+	if err := s.store.SetState(ctx, s.state); err != nil {
+		return errors.Wrap(err, "failed to store state")
+	}
+
+	return nil
+}
@@ -0,0 +1,499 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lightclient
+
+import (
+	"context"
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSlotsPerEpoch                = 8
+	testEpochsPerSyncCommitteePeriod = 8
+)
+
+// testClient is a client that serves light client data from memory.
+type testClient struct {
+	genesisTime time.Time
+	bootstrap   *spec.VersionedLCBootstrap
+	updates     []*spec.VersionedLCUpdate
+}
+
+func (*testClient) Name() string {
+	return "test"
+}
+
+func (*testClient) Address() string {
+	return "test"
+}
+
+func (c *testClient) Genesis(_ context.Context, _ *api.GenesisOpts) (*api.Response[*apiv1.Genesis], error) {
+	return &api.Response[*apiv1.Genesis]{
+		Data: &apiv1.Genesis{
+			GenesisTime:           c.genesisTime,
+			GenesisValidatorsRoot: phase0.Root{0x01},
+		},
+	}, nil
+}
+
+func (*testClient) ForkSchedule(_ context.Context, _ *api.ForkScheduleOpts) (*api.Response[[]*phase0.Fork], error) {
+	return &api.Response[[]*phase0.Fork]{
+		Data: []*phase0.Fork{
+			{Epoch: 0, CurrentVersion: phase0.Version{0x00}},
+			{Epoch: 0, PreviousVersion: phase0.Version{0x00}, CurrentVersion: phase0.Version{0x01}},
+		},
+	}, nil
+}
+
+func (*testClient) Spec(_ context.Context, _ *api.SpecOpts) (*api.Response[map[string]any], error) {
+	return &api.Response[map[string]any]{
+		Data: map[string]any{
+			"SECONDS_PER_SLOT":                 time.Second,
+			"SLOTS_PER_EPOCH":                  uint64(testSlotsPerEpoch),
+			"EPOCHS_PER_SYNC_COMMITTEE_PERIOD": uint64(testEpochsPerSyncCommitteePeriod),
+			"DOMAIN_SYNC_COMMITTEE":            phase0.DomainType{0x07, 0x00, 0x00, 0x00},
+			"CAPELLA_FORK_EPOCH":               uint64(1000000),
+			"DENEB_FORK_EPOCH":                 uint64(1000000),
+		},
+	}, nil
+}
+
+func (c *testClient) LightClientBootstrap(_ context.Context, _ *api.LightClientBootstrapOpts) (*api.Response[*spec.VersionedLCBootstrap], error) {
+	return &api.Response[*spec.VersionedLCBootstrap]{Data: c.bootstrap}, nil
+}
+
+func (c *testClient) LightClientUpdates(_ context.Context, _ *api.LightClientUpdatesOpts) (*api.Response[[]*spec.VersionedLCUpdate], error) {
+	return &api.Response[[]*spec.VersionedLCUpdate]{Data: c.updates}, nil
+}
+
+func (*testClient) LightClientFinalityUpdate(_ context.Context, _ *api.CommonOpts) (*api.Response[*spec.VersionedLCFinalityUpdate], error) {
+	return nil, nil
+}
+
+func (*testClient) LightClientOptimisticUpdate(_ context.Context, _ *api.CommonOpts) (*api.Response[*spec.VersionedLCOptimisticUpdate], error) {
+	return nil, nil
+}
+
+// testVerifier accepts signatures whose first byte is 0x01.
+type testVerifier struct{}
+
+func (testVerifier) FastAggregateVerify(_ []phase0.BLSPubKey, _ phase0.Root, signature phase0.BLSSignature) (bool, error) {
+	return signature[0] == 0x01, nil
+}
+
+// merkleTree builds the nodes of a tree containing the given leaves, with all other nodes zero.
+func merkleTree(leaves map[uint64]phase0.Root) func(uint64) phase0.Root {
+	var node func(uint64) phase0.Root
+	node = func(gindex uint64) phase0.Root {
+		if leaf, exists := leaves[gindex]; exists {
+			return leaf
+		}
+		for leafGindex := range leaves {
+			for ancestor := leafGindex >> 1; ancestor > 0; ancestor >>= 1 {
+				if ancestor == gindex {
+					left := node(gindex * 2)
+					right := node(gindex*2 + 1)
+
+					return sha256.Sum256(append(left[:], right[:]...))
+				}
+			}
+		}
+
+		return phase0.Root{}
+	}
+
+	return node
+}
+
+// merkleBranch returns the branch for the given generalized index.
+func merkleBranch(node func(uint64) phase0.Root, gindex uint64) [][]byte {
+	branch := make([][]byte, 0)
+	for ; gindex > 1; gindex >>= 1 {
+		sibling := node(gindex ^ 1)
+		branch = append(branch, sibling[:])
+	}
+
+	return branch
+}
+
+func testSyncCommittee(seed byte) *altair.SyncCommittee {
+	syncCommittee := &altair.SyncCommittee{
+		Pubkeys: make([]phase0.BLSPubKey, 512),
+	}
+	for i := range syncCommittee.Pubkeys {
+		syncCommittee.Pubkeys[i][0] = seed
+		syncCommittee.Pubkeys[i][1] = byte(i)
+		syncCommittee.Pubkeys[i][2] = byte(i >> 8)
+	}
+
+	return syncCommittee
+}
+
+func testSyncAggregate(participants int, signature byte) *altair.SyncAggregate {
+	bits := bitfield.NewBitvector512()
+	for i := 0; i < participants; i++ {
+		bits.SetBitAt(uint64(i), true)
+	}
+
+	return &altair.SyncAggregate{
+		SyncCommitteeBits:      bits,
+		SyncCommitteeSignature: phase0.BLSSignature{signature},
+	}
+}
+
+// testChain creates a bootstrap at the given slot, and an update that finalizes
+// a later block in the same period and provides the next sync committee.
+func testChain(t *testing.T, bootstrapSlot phase0.Slot) (*testClient, phase0.Root) {
+	t.Helper()
+
+	currentSyncCommittee := testSyncCommittee(0x01)
+	currentSyncCommitteeRoot, err := currentSyncCommittee.HashTreeRoot()
+	require.NoError(t, err)
+	bootstrapTree := merkleTree(map[uint64]phase0.Root{currentSyncCommitteeGindex: currentSyncCommitteeRoot})
+	bootstrapHeader := &phase0.BeaconBlockHeader{
+		Slot:      bootstrapSlot,
+		StateRoot: bootstrapTree(1),
+		BodyRoot:  phase0.Root{0x02},
+	}
+	bootstrapRoot, err := bootstrapHeader.HashTreeRoot()
+	require.NoError(t, err)
+
+	finalizedHeader := &phase0.BeaconBlockHeader{
+		Slot:       bootstrapSlot + 8,
+		ParentRoot: bootstrapRoot,
+		StateRoot:  phase0.Root{0x03},
+		BodyRoot:   phase0.Root{0x04},
+	}
+	finalizedRoot, err := finalizedHeader.HashTreeRoot()
+	require.NoError(t, err)
+	nextSyncCommittee := testSyncCommittee(0x02)
+	nextSyncCommitteeRoot, err := nextSyncCommittee.HashTreeRoot()
+	require.NoError(t, err)
+	attestedTree := merkleTree(map[uint64]phase0.Root{
+		finalizedRootGindex:     finalizedRoot,
+		nextSyncCommitteeGindex: nextSyncCommitteeRoot,
+	})
+	attestedHeader := &phase0.BeaconBlockHeader{
+		Slot:       bootstrapSlot + 24,
+		ParentRoot: finalizedRoot,
+		StateRoot:  attestedTree(1),
+		BodyRoot:   phase0.Root{0x05},
+	}
+
+	client := &testClient{
+		genesisTime: time.Now().Add(-1000 * time.Second),
+		bootstrap: &spec.VersionedLCBootstrap{
+			Version: spec.DataVersionAltair,
+			Altair: &altair.LightClientBootstrap{
+				Header:                     &altair.LightClientHeader{Beacon: bootstrapHeader},
+				CurrentSyncCommittee:       currentSyncCommittee,
+				CurrentSyncCommitteeBranch: merkleBranch(bootstrapTree, currentSyncCommitteeGindex),
+			},
+		},
+		updates: []*spec.VersionedLCUpdate{
+			{
+				Version: spec.DataVersionAltair,
+				Altair: &altair.LightClientUpdate{
+					AttestedHeader:          &altair.LightClientHeader{Beacon: attestedHeader},
+					NextSyncCommittee:       nextSyncCommittee,
+					NextSyncCommitteeBranch: merkleBranch(attestedTree, nextSyncCommitteeGindex),
+					FinalizedHeader:         &altair.LightClientHeader{Beacon: finalizedHeader},
+					FinalityBranch:          merkleBranch(attestedTree, finalizedRootGindex),
+					SyncAggregate:           testSyncAggregate(512, 0x01),
+					SignatureSlot:           attestedHeader.Slot + 1,
+				},
+			},
+		},
+	}
+
+	return client, bootstrapRoot
+}
+
+func TestMerkleBranch(t *testing.T) {
+	leaf := phase0.Root{0x01}
+	tree := merkleTree(map[uint64]phase0.Root{currentSyncCommitteeGindex: leaf})
+	branch := merkleBranch(tree, currentSyncCommitteeGindex)
+	require.Len(t, branch, 5)
+
+	require.True(t, isValidMerkleBranch(leaf, branch, currentSyncCommitteeGindex, tree(1)))
+	require.False(t, isValidMerkleBranch(phase0.Root{0x02}, branch, currentSyncCommitteeGindex, tree(1)))
+	require.False(t, isValidMerkleBranch(leaf, branch, nextSyncCommitteeGindex, tree(1)))
+	require.False(t, isValidMerkleBranch(leaf, branch[1:], currentSyncCommitteeGindex, tree(1)))
+}
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+	client, root := testChain(t, 72)
+
+	tests := []struct {
+		name   string
+		params []Parameter
+		err    string
+	}{
+		{
+			name: "ClientMissing",
+			params: []Parameter{
+				WithSignatureVerifier(testVerifier{}),
+				WithTrustedBlockRoot(root),
+			},
+			err: "problem with parameters: no client specified",
+		},
+		{
+			name: "SignatureVerifierMissing",
+			params: []Parameter{
+				WithClient(client),
+				WithTrustedBlockRoot(root),
+			},
+			err: "problem with parameters: no signature verifier specified",
+		},
+		{
+			name: "TrustedBlockRootMissing",
+			params: []Parameter{
+				WithClient(client),
+				WithSignatureVerifier(testVerifier{}),
+			},
+			err: "no state in store and no trusted block root specified",
+		},
+		{
+			name: "TrustedBlockRootIncorrect",
+			params: []Parameter{
+				WithClient(client),
+				WithSignatureVerifier(testVerifier{}),
+				WithTrustedBlockRoot(phase0.Root{0x01}),
+			},
+			err: "bootstrap header does not match trusted block root",
+		},
+		{
+			name: "Good",
+			params: []Parameter{
+				WithClient(client),
+				WithSignatureVerifier(testVerifier{}),
+				WithTrustedBlockRoot(root),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, err := New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, phase0.Slot(72), s.FinalizedHeader().Slot)
+				require.Equal(t, phase0.Slot(72), s.OptimisticHeader().Slot)
+			}
+		})
+	}
+}
+
+func TestBootstrapBadBranch(t *testing.T) {
+	ctx := context.Background()
+	client, root := testChain(t, 72)
+	client.bootstrap.Altair.CurrentSyncCommitteeBranch[0] = make([]byte, 32)
+	client.bootstrap.Altair.CurrentSyncCommitteeBranch[0][0] = 0xff
+
+	_, err := New(ctx,
+		WithClient(client),
+		WithSignatureVerifier(testVerifier{}),
+		WithTrustedBlockRoot(root),
+	)
+	require.EqualError(t, err, "invalid bootstrap current sync committee branch")
+}
+
+func TestProcessUpdate(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		modify func(*altair.LightClientUpdate)
+		err    string
+	}{
+		{
+			name: "NoParticipants",
+			modify: func(u *altair.LightClientUpdate) {
+				u.SyncAggregate = testSyncAggregate(0, 0x01)
+			},
+			err: "insufficient sync committee participants",
+		},
+		{
+			name: "SignatureSlotTooEarly",
+			modify: func(u *altair.LightClientUpdate) {
+				u.SignatureSlot = u.AttestedHeader.Beacon.Slot
+			},
+			err: "update slots out of order",
+		},
+		{
+			name: "SignatureInvalid",
+			modify: func(u *altair.LightClientUpdate) {
+				u.SyncAggregate = testSyncAggregate(512, 0x02)
+			},
+			err: "invalid sync committee signature",
+		},
+		{
+			name: "FinalityBranchInvalid",
+			modify: func(u *altair.LightClientUpdate) {
+				u.FinalityBranch[0] = make([]byte, 32)
+				u.FinalityBranch[0][0] = 0xff
+			},
+			err: "invalid finality branch",
+		},
+		{
+			name: "NextSyncCommitteeBranchInvalid",
+			modify: func(u *altair.LightClientUpdate) {
+				u.NextSyncCommittee = testSyncCommittee(0x03)
+			},
+			err: "invalid next sync committee branch",
+		},
+		{
+			name: "Good",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client, root := testChain(t, 72)
+			store := NewMemoryStore()
+			s, err := New(ctx,
+				WithClient(client),
+				WithSignatureVerifier(testVerifier{}),
+				WithTrustedBlockRoot(root),
+				WithStore(store),
+			)
+			require.NoError(t, err)
+
+			if test.modify != nil {
+				test.modify(client.updates[0].Altair)
+			}
+			err = s.ProcessUpdate(ctx, client.updates[0])
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				require.Equal(t, phase0.Slot(72), s.FinalizedHeader().Slot)
+				require.Nil(t, s.State().NextSyncCommittee)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, phase0.Slot(80), s.FinalizedHeader().Slot)
+				require.Equal(t, phase0.Slot(96), s.OptimisticHeader().Slot)
+				require.NotNil(t, s.State().NextSyncCommittee)
+				require.Equal(t, uint64(512), s.State().CurrentMaxActiveParticipants)
+
+				stored, err := store.State(ctx)
+				require.NoError(t, err)
+				require.Equal(t, phase0.Slot(80), stored.FinalizedHeader.Slot)
+			}
+		})
+	}
+}
+
+func TestSync(t *testing.T) {
+	ctx := context.Background()
+	client, root := testChain(t, 72)
+
+	s, err := New(ctx,
+		WithClient(client),
+		WithSignatureVerifier(testVerifier{}),
+		WithTrustedBlockRoot(root),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, s.Sync(ctx))
+	require.Equal(t, phase0.Slot(80), s.FinalizedHeader().Slot)
+	require.Equal(t, phase0.Slot(96), s.OptimisticHeader().Slot)
+}
+
+func TestRestart(t *testing.T) {
+	ctx := context.Background()
+	client, root := testChain(t, 72)
+	store := NewMemoryStore()
+
+	s, err := New(ctx,
+		WithClient(client),
+		WithSignatureVerifier(testVerifier{}),
+		WithTrustedBlockRoot(root),
+		WithStore(store),
+	)
+	require.NoError(t, err)
+	require.NoError(t, s.Sync(ctx))
+
+	// A new client on the same store should pick up where the previous one left off,
+	// without requiring a trusted block root.
+	s, err = New(ctx,
+		WithClient(client),
+		WithSignatureVerifier(testVerifier{}),
+		WithStore(store),
+	)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Slot(80), s.FinalizedHeader().Slot)
+}
+
+func TestIsBetterUpdate(t *testing.T) {
+	s := &Service{
+		slotsPerEpoch:                testSlotsPerEpoch,
+		epochsPerSyncCommitteePeriod: testEpochsPerSyncCommitteePeriod,
+	}
+	header := &header{beacon: &phase0.BeaconBlockHeader{Slot: 90}}
+
+	supermajority := &update{attestedHeader: header, syncAggregate: testSyncAggregate(400, 0x01), signatureSlot: 91}
+	minority := &update{attestedHeader: header, syncAggregate: testSyncAggregate(300, 0x01), signatureSlot: 91}
+	require.True(t, s.isBetterUpdate(supermajority, minority))
+	require.False(t, s.isBetterUpdate(minority, supermajority))
+
+	finality := &update{attestedHeader: header, finalizedHeader: header, syncAggregate: testSyncAggregate(400, 0x01), signatureSlot: 91}
+	require.True(t, s.isBetterUpdate(finality, supermajority))
+
+	older := &update{attestedHeader: header, syncAggregate: testSyncAggregate(400, 0x01), signatureSlot: 92}
+	require.True(t, s.isBetterUpdate(supermajority, older))
+}
+
+func TestIsValidHeaderPreCapella(t *testing.T) {
+	s := &Service{
+		slotsPerEpoch:    testSlotsPerEpoch,
+		capellaForkEpoch: 10,
+		denebForkEpoch:   20,
+	}
+	beacon := &phase0.BeaconBlockHeader{Slot: 8}
+
+	valid, err := s.isValidHeader(&header{beacon: beacon, capellaExecution: &capella.ExecutionPayloadHeader{}})
+	require.NoError(t, err)
+	require.True(t, valid)
+	valid, err = s.isValidHeader(&header{beacon: beacon, denebExecution: &deneb.ExecutionPayloadHeader{BaseFeePerGas: new(uint256.Int)}})
+	require.NoError(t, err)
+	require.True(t, valid)
+
+	// Any non-empty field in the execution header is rejected, not just the block hash.
+	valid, err = s.isValidHeader(&header{beacon: beacon, capellaExecution: &capella.ExecutionPayloadHeader{StateRoot: phase0.Root{0x01}}})
+	require.NoError(t, err)
+	require.False(t, valid)
+	valid, err = s.isValidHeader(&header{beacon: beacon, denebExecution: &deneb.ExecutionPayloadHeader{BaseFeePerGas: new(uint256.Int), ExtraData: []byte{0x01}}})
+	require.NoError(t, err)
+	require.False(t, valid)
+
+	// A non-zero branch is rejected.
+	valid, err = s.isValidHeader(&header{beacon: beacon, capellaExecution: &capella.ExecutionPayloadHeader{}, executionBranch: [][]byte{{0x01}}})
+	require.NoError(t, err)
+	require.False(t, valid)
+}
@@ -0,0 +1,26 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lightclient
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// SignatureVerifier is the interface for verifying sync committee signatures.
+// This module does not include a BLS implementation, so callers must supply one.
+type SignatureVerifier interface {
+	// FastAggregateVerify returns true if the signature is a valid aggregate
+	// signature over the message by all of the public keys.
+	FastAggregateVerify(pubKeys []phase0.BLSPubKey, message phase0.Root, signature phase0.BLSSignature) (bool, error)
+}
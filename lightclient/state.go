@@ -0,0 +1,116 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lightclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// State is the state of a light client, as persisted by a Store.
+type State struct {
+	// FinalizedHeader is the header of the latest verified finalized block.
+	FinalizedHeader *phase0.BeaconBlockHeader
+	// OptimisticHeader is the header of the latest verified block attested
+	// to by the sync committee, but not necessarily finalized.
+	OptimisticHeader *phase0.BeaconBlockHeader
+	// CurrentSyncCommittee is the sync committee for the period of the finalized header.
+	CurrentSyncCommittee *altair.SyncCommittee
+	// NextSyncCommittee is the sync committee for the period after that of the
+	// finalized header.  It is nil if not yet known.
+	NextSyncCommittee *altair.SyncCommittee
+	// PreviousMaxActiveParticipants is the highest sync committee participation
+	// seen in the previous period.
+	PreviousMaxActiveParticipants uint64
+	// CurrentMaxActiveParticipants is the highest sync committee participation
+	// seen in the current period.
+	CurrentMaxActiveParticipants uint64
+}
+
+// stateJSON is the JSON representation of the struct.
+type stateJSON struct {
+	FinalizedHeader               *phase0.BeaconBlockHeader `json:"finalized_header"`
+	OptimisticHeader              *phase0.BeaconBlockHeader `json:"optimistic_header"`
+	CurrentSyncCommittee          *altair.SyncCommittee     `json:"current_sync_committee"`
+	NextSyncCommittee             *altair.SyncCommittee     `json:"next_sync_committee,omitempty"`
+	PreviousMaxActiveParticipants string                    `json:"previous_max_active_participants"`
+	CurrentMaxActiveParticipants  string                    `json:"current_max_active_participants"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s *State) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&stateJSON{
+		FinalizedHeader:               s.FinalizedHeader,
+		OptimisticHeader:              s.OptimisticHeader,
+		CurrentSyncCommittee:          s.CurrentSyncCommittee,
+		NextSyncCommittee:             s.NextSyncCommittee,
+		PreviousMaxActiveParticipants: fmt.Sprintf("%d", s.PreviousMaxActiveParticipants),
+		CurrentMaxActiveParticipants:  fmt.Sprintf("%d", s.CurrentMaxActiveParticipants),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *State) UnmarshalJSON(input []byte) error {
+	var data stateJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.FinalizedHeader == nil {
+		return errors.New("finalized header missing")
+	}
+	s.FinalizedHeader = data.FinalizedHeader
+	if data.OptimisticHeader == nil {
+		return errors.New("optimistic header missing")
+	}
+	s.OptimisticHeader = data.OptimisticHeader
+	if data.CurrentSyncCommittee == nil {
+		return errors.New("current sync committee missing")
+	}
+	s.CurrentSyncCommittee = data.CurrentSyncCommittee
+	s.NextSyncCommittee = data.NextSyncCommittee
+	if data.PreviousMaxActiveParticipants == "" {
+		return errors.New("previous max active participants missing")
+	}
+	previousMaxActiveParticipants, err := strconv.ParseUint(data.PreviousMaxActiveParticipants, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for previous max active participants")
+	}
+	s.PreviousMaxActiveParticipants = previousMaxActiveParticipants
+	if data.CurrentMaxActiveParticipants == "" {
+		return errors.New("current max active participants missing")
+	}
+	currentMaxActiveParticipants, err := strconv.ParseUint(data.CurrentMaxActiveParticipants, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for current max active participants")
+	}
+	s.CurrentMaxActiveParticipants = currentMaxActiveParticipants
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (s *State) String() string {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
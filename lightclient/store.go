@@ -0,0 +1,27 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lightclient
+
+import (
+	"context"
+)
+
+// Store is the interface for persisting light client state.
+type Store interface {
+	// State returns the persisted state, or nil if no state has been persisted.
+	State(ctx context.Context) (*State, error)
+
+	// SetState persists the state.
+	SetState(ctx context.Context, state *State) error
+}
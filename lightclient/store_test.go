@@ -0,0 +1,93 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lightclient_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/lightclient"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func testState() *lightclient.State {
+	syncCommittee := &altair.SyncCommittee{
+		Pubkeys: make([]phase0.BLSPubKey, 512),
+	}
+
+	return &lightclient.State{
+		FinalizedHeader: &phase0.BeaconBlockHeader{
+			Slot:          64,
+			ProposerIndex: 1,
+		},
+		OptimisticHeader: &phase0.BeaconBlockHeader{
+			Slot:          70,
+			ProposerIndex: 2,
+		},
+		CurrentSyncCommittee:          syncCommittee,
+		PreviousMaxActiveParticipants: 500,
+		CurrentMaxActiveParticipants:  510,
+	}
+}
+
+func TestStateJSON(t *testing.T) {
+	state := testState()
+	data, err := json.Marshal(state)
+	require.NoError(t, err)
+
+	var res lightclient.State
+	require.NoError(t, json.Unmarshal(data, &res))
+	require.Equal(t, state, &res)
+	require.Nil(t, res.NextSyncCommittee)
+
+	require.EqualError(t, json.Unmarshal([]byte(`{}`), &res), "finalized header missing")
+}
+
+func TestFileStore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "state.json")
+	store := lightclient.NewFileStore(path)
+
+	state, err := store.State(ctx)
+	require.NoError(t, err)
+	require.Nil(t, state)
+
+	require.NoError(t, store.SetState(ctx, testState()))
+	state, err = store.State(ctx)
+	require.NoError(t, err)
+	require.Equal(t, testState(), state)
+
+	require.NoError(t, os.WriteFile(path, []byte("bad"), 0o600))
+	_, err = store.State(ctx)
+	require.Error(t, err)
+}
+
+func TestMemoryStore(t *testing.T) {
+	ctx := context.Background()
+	store := lightclient.NewMemoryStore()
+
+	state, err := store.State(ctx)
+	require.NoError(t, err)
+	require.Nil(t, state)
+
+	require.NoError(t, store.SetState(ctx, testState()))
+	state, err = store.State(ctx)
+	require.NoError(t, err)
+	require.Equal(t, testState(), state)
+}
@@ -0,0 +1,455 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lightclient
+
+import (
+	"bytes"
+	"crypto/sha256"
+
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/holiman/uint256"
+	"github.com/pkg/errors"
+)
+
+// Generalized indices of the items proven by light client Merkle branches.
+const (
+	finalizedRootGindex        = 105
+	currentSyncCommitteeGindex = 54
+	nextSyncCommitteeGindex    = 55
+	executionPayloadGindex     = 25
+)
+
+// minSyncCommitteeParticipants is the minimum number of participants for an update to be considered.
+const minSyncCommitteeParticipants = 1
+
+// isValidMerkleBranch returns true if the branch proves the leaf at the
+// generalized index in the tree with the given root.
+func isValidMerkleBranch(leaf phase0.Root, branch [][]byte, gindex uint64, root phase0.Root) bool {
+	depth := 0
+	for i := gindex; i > 1; i >>= 1 {
+		depth++
+	}
+	if len(branch) != depth {
+		return false
+	}
+
+	value := leaf
+	buf := make([]byte, 64)
+	for i := 0; i < depth; i++ {
+		if len(branch[i]) != 32 {
+			return false
+		}
+		if (gindex>>i)&1 == 1 {
+			copy(buf[:32], branch[i])
+			copy(buf[32:], value[:])
+		} else {
+			copy(buf[:32], value[:])
+			copy(buf[32:], branch[i])
+		}
+		value = sha256.Sum256(buf)
+	}
+
+	return value == root
+}
+
+// syncCommitteePeriod returns the sync committee period of the slot.
+func (s *Service) syncCommitteePeriod(slot phase0.Slot) uint64 {
+	return uint64(slot) / s.slotsPerEpoch / s.epochsPerSyncCommitteePeriod
+}
+
+// isNextSyncCommitteeKnown returns true if the state contains the next sync committee.
+func (s *Service) isNextSyncCommitteeKnown() bool {
+	return s.state.NextSyncCommittee != nil
+}
+
+// safetyThreshold returns the participation required for an optimistic header to be accepted.
+func (s *Service) safetyThreshold() uint64 {
+	if s.state.PreviousMaxActiveParticipants > s.state.CurrentMaxActiveParticipants {
+		return s.state.PreviousMaxActiveParticipants / 2
+	}
+
+	return s.state.CurrentMaxActiveParticipants / 2
+}
+
+// isValidHeader returns true if the execution part of the header, if any, is consistent with its beacon part.
+func (s *Service) isValidHeader(h *header) (bool, error) {
+	epoch := phase0.Epoch(uint64(h.beacon.Slot) / s.slotsPerEpoch)
+
+	var executionRoot phase0.Root
+	switch {
+	case h.denebExecution != nil:
+		if epoch < s.denebForkEpoch &&
+			(h.denebExecution.BlobGasUsed != 0 || h.denebExecution.ExcessBlobGas != 0) {
+			return false, nil
+		}
+		if epoch < s.capellaForkEpoch {
+			return isEmptyExecutionHeader(h.denebExecution, &deneb.ExecutionPayloadHeader{BaseFeePerGas: new(uint256.Int)}, h.executionBranch)
+		}
+		root, err := h.denebExecution.HashTreeRoot()
+		if err != nil {
+			return false, errors.Wrap(err, "failed to obtain execution header root")
+		}
+		executionRoot = root
+	case h.capellaExecution != nil:
+		if epoch < s.capellaForkEpoch {
+			return isEmptyExecutionHeader(h.capellaExecution, &capella.ExecutionPayloadHeader{}, h.executionBranch)
+		}
+		root, err := h.capellaExecution.HashTreeRoot()
+		if err != nil {
+			return false, errors.Wrap(err, "failed to obtain execution header root")
+		}
+		executionRoot = root
+	default:
+		// Pre-Capella headers have no execution component.
+		return true, nil
+	}
+
+	return isValidMerkleBranch(executionRoot, h.executionBranch, executionPayloadGindex, h.beacon.BodyRoot), nil
+}
+
+// isEmptyExecutionHeader returns true if the execution header and its branch are empty,
+// as required for headers prior to Capella.
+func isEmptyExecutionHeader(header ssz.HashRoot, empty ssz.HashRoot, branch [][]byte) (bool, error) {
+	if !isZeroBranch(branch) {
+		return false, nil
+	}
+
+	root, err := header.HashTreeRoot()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to obtain execution header root")
+	}
+	emptyRoot, err := empty.HashTreeRoot()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to obtain empty execution header root")
+	}
+
+	return root == emptyRoot, nil
+}
+
+// forkVersion returns the fork version at the given epoch.
+func (s *Service) forkVersion(epoch phase0.Epoch) phase0.Version {
+	var version phase0.Version
+	for _, fork := range s.forkSchedule {
+		if fork.Epoch <= epoch {
+			version = fork.CurrentVersion
+		}
+	}
+
+	return version
+}
+
+// syncCommitteeSigningRoot returns the root signed by the sync committee for the given header.
+func (s *Service) syncCommitteeSigningRoot(h *phase0.BeaconBlockHeader, signatureSlot phase0.Slot) (phase0.Root, error) {
+	forkVersionSlot := signatureSlot
+	if forkVersionSlot > 0 {
+		forkVersionSlot--
+	}
+	forkData := &phase0.ForkData{
+		CurrentVersion:        s.forkVersion(phase0.Epoch(uint64(forkVersionSlot) / s.slotsPerEpoch)),
+		GenesisValidatorsRoot: s.genesisValidatorsRoot,
+	}
+	forkDataRoot, err := forkData.HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Wrap(err, "failed to obtain fork data root")
+	}
+	var domain phase0.Domain
+	copy(domain[:], s.domainSyncCommittee[:])
+	copy(domain[4:], forkDataRoot[:28])
+
+	headerRoot, err := h.HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Wrap(err, "failed to obtain header root")
+	}
+	signingData := &phase0.SigningData{
+		ObjectRoot: headerRoot,
+		Domain:     domain,
+	}
+
+	return signingData.HashTreeRoot()
+}
+
+// validateUpdate validates an update against the current state.
+func (s *Service) validateUpdate(u *update, currentSlot phase0.Slot) error {
+	if u.syncAggregate.SyncCommitteeBits.Count() < minSyncCommitteeParticipants {
+		return errors.New("insufficient sync committee participants")
+	}
+	valid, err := s.isValidHeader(u.attestedHeader)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		return errors.New("invalid attested header")
+	}
+
+	attestedSlot := u.attestedHeader.beacon.Slot
+	finalizedSlot := phase0.Slot(0)
+	if u.finalizedHeader != nil {
+		finalizedSlot = u.finalizedHeader.beacon.Slot
+	}
+	if currentSlot < u.signatureSlot || u.signatureSlot <= attestedSlot || attestedSlot < finalizedSlot {
+		return errors.New("update slots out of order")
+	}
+
+	statePeriod := s.syncCommitteePeriod(s.state.FinalizedHeader.Slot)
+	signaturePeriod := s.syncCommitteePeriod(u.signatureSlot)
+	if s.isNextSyncCommitteeKnown() {
+		if signaturePeriod != statePeriod && signaturePeriod != statePeriod+1 {
+			return errors.New("update signature period not current or next")
+		}
+	} else if signaturePeriod != statePeriod {
+		return errors.New("update signature period not current")
+	}
+
+	attestedPeriod := s.syncCommitteePeriod(attestedSlot)
+	hasNextSyncCommittee := !s.isNextSyncCommitteeKnown() &&
+		u.nextSyncCommittee != nil &&
+		attestedPeriod == statePeriod
+	if attestedSlot <= s.state.FinalizedHeader.Slot && !hasNextSyncCommittee {
+		return errors.New("update is not relevant")
+	}
+
+	if u.finalizedHeader != nil {
+		var finalizedRoot phase0.Root
+		if finalizedSlot != 0 {
+			valid, err := s.isValidHeader(u.finalizedHeader)
+			if err != nil {
+				return err
+			}
+			if !valid {
+				return errors.New("invalid finalized header")
+			}
+			finalizedRoot, err = u.finalizedHeader.beacon.HashTreeRoot()
+			if err != nil {
+				return errors.Wrap(err, "failed to obtain finalized header root")
+			}
+		}
+		if !isValidMerkleBranch(finalizedRoot, u.finalityBranch, finalizedRootGindex, u.attestedHeader.beacon.StateRoot) {
+			return errors.New("invalid finality branch")
+		}
+	}
+
+	if u.nextSyncCommittee != nil {
+		if attestedPeriod == statePeriod && s.isNextSyncCommitteeKnown() {
+			match, err := syncCommitteesEqual(u.nextSyncCommittee, s.state.NextSyncCommittee)
+			if err != nil {
+				return err
+			}
+			if !match {
+				return errors.New("next sync committee does not match known next sync committee")
+			}
+		}
+		nextSyncCommitteeRoot, err := u.nextSyncCommittee.HashTreeRoot()
+		if err != nil {
+			return errors.Wrap(err, "failed to obtain next sync committee root")
+		}
+		if !isValidMerkleBranch(nextSyncCommitteeRoot, u.nextSyncCommitteeBranch, nextSyncCommitteeGindex, u.attestedHeader.beacon.StateRoot) {
+			return errors.New("invalid next sync committee branch")
+		}
+	}
+
+	syncCommittee := s.state.CurrentSyncCommittee
+	if signaturePeriod != statePeriod {
+		syncCommittee = s.state.NextSyncCommittee
+	}
+	pubKeys := make([]phase0.BLSPubKey, 0, len(syncCommittee.Pubkeys))
+	for _, index := range u.syncAggregate.SyncCommitteeBits.BitIndices() {
+		if index >= len(syncCommittee.Pubkeys) {
+			return errors.New("sync committee bits exceed sync committee size")
+		}
+		pubKeys = append(pubKeys, syncCommittee.Pubkeys[index])
+	}
+	signingRoot, err := s.syncCommitteeSigningRoot(u.attestedHeader.beacon, u.signatureSlot)
+	if err != nil {
+		return err
+	}
+	verified, err := s.signatureVerifier.FastAggregateVerify(pubKeys, signingRoot, u.syncAggregate.SyncCommitteeSignature)
+	if err != nil {
+		return errors.Wrap(err, "failed to verify sync committee signature")
+	}
+	if !verified {
+		return errors.New("invalid sync committee signature")
+	}
+
+	return nil
+}
+
+// processUpdate validates an update and applies it to the current state if appropriate.
+// It returns true if the state changed.
+func (s *Service) processUpdate(u *update, currentSlot phase0.Slot) (bool, error) {
+	if err := s.validateUpdate(u, currentSlot); err != nil {
+		return false, err
+	}
+
+	changed := false
+	participants := s.participants(u)
+
+	if s.bestValidUpdate == nil || s.isBetterUpdate(u, s.bestValidUpdate) {
+		s.bestValidUpdate = u
+	}
+
+	if participants > s.state.CurrentMaxActiveParticipants {
+		s.state.CurrentMaxActiveParticipants = participants
+		changed = true
+	}
+
+	if participants > s.safetyThreshold() &&
+		u.attestedHeader.beacon.Slot > s.state.OptimisticHeader.Slot {
+		s.state.OptimisticHeader = u.attestedHeader.beacon
+		changed = true
+	}
+
+	hasFinalizedNextSyncCommittee := !s.isNextSyncCommitteeKnown() &&
+		u.nextSyncCommittee != nil &&
+		u.finalizedHeader != nil &&
+		s.syncCommitteePeriod(u.finalizedHeader.beacon.Slot) == s.syncCommitteePeriod(u.attestedHeader.beacon.Slot)
+	if participants*3 >= u.syncAggregate.SyncCommitteeBits.Len()*2 &&
+		u.finalizedHeader != nil &&
+		(u.finalizedHeader.beacon.Slot > s.state.FinalizedHeader.Slot || hasFinalizedNextSyncCommittee) {
+		if err := s.applyUpdate(u); err != nil {
+			return changed, err
+		}
+		s.bestValidUpdate = nil
+		changed = true
+	}
+
+	return changed, nil
+}
+
+// processForceUpdate applies the best valid update if no finalized update has been seen for too long.
+// It returns true if the state changed.
+func (s *Service) processForceUpdate(currentSlot phase0.Slot) (bool, error) {
+	updateTimeout := phase0.Slot(s.slotsPerEpoch * s.epochsPerSyncCommitteePeriod)
+	if currentSlot <= s.state.FinalizedHeader.Slot+updateTimeout || s.bestValidUpdate == nil {
+		return false, nil
+	}
+
+	// The attested header may be treated as finalized in extended periods of
+	// non-finality, to guarantee progression into later sync committee periods.
+	u := *s.bestValidUpdate
+	if u.finalizedHeader == nil || u.finalizedHeader.beacon.Slot <= s.state.FinalizedHeader.Slot {
+		u.finalizedHeader = u.attestedHeader
+	}
+	if err := s.applyUpdate(&u); err != nil {
+		return false, err
+	}
+	s.bestValidUpdate = nil
+
+	return true, nil
+}
+
+// applyUpdate applies a validated update to the state.
+func (s *Service) applyUpdate(u *update) error {
+	statePeriod := s.syncCommitteePeriod(s.state.FinalizedHeader.Slot)
+	finalizedPeriod := s.syncCommitteePeriod(u.finalizedHeader.beacon.Slot)
+	switch {
+	case !s.isNextSyncCommitteeKnown():
+		if finalizedPeriod != statePeriod {
+			return errors.New("update finalized period does not match state period")
+		}
+		s.state.NextSyncCommittee = u.nextSyncCommittee
+	case finalizedPeriod == statePeriod+1:
+		s.state.CurrentSyncCommittee = s.state.NextSyncCommittee
+		s.state.NextSyncCommittee = u.nextSyncCommittee
+		s.state.PreviousMaxActiveParticipants = s.state.CurrentMaxActiveParticipants
+		s.state.CurrentMaxActiveParticipants = 0
+	}
+
+	if u.finalizedHeader.beacon.Slot > s.state.FinalizedHeader.Slot {
+		s.state.FinalizedHeader = u.finalizedHeader.beacon
+		if s.state.FinalizedHeader.Slot > s.state.OptimisticHeader.Slot {
+			s.state.OptimisticHeader = s.state.FinalizedHeader
+		}
+	}
+
+	return nil
+}
+
+// participants returns the number of sync committee members that signed the update.
+func (*Service) participants(u *update) uint64 {
+	return u.syncAggregate.SyncCommitteeBits.Count()
+}
+
+// isBetterUpdate returns true if the new update is better than the old update.
+func (s *Service) isBetterUpdate(newUpdate *update, oldUpdate *update) bool {
+	maxParticipants := newUpdate.syncAggregate.SyncCommitteeBits.Len()
+	newParticipants := s.participants(newUpdate)
+	oldParticipants := s.participants(oldUpdate)
+
+	// Compare supermajority (> 2/3) sync committee participation.
+	newHasSupermajority := newParticipants*3 >= maxParticipants*2
+	oldHasSupermajority := oldParticipants*3 >= maxParticipants*2
+	if newHasSupermajority != oldHasSupermajority {
+		return newHasSupermajority
+	}
+	if !newHasSupermajority && newParticipants != oldParticipants {
+		return newParticipants > oldParticipants
+	}
+
+	// Compare presence of relevant sync committee.
+	newHasRelevantSyncCommittee := newUpdate.nextSyncCommittee != nil &&
+		s.syncCommitteePeriod(newUpdate.attestedHeader.beacon.Slot) == s.syncCommitteePeriod(newUpdate.signatureSlot)
+	oldHasRelevantSyncCommittee := oldUpdate.nextSyncCommittee != nil &&
+		s.syncCommitteePeriod(oldUpdate.attestedHeader.beacon.Slot) == s.syncCommitteePeriod(oldUpdate.signatureSlot)
+	if newHasRelevantSyncCommittee != oldHasRelevantSyncCommittee {
+		return newHasRelevantSyncCommittee
+	}
+
+	// Compare indication of any finality.
+	newHasFinality := newUpdate.finalizedHeader != nil
+	oldHasFinality := oldUpdate.finalizedHeader != nil
+	if newHasFinality != oldHasFinality {
+		return newHasFinality
+	}
+
+	// Compare sync committee finality.
+	if newHasFinality {
+		newHasSyncCommitteeFinality := s.syncCommitteePeriod(newUpdate.finalizedHeader.beacon.Slot) ==
+			s.syncCommitteePeriod(newUpdate.attestedHeader.beacon.Slot)
+		oldHasSyncCommitteeFinality := s.syncCommitteePeriod(oldUpdate.finalizedHeader.beacon.Slot) ==
+			s.syncCommitteePeriod(oldUpdate.attestedHeader.beacon.Slot)
+		if newHasSyncCommitteeFinality != oldHasSyncCommitteeFinality {
+			return newHasSyncCommitteeFinality
+		}
+	}
+
+	// Tiebreaker 1: sync committee participation beyond supermajority.
+	if newParticipants != oldParticipants {
+		return newParticipants > oldParticipants
+	}
+
+	// Tiebreaker 2: prefer older data (fewer changes to best).
+	if newUpdate.attestedHeader.beacon.Slot != oldUpdate.attestedHeader.beacon.Slot {
+		return newUpdate.attestedHeader.beacon.Slot < oldUpdate.attestedHeader.beacon.Slot
+	}
+
+	return newUpdate.signatureSlot < oldUpdate.signatureSlot
+}
+
+// syncCommitteesEqual returns true if the two sync committees are the same.
+func syncCommitteesEqual(a *altair.SyncCommittee, b *altair.SyncCommittee) (bool, error) {
+	aRoot, err := a.HashTreeRoot()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to obtain sync committee root")
+	}
+	bRoot, err := b.HashTreeRoot()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to obtain sync committee root")
+	}
+
+	return bytes.Equal(aRoot[:], bRoot[:]), nil
+}
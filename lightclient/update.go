@@ -0,0 +1,362 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lightclient
+
+import (
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// header is a fork-agnostic light client header.
+type header struct {
+	beacon *phase0.BeaconBlockHeader
+	// Only one of the execution headers is present, and only from Capella onwards.
+	capellaExecution *capella.ExecutionPayloadHeader
+	denebExecution   *deneb.ExecutionPayloadHeader
+	executionBranch  [][]byte
+}
+
+// update is a fork-agnostic light client update.
+// Finality and optimistic updates are represented without a next sync committee,
+// and optimistic updates also without a finalized header.
+type update struct {
+	attestedHeader          *header
+	nextSyncCommittee       *altair.SyncCommittee
+	nextSyncCommitteeBranch [][]byte
+	finalizedHeader         *header
+	finalityBranch          [][]byte
+	syncAggregate           *altair.SyncAggregate
+	signatureSlot           phase0.Slot
+}
+
+// bootstrap is a fork-agnostic light client bootstrap.
+type bootstrap struct {
+	header                     *header
+	currentSyncCommittee       *altair.SyncCommittee
+	currentSyncCommitteeBranch [][]byte
+}
+
+func altairHeader(h *altair.LightClientHeader) (*header, error) {
+	if h == nil || h.Beacon == nil {
+		return nil, errors.New("header missing")
+	}
+
+	return &header{
+		beacon: h.Beacon,
+	}, nil
+}
+
+func capellaHeader(h *capella.LightClientHeader) (*header, error) {
+	if h == nil || h.Beacon == nil {
+		return nil, errors.New("header missing")
+	}
+	if h.Execution == nil {
+		return nil, errors.New("execution header missing")
+	}
+
+	return &header{
+		beacon:           h.Beacon,
+		capellaExecution: h.Execution,
+		executionBranch:  h.ExecutionBranch,
+	}, nil
+}
+
+func denebHeader(h *deneb.LightClientHeader) (*header, error) {
+	if h == nil || h.Beacon == nil {
+		return nil, errors.New("header missing")
+	}
+	if h.Execution == nil {
+		return nil, errors.New("execution header missing")
+	}
+
+	return &header{
+		beacon:          h.Beacon,
+		denebExecution:  h.Execution,
+		executionBranch: h.ExecutionBranch,
+	}, nil
+}
+
+// isZeroBranch returns true if the branch is empty or all of its nodes are zero.
+func isZeroBranch(branch [][]byte) bool {
+	for i := range branch {
+		for j := range branch[i] {
+			if branch[i][j] != 0 {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// newUpdate creates a fork-agnostic update from the components of a versioned update.
+func newUpdate(attestedHeader *header,
+	nextSyncCommittee *altair.SyncCommittee,
+	nextSyncCommitteeBranch [][]byte,
+	finalizedHeader *header,
+	finalityBranch [][]byte,
+	syncAggregate *altair.SyncAggregate,
+	signatureSlot phase0.Slot,
+) (
+	*update,
+	error,
+) {
+	if syncAggregate == nil {
+		return nil, errors.New("sync aggregate missing")
+	}
+
+	res := &update{
+		attestedHeader: attestedHeader,
+		syncAggregate:  syncAggregate,
+		signatureSlot:  signatureSlot,
+	}
+	if nextSyncCommittee != nil && !isZeroBranch(nextSyncCommitteeBranch) {
+		res.nextSyncCommittee = nextSyncCommittee
+		res.nextSyncCommitteeBranch = nextSyncCommitteeBranch
+	}
+	if finalizedHeader != nil && !isZeroBranch(finalityBranch) {
+		res.finalizedHeader = finalizedHeader
+		res.finalityBranch = finalityBranch
+	}
+
+	return res, nil
+}
+
+func updateFromVersioned(data *spec.VersionedLCUpdate) (*update, error) {
+	if data == nil {
+		return nil, errors.New("no update")
+	}
+
+	switch data.Version {
+	case spec.DataVersionAltair, spec.DataVersionBellatrix:
+		u := data.Altair
+		if data.Version == spec.DataVersionBellatrix {
+			u = data.Bellatrix
+		}
+		if u == nil {
+			return nil, errors.New("no update data")
+		}
+		attestedHeader, err := altairHeader(u.AttestedHeader)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid attested header")
+		}
+		finalizedHeader, err := altairHeader(u.FinalizedHeader)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid finalized header")
+		}
+
+		return newUpdate(attestedHeader, u.NextSyncCommittee, u.NextSyncCommitteeBranch, finalizedHeader, u.FinalityBranch, u.SyncAggregate, u.SignatureSlot)
+	case spec.DataVersionCapella:
+		u := data.Capella
+		if u == nil {
+			return nil, errors.New("no update data")
+		}
+		attestedHeader, err := capellaHeader(u.AttestedHeader)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid attested header")
+		}
+		finalizedHeader, err := capellaHeader(u.FinalizedHeader)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid finalized header")
+		}
+
+		return newUpdate(attestedHeader, u.NextSyncCommittee, u.NextSyncCommitteeBranch, finalizedHeader, u.FinalityBranch, u.SyncAggregate, u.SignatureSlot)
+	case spec.DataVersionDeneb:
+		u := data.Deneb
+		if u == nil {
+			return nil, errors.New("no update data")
+		}
+		attestedHeader, err := denebHeader(u.AttestedHeader)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid attested header")
+		}
+		finalizedHeader, err := denebHeader(u.FinalizedHeader)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid finalized header")
+		}
+
+		return newUpdate(attestedHeader, u.NextSyncCommittee, u.NextSyncCommitteeBranch, finalizedHeader, u.FinalityBranch, u.SyncAggregate, u.SignatureSlot)
+	default:
+		return nil, errors.Errorf("unsupported version %s", data.Version)
+	}
+}
+
+func updateFromVersionedFinalityUpdate(data *spec.VersionedLCFinalityUpdate) (*update, error) {
+	if data == nil {
+		return nil, errors.New("no finality update")
+	}
+
+	switch data.Version {
+	case spec.DataVersionAltair, spec.DataVersionBellatrix:
+		u := data.Altair
+		if data.Version == spec.DataVersionBellatrix {
+			u = data.Bellatrix
+		}
+		if u == nil {
+			return nil, errors.New("no finality update data")
+		}
+		attestedHeader, err := altairHeader(u.AttestedHeader)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid attested header")
+		}
+		finalizedHeader, err := altairHeader(u.FinalizedHeader)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid finalized header")
+		}
+
+		return newUpdate(attestedHeader, nil, nil, finalizedHeader, u.FinalityBranch, u.SyncAggregate, u.SignatureSlot)
+	case spec.DataVersionCapella:
+		u := data.Capella
+		if u == nil {
+			return nil, errors.New("no finality update data")
+		}
+		attestedHeader, err := capellaHeader(u.AttestedHeader)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid attested header")
+		}
+		finalizedHeader, err := capellaHeader(u.FinalizedHeader)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid finalized header")
+		}
+
+		return newUpdate(attestedHeader, nil, nil, finalizedHeader, u.FinalityBranch, u.SyncAggregate, u.SignatureSlot)
+	case spec.DataVersionDeneb:
+		u := data.Deneb
+		if u == nil {
+			return nil, errors.New("no finality update data")
+		}
+		attestedHeader, err := denebHeader(u.AttestedHeader)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid attested header")
+		}
+		finalizedHeader, err := denebHeader(u.FinalizedHeader)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid finalized header")
+		}
+
+		return newUpdate(attestedHeader, nil, nil, finalizedHeader, u.FinalityBranch, u.SyncAggregate, u.SignatureSlot)
+	default:
+		return nil, errors.Errorf("unsupported version %s", data.Version)
+	}
+}
+
+func updateFromVersionedOptimisticUpdate(data *spec.VersionedLCOptimisticUpdate) (*update, error) {
+	if data == nil {
+		return nil, errors.New("no optimistic update")
+	}
+
+	switch data.Version {
+	case spec.DataVersionAltair, spec.DataVersionBellatrix:
+		u := data.Altair
+		if data.Version == spec.DataVersionBellatrix {
+			u = data.Bellatrix
+		}
+		if u == nil {
+			return nil, errors.New("no optimistic update data")
+		}
+		attestedHeader, err := altairHeader(u.AttestedHeader)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid attested header")
+		}
+
+		return newUpdate(attestedHeader, nil, nil, nil, nil, u.SyncAggregate, u.SignatureSlot)
+	case spec.DataVersionCapella:
+		u := data.Capella
+		if u == nil {
+			return nil, errors.New("no optimistic update data")
+		}
+		attestedHeader, err := capellaHeader(u.AttestedHeader)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid attested header")
+		}
+
+		return newUpdate(attestedHeader, nil, nil, nil, nil, u.SyncAggregate, u.SignatureSlot)
+	case spec.DataVersionDeneb:
+		u := data.Deneb
+		if u == nil {
+			return nil, errors.New("no optimistic update data")
+		}
+		attestedHeader, err := denebHeader(u.AttestedHeader)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid attested header")
+		}
+
+		return newUpdate(attestedHeader, nil, nil, nil, nil, u.SyncAggregate, u.SignatureSlot)
+	default:
+		return nil, errors.Errorf("unsupported version %s", data.Version)
+	}
+}
+
+func bootstrapFromVersioned(data *spec.VersionedLCBootstrap) (*bootstrap, error) {
+	if data == nil {
+		return nil, errors.New("no bootstrap")
+	}
+
+	switch data.Version {
+	case spec.DataVersionAltair, spec.DataVersionBellatrix:
+		b := data.Altair
+		if data.Version == spec.DataVersionBellatrix {
+			b = data.Bellatrix
+		}
+		if b == nil {
+			return nil, errors.New("no bootstrap data")
+		}
+		h, err := altairHeader(b.Header)
+		if err != nil {
+			return nil, err
+		}
+
+		return &bootstrap{
+			header:                     h,
+			currentSyncCommittee:       b.CurrentSyncCommittee,
+			currentSyncCommitteeBranch: b.CurrentSyncCommitteeBranch,
+		}, nil
+	case spec.DataVersionCapella:
+		b := data.Capella
+		if b == nil {
+			return nil, errors.New("no bootstrap data")
+		}
+		h, err := capellaHeader(b.Header)
+		if err != nil {
+			return nil, err
+		}
+
+		return &bootstrap{
+			header:                     h,
+			currentSyncCommittee:       b.CurrentSyncCommittee,
+			currentSyncCommitteeBranch: b.CurrentSyncCommitteeBranch,
+		}, nil
+	case spec.DataVersionDeneb:
+		b := data.Deneb
+		if b == nil {
+			return nil, errors.New("no bootstrap data")
+		}
+		h, err := denebHeader(b.Header)
+		if err != nil {
+			return nil, err
+		}
+
+		return &bootstrap{
+			header:                     h,
+			currentSyncCommittee:       b.CurrentSyncCommittee,
+			currentSyncCommitteeBranch: b.CurrentSyncCommitteeBranch,
+		}, nil
+	default:
+		return nil, errors.Errorf("unsupported version %s", data.Version)
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+)
+
+// NodeIdentity provides the identity of the node.
+func (s *Service) NodeIdentity(_ context.Context,
+	_ *api.NodeIdentityOpts,
+) (
+	*api.Response[*apiv1.NodeIdentity],
+	error,
+) {
+	return &api.Response[*apiv1.NodeIdentity]{
+		Data: &apiv1.NodeIdentity{
+			PeerID:             "16Uiu2HAmJTM9fMwCa8tLDNKpE3Fy6vBQVS4D4kHgcADFiVFYKHEW",
+			ENR:                "enr:-mock",
+			P2PAddresses:       []string{},
+			DiscoveryAddresses: []string{},
+			Metadata: &apiv1.NodeMetadata{
+				Attnets:  bitfield.NewBitvector64(),
+				Syncnets: bitfield.NewBitvector4(),
+			},
+		},
+		Metadata: make(map[string]any),
+	}, nil
+}
@@ -23,3 +23,8 @@ import (
 func (s *Service) SubmitProposal(_ context.Context, _ *api.VersionedSignedProposal) error {
 	return nil
 }
+
+// SubmitProposalWithOpts submits a proposal with the given options.
+func (s *Service) SubmitProposalWithOpts(_ context.Context, _ *api.SubmitProposalOpts) error {
+	return nil
+}
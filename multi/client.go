@@ -20,6 +20,7 @@ import (
 
 	consensusclient "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 )
@@ -54,7 +55,7 @@ func (s *Service) recheck(ctx context.Context) {
 
 	// Ping each client to update its state.
 	for _, client := range clients {
-		if ping(ctx, client) {
+		if ping(ctx, client, s.nodeHealth) {
 			s.activateClient(ctx, client)
 		} else {
 			s.deactivateClient(ctx, client)
@@ -118,9 +119,25 @@ func (s *Service) activateClient(ctx context.Context, client consensusclient.Ser
 
 // ping pings a client, returning true if it is ready to serve requests and
 // false otherwise.
-func ping(ctx context.Context, client consensusclient.Service) bool {
+// If nodeHealth is true and the client provides node health then that is used,
+// otherwise the client's sync state is used.
+func ping(ctx context.Context, client consensusclient.Service, nodeHealth bool) bool {
 	log := zerolog.Ctx(ctx)
 
+	if nodeHealth {
+		if provider, isProvider := client.(consensusclient.NodeHealthProvider); isProvider {
+			response, err := provider.NodeHealth(ctx, &api.NodeHealthOpts{})
+			if err != nil {
+				log.Warn().Err(err).Msg("Failed to obtain health from node")
+
+				return false
+			}
+
+			return response.Data == apiv1.NodeHealthReady
+		}
+		log.Debug().Str("provider", client.Address()).Msg("Client does not provide health; falling back to sync state")
+	}
+
 	provider, isProvider := client.(consensusclient.NodeSyncingProvider)
 	if !isProvider {
 		log.Debug().Str("provider", client.Address()).Msg("Client does not provide sync state")
@@ -142,3 +142,20 @@ func TestRecheck(t *testing.T) {
 	// Should re-activate in recheck so not return an error.
 	require.NoError(t, err)
 }
+
+// TestPingNodeHealth tests ping using the node health endpoint.
+func TestPingNodeHealth(t *testing.T) {
+	ctx := context.Background()
+
+	readyClient, err := mock.New(ctx)
+	require.NoError(t, err)
+	syncingClient, err := mock.New(ctx)
+	require.NoError(t, err)
+	syncingClient.SyncDistance = 10
+	erroringClient, err := testclients.NewErroring(ctx, 1, readyClient)
+	require.NoError(t, err)
+
+	require.True(t, ping(ctx, readyClient, true))
+	require.False(t, ping(ctx, syncingClient, true))
+	require.False(t, ping(ctx, erroringClient, true))
+}
@@ -0,0 +1,44 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+)
+
+// ExpectedWithdrawals provides the withdrawals expected in the payload of a proposal.
+func (s *Service) ExpectedWithdrawals(ctx context.Context,
+	opts *api.ExpectedWithdrawalsOpts,
+) (
+	*api.Response[[]*capella.Withdrawal],
+	error,
+) {
+	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		withdrawals, err := client.(consensusclient.ExpectedWithdrawalsProvider).ExpectedWithdrawals(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		return withdrawals, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*api.Response[[]*capella.Withdrawal]), nil
+}
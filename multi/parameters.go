@@ -30,6 +30,7 @@ type parameters struct {
 	timeout      time.Duration
 	extraHeaders map[string]string
 	enforceJSON  bool
+	nodeHealth   bool
 }
 
 // Parameter is the interface for service parameters.
@@ -92,6 +93,14 @@ func WithExtraHeaders(headers map[string]string) Parameter {
 	})
 }
 
+// WithNodeHealth uses the node's health endpoint, where available, when checking
+// if a client is ready to serve requests.
+func WithNodeHealth(nodeHealth bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.nodeHealth = nodeHealth
+	})
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
@@ -31,6 +31,9 @@ type Service struct {
 	clientsMu       sync.RWMutex
 	activeClients   []consensusclient.Service
 	inactiveClients []consensusclient.Service
+
+	// nodeHealth is true if the node health endpoint should be used when pinging clients.
+	nodeHealth bool
 }
 
 // New creates a new Ethereum 2 client with multiple endpoints.
@@ -60,7 +63,7 @@ func New(ctx context.Context, params ...Parameter) (consensusclient.Service, err
 	activeClients := make([]consensusclient.Service, 0, len(parameters.clients))
 	inactiveClients := make([]consensusclient.Service, 0, len(parameters.clients))
 	for _, client := range parameters.clients {
-		if ping(ctx, client) {
+		if ping(ctx, client, parameters.nodeHealth) {
 			activeClients = append(activeClients, client)
 		} else {
 			inactiveClients = append(inactiveClients, client)
@@ -79,7 +82,7 @@ func New(ctx context.Context, params ...Parameter) (consensusclient.Service, err
 
 			continue
 		}
-		if ping(ctx, client) {
+		if ping(ctx, client, parameters.nodeHealth) {
 			activeClients = append(activeClients, client)
 			setProviderActiveMetric(ctx, client.Address(), "active")
 		} else {
@@ -98,6 +101,7 @@ func New(ctx context.Context, params ...Parameter) (consensusclient.Service, err
 		log:             log,
 		activeClients:   activeClients,
 		inactiveClients: inactiveClients,
+		nodeHealth:      parameters.nodeHealth,
 	}
 
 	// Kick off monitor.
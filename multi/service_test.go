@@ -103,12 +103,17 @@ func TestInterfaces(t *testing.T) {
 
 	// Standard interfacs.
 	assert.Implements(t, (*client.AggregateAttestationProvider)(nil), s)
+	assert.Implements(t, (*client.VersionedAggregateAttestationProvider)(nil), s)
 	assert.Implements(t, (*client.AggregateAttestationsSubmitter)(nil), s)
+	assert.Implements(t, (*client.VersionedAggregateAttestationsSubmitter)(nil), s)
 	assert.Implements(t, (*client.AttestationDataProvider)(nil), s)
 	assert.Implements(t, (*client.AttestationPoolProvider)(nil), s)
+	assert.Implements(t, (*client.VersionedAttestationPoolProvider)(nil), s)
 	assert.Implements(t, (*client.AttestationsSubmitter)(nil), s)
+	assert.Implements(t, (*client.VersionedAttestationsSubmitter)(nil), s)
 	assert.Implements(t, (*client.AttesterDutiesProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconBlockHeadersProvider)(nil), s)
+	assert.Implements(t, (*client.BeaconBlockHeadersListProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconBlockRootProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconBlockSubmitter)(nil), s)
 	assert.Implements(t, (*client.BeaconCommitteeSubscriptionsSubmitter)(nil), s)
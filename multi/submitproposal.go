@@ -33,3 +33,17 @@ func (s *Service) SubmitProposal(ctx context.Context, proposal *api.VersionedSig
 
 	return err
 }
+
+// SubmitProposalWithOpts submits a proposal with the given options.
+func (s *Service) SubmitProposalWithOpts(ctx context.Context, opts *api.SubmitProposalOpts) error {
+	_, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		err := client.(consensusclient.ProposalWithOptsSubmitter).SubmitProposalWithOpts(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		return true, nil
+	}, nil)
+
+	return err
+}
@@ -0,0 +1,37 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// SubmitVersionedAggregateAttestations submits aggregate attestations.
+func (s *Service) SubmitVersionedAggregateAttestations(ctx context.Context,
+	opts *api.SubmitAggregateAttestationsOpts,
+) error {
+	_, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		err := client.(consensusclient.VersionedAggregateAttestationsSubmitter).SubmitVersionedAggregateAttestations(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		return true, nil
+	}, nil)
+
+	return err
+}
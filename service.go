@@ -137,12 +137,24 @@ type AggregateAttestationProvider interface {
 	AggregateAttestation(ctx context.Context, opts *api.AggregateAttestationOpts) (*api.Response[*phase0.Attestation], error)
 }
 
+// VersionedAggregateAttestationProvider is the interface for providing versioned aggregate attestations.
+type VersionedAggregateAttestationProvider interface {
+	// VersionedAggregateAttestation fetches the aggregate attestation for the given options.
+	VersionedAggregateAttestation(ctx context.Context, opts *api.AggregateAttestationOpts) (*api.Response[*spec.VersionedAttestation], error)
+}
+
 // AggregateAttestationsSubmitter is the interface for submitting aggregate attestations.
 type AggregateAttestationsSubmitter interface {
 	// SubmitAggregateAttestations submits aggregate attestations.
 	SubmitAggregateAttestations(ctx context.Context, aggregateAndProofs []*phase0.SignedAggregateAndProof) error
 }
 
+// VersionedAggregateAttestationsSubmitter is the interface for submitting versioned aggregate attestations.
+type VersionedAggregateAttestationsSubmitter interface {
+	// SubmitVersionedAggregateAttestations submits aggregate attestations.
+	SubmitVersionedAggregateAttestations(ctx context.Context, opts *api.SubmitAggregateAttestationsOpts) error
+}
+
 // AttestationDataProvider is the interface for providing attestation data.
 type AttestationDataProvider interface {
 	// AttestationData fetches the attestation data for the given options.
@@ -155,12 +167,24 @@ type AttestationPoolProvider interface {
 	AttestationPool(ctx context.Context, opts *api.AttestationPoolOpts) (*api.Response[[]*phase0.Attestation], error)
 }
 
+// VersionedAttestationPoolProvider is the interface for providing versioned attestation pools.
+type VersionedAttestationPoolProvider interface {
+	// VersionedAttestationPool fetches the attestation pool for the given options.
+	VersionedAttestationPool(ctx context.Context, opts *api.AttestationPoolOpts) (*api.Response[[]*spec.VersionedAttestation], error)
+}
+
 // AttestationsSubmitter is the interface for submitting attestations.
 type AttestationsSubmitter interface {
 	// SubmitAttestations submits attestations.
 	SubmitAttestations(ctx context.Context, attestations []*phase0.Attestation) error
 }
 
+// VersionedAttestationsSubmitter is the interface for submitting versioned attestations.
+type VersionedAttestationsSubmitter interface {
+	// SubmitVersionedAttestations submits attestations.
+	SubmitVersionedAttestations(ctx context.Context, opts *api.SubmitAttestationsOpts) error
+}
+
 // AttesterSlashingSubmitter is the interface for submitting attester slashings.
 type AttesterSlashingSubmitter interface {
 	// SubmitAttesterSlashing submits an attester slashing
@@ -179,6 +203,18 @@ type DepositContractProvider interface {
 	DepositContract(ctx context.Context, opts *api.DepositContractOpts) (*api.Response[*apiv1.DepositContract], error)
 }
 
+// DepositSnapshotProvider is the interface for providing the deposit snapshot.
+type DepositSnapshotProvider interface {
+	// DepositSnapshot provides the EIP-4881 snapshot of the finalized deposit tree.
+	DepositSnapshot(ctx context.Context, opts *api.DepositSnapshotOpts) (*api.Response[*apiv1.DepositSnapshot], error)
+}
+
+// ExpectedWithdrawalsProvider is the interface for providing expected withdrawals.
+type ExpectedWithdrawalsProvider interface {
+	// ExpectedWithdrawals provides the withdrawals expected in the payload of a proposal.
+	ExpectedWithdrawals(ctx context.Context, opts *api.ExpectedWithdrawalsOpts) (*api.Response[[]*capella.Withdrawal], error)
+}
+
 // SyncCommitteeDutiesProvider is the interface for providing sync committee duties.
 type SyncCommitteeDutiesProvider interface {
 	// SyncCommitteeDuties obtains sync committee duties.
@@ -222,6 +258,12 @@ type BeaconBlockHeadersProvider interface {
 	BeaconBlockHeader(ctx context.Context, opts *api.BeaconBlockHeaderOpts) (*api.Response[*apiv1.BeaconBlockHeader], error)
 }
 
+// BeaconBlockHeadersListProvider is the interface for providing lists of beacon block headers.
+type BeaconBlockHeadersListProvider interface {
+	// BeaconBlockHeaders provides the block headers matching the opts.
+	BeaconBlockHeaders(ctx context.Context, opts *api.BeaconBlockHeadersOpts) (*api.Response[[]*apiv1.BeaconBlockHeader], error)
+}
+
 // ProposalProvider is the interface for providing proposals.
 type ProposalProvider interface {
 	// Proposal fetches a proposal for signing.
@@ -253,6 +295,24 @@ type ProposalSubmitter interface {
 	SubmitProposal(ctx context.Context, block *api.VersionedSignedProposal) error
 }
 
+// ProposalWithOptsSubmitter is the interface for submitting proposals with options.
+type ProposalWithOptsSubmitter interface {
+	// SubmitProposalWithOpts submits a proposal with the given options.
+	SubmitProposalWithOpts(ctx context.Context, opts *api.SubmitProposalOpts) error
+}
+
+// BeaconCommitteeSelectionsProvider is the interface for providing aggregated beacon committee selections.
+type BeaconCommitteeSelectionsProvider interface {
+	// BeaconCommitteeSelections exchanges partial beacon committee selections for aggregated beacon committee selections.
+	BeaconCommitteeSelections(ctx context.Context, opts *api.BeaconCommitteeSelectionsOpts) (*api.Response[[]*apiv1.BeaconCommitteeSelection], error)
+}
+
+// SyncCommitteeSelectionsProvider is the interface for providing aggregated sync committee selections.
+type SyncCommitteeSelectionsProvider interface {
+	// SyncCommitteeSelections exchanges partial sync committee selections for aggregated sync committee selections.
+	SyncCommitteeSelections(ctx context.Context, opts *api.SyncCommitteeSelectionsOpts) (*api.Response[[]*apiv1.SyncCommitteeSelection], error)
+}
+
 // BeaconCommitteeSubscriptionsSubmitter is the interface for submitting beacon committee subnet subscription requests.
 type BeaconCommitteeSubscriptionsSubmitter interface {
 	// SubmitBeaconCommitteeSubscriptions subscribes to beacon committees.
@@ -297,6 +357,12 @@ type BlindedProposalSubmitter interface {
 	SubmitBlindedProposal(ctx context.Context, block *api.VersionedSignedBlindedProposal) error
 }
 
+// BlindedProposalWithOptsSubmitter is the interface for submitting blinded proposals with options.
+type BlindedProposalWithOptsSubmitter interface {
+	// SubmitBlindedProposalWithOpts submits a blinded proposal with the given options.
+	SubmitBlindedProposalWithOpts(ctx context.Context, opts *api.SubmitBlindedProposalOpts) error
+}
+
 // ValidatorRegistrationsSubmitter is the interface for submitting validator registrations.
 type ValidatorRegistrationsSubmitter interface {
 	// SubmitValidatorRegistrations submits a validator registration.
@@ -339,6 +405,24 @@ type GenesisProvider interface {
 	Genesis(ctx context.Context, opts *api.GenesisOpts) (*api.Response[*apiv1.Genesis], error)
 }
 
+// NodeHealthProvider is the interface for providing node health.
+type NodeHealthProvider interface {
+	// NodeHealth provides the health of the node.
+	NodeHealth(ctx context.Context, opts *api.NodeHealthOpts) (*api.Response[apiv1.NodeHealth], error)
+}
+
+// NodeIdentityProvider is the interface for providing node identity.
+type NodeIdentityProvider interface {
+	// NodeIdentity provides the identity of the node.
+	NodeIdentity(ctx context.Context, opts *api.NodeIdentityOpts) (*api.Response[*apiv1.NodeIdentity], error)
+}
+
+// NodePeerCountProvider is the interface for providing peer counts.
+type NodePeerCountProvider interface {
+	// NodePeerCount provides the number of peers of the node in each connection state.
+	NodePeerCount(ctx context.Context, opts *api.NodePeerCountOpts) (*api.Response[*apiv1.PeerCount], error)
+}
+
 // NodePeersProvider is the interface for providing peer information.
 type NodePeersProvider interface {
 	// NodePeers provides the peers of the node.
@@ -390,6 +474,30 @@ type ValidatorBalancesProvider interface {
 	ValidatorBalances(ctx context.Context, opts *api.ValidatorBalancesOpts) (*api.Response[map[phase0.ValidatorIndex]phase0.Gwei], error)
 }
 
+// AttestationRewardsProvider is the interface for providing attestation rewards.
+type AttestationRewardsProvider interface {
+	// AttestationRewards provides the attestation rewards for the given options.
+	AttestationRewards(ctx context.Context, opts *api.AttestationRewardsOpts) (*api.Response[*apiv1.AttestationRewards], error)
+}
+
+// BlockRewardsProvider is the interface for providing block rewards.
+type BlockRewardsProvider interface {
+	// BlockRewards provides the block rewards for the given options.
+	BlockRewards(ctx context.Context, opts *api.BlockRewardsOpts) (*api.Response[*apiv1.BlockRewards], error)
+}
+
+// SyncCommitteeRewardsProvider is the interface for providing sync committee rewards.
+type SyncCommitteeRewardsProvider interface {
+	// SyncCommitteeRewards provides the sync committee rewards for the given options.
+	SyncCommitteeRewards(ctx context.Context, opts *api.SyncCommitteeRewardsOpts) (*api.Response[[]*apiv1.SyncCommitteeReward], error)
+}
+
+// ValidatorLivenessProvider is the interface for providing validator liveness.
+type ValidatorLivenessProvider interface {
+	// ValidatorLiveness provides the liveness of validators for the given options.
+	ValidatorLiveness(ctx context.Context, opts *api.ValidatorLivenessOpts) (*api.Response[[]*apiv1.ValidatorLiveness], error)
+}
+
 // ValidatorsProvider is the interface for providing validator information.
 type ValidatorsProvider interface {
 	// Validators provides the validators, with their balance and status, for the given options.
@@ -417,6 +525,24 @@ type LightClientProvider interface {
 	LightClientOptimisticUpdate(ctx context.Context) (*altair.LightClientOptimisticUpdate, error)
 }
 
+// AttesterSlashingPoolProvider is the interface for providing attester slashing pools.
+type AttesterSlashingPoolProvider interface {
+	// AttesterSlashingPool fetches the attester slashing pool.
+	AttesterSlashingPool(ctx context.Context, opts *api.AttesterSlashingPoolOpts) (*api.Response[[]*phase0.AttesterSlashing], error)
+}
+
+// ProposerSlashingPoolProvider is the interface for providing proposer slashing pools.
+type ProposerSlashingPoolProvider interface {
+	// ProposerSlashingPool fetches the proposer slashing pool.
+	ProposerSlashingPool(ctx context.Context, opts *api.ProposerSlashingPoolOpts) (*api.Response[[]*phase0.ProposerSlashing], error)
+}
+
+// BLSToExecutionChangePoolProvider is the interface for providing BLS to execution change pools.
+type BLSToExecutionChangePoolProvider interface {
+	// BLSToExecutionChangePool fetches the BLS to execution change pool.
+	BLSToExecutionChangePool(ctx context.Context, opts *api.BLSToExecutionChangePoolOpts) (*api.Response[[]*capella.SignedBLSToExecutionChange], error)
+}
+
 // VoluntaryExitPoolProvider is the interface for providing voluntary exit pools.
 type VoluntaryExitPoolProvider interface {
 	// VoluntaryExitPool fetches the voluntary exit pool.
@@ -0,0 +1,61 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// aggregateAndProofJSON is the spec representation of the struct.
+type aggregateAndProofJSON struct {
+	AggregatorIndex string              `json:"aggregator_index"`
+	Aggregate       *Attestation        `json:"aggregate"`
+	SelectionProof  phase0.BLSSignature `json:"selection_proof"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (a *AggregateAndProof) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&aggregateAndProofJSON{
+		AggregatorIndex: fmt.Sprintf("%d", a.AggregatorIndex),
+		Aggregate:       a.Aggregate,
+		SelectionProof:  a.SelectionProof,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (a *AggregateAndProof) UnmarshalJSON(input []byte) error {
+	raw, err := codecs.RawJSON(&aggregateAndProofJSON{}, input)
+	if err != nil {
+		return err
+	}
+
+	if err := a.AggregatorIndex.UnmarshalJSON(raw["aggregator_index"]); err != nil {
+		return errors.Wrap(err, "aggregator_index")
+	}
+
+	if err := json.Unmarshal(raw["aggregate"], &a.Aggregate); err != nil {
+		return errors.Wrap(err, "aggregate")
+	}
+
+	if err := a.SelectionProof.UnmarshalJSON(raw["selection_proof"]); err != nil {
+		return errors.Wrap(err, "selection_proof")
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra
+
+import (
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/goccy/go-yaml"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+)
+
+// Attestation is the Ethereum 2 attestation structure.
+type Attestation struct {
+	AggregationBits bitfield.Bitlist `ssz-max:"131072"`
+	Data            *phase0.AttestationData
+	Signature       phase0.BLSSignature  `ssz-size:"96"`
+	CommitteeBits   bitfield.Bitvector64 `ssz-size:"8"`
+}
+
+// String returns a string version of the structure.
+func (a *Attestation) String() string {
+	data, err := yaml.Marshal(a)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
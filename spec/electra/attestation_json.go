@@ -0,0 +1,94 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+)
+
+// attestationJSON is the spec representation of the struct.
+type attestationJSON struct {
+	AggregationBits string                  `json:"aggregation_bits"`
+	Data            *phase0.AttestationData `json:"data"`
+	Signature       phase0.BLSSignature     `json:"signature"`
+	CommitteeBits   string                  `json:"committee_bits"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (a *Attestation) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&attestationJSON{
+		AggregationBits: fmt.Sprintf("%#x", []byte(a.AggregationBits)),
+		Data:            a.Data,
+		Signature:       a.Signature,
+		CommitteeBits:   fmt.Sprintf("%#x", []byte(a.CommitteeBits)),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (a *Attestation) UnmarshalJSON(input []byte) error {
+	raw, err := codecs.RawJSON(&attestationJSON{}, input)
+	if err != nil {
+		return err
+	}
+
+	aggregationBits, err := unmarshalHexBytes(raw["aggregation_bits"])
+	if err != nil {
+		return errors.Wrap(err, "aggregation_bits")
+	}
+	a.AggregationBits = bitfield.Bitlist(aggregationBits)
+
+	if err := json.Unmarshal(raw["data"], &a.Data); err != nil {
+		return errors.Wrap(err, "data")
+	}
+
+	if err := a.Signature.UnmarshalJSON(raw["signature"]); err != nil {
+		return errors.Wrap(err, "signature")
+	}
+
+	committeeBits, err := unmarshalHexBytes(raw["committee_bits"])
+	if err != nil {
+		return errors.Wrap(err, "committee_bits")
+	}
+	if len(committeeBits) != 8 {
+		return errors.New("committee_bits: incorrect length")
+	}
+	a.CommitteeBits = bitfield.Bitvector64(committeeBits)
+
+	return nil
+}
+
+// unmarshalHexBytes unmarshals a JSON hex string in to a byte slice.
+func unmarshalHexBytes(input []byte) ([]byte, error) {
+	var data string
+	if err := json.Unmarshal(input, &data); err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(data, "0x") {
+		return nil, errors.New("invalid prefix")
+	}
+	res, err := hex.DecodeString(strings.TrimPrefix(data, "0x"))
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
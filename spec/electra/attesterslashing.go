@@ -0,0 +1,36 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra
+
+import (
+	"fmt"
+
+	"github.com/goccy/go-yaml"
+)
+
+// AttesterSlashing provides meta-data for an attester slashing.
+type AttesterSlashing struct {
+	Attestation1 *IndexedAttestation
+	Attestation2 *IndexedAttestation
+}
+
+// String returns a string version of the structure.
+func (a *AttesterSlashing) String() string {
+	data, err := yaml.Marshal(a)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
@@ -0,0 +1,141 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: 24c64477d3f8092313ecc702f3f14a341820c87c8b45b7574d86ec07e20b8e60
+// Version: 0.1.3
+package electra
+
+import (
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// MarshalSSZ ssz marshals the AttesterSlashing object
+func (a *AttesterSlashing) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(a)
+}
+
+// MarshalSSZTo ssz marshals the AttesterSlashing object to a target array
+func (a *AttesterSlashing) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(8)
+
+	// Offset (0) 'Attestation1'
+	dst = ssz.WriteOffset(dst, offset)
+	if a.Attestation1 == nil {
+		a.Attestation1 = new(IndexedAttestation)
+	}
+	offset += a.Attestation1.SizeSSZ()
+
+	// Offset (1) 'Attestation2'
+	dst = ssz.WriteOffset(dst, offset)
+	if a.Attestation2 == nil {
+		a.Attestation2 = new(IndexedAttestation)
+	}
+	offset += a.Attestation2.SizeSSZ()
+
+	// Field (0) 'Attestation1'
+	if dst, err = a.Attestation1.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Attestation2'
+	if dst, err = a.Attestation2.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the AttesterSlashing object
+func (a *AttesterSlashing) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 8 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1 uint64
+
+	// Offset (0) 'Attestation1'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 8 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'Attestation2'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Field (0) 'Attestation1'
+	{
+		buf = tail[o0:o1]
+		if a.Attestation1 == nil {
+			a.Attestation1 = new(IndexedAttestation)
+		}
+		if err = a.Attestation1.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'Attestation2'
+	{
+		buf = tail[o1:]
+		if a.Attestation2 == nil {
+			a.Attestation2 = new(IndexedAttestation)
+		}
+		if err = a.Attestation2.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the AttesterSlashing object
+func (a *AttesterSlashing) SizeSSZ() (size int) {
+	size = 8
+
+	// Field (0) 'Attestation1'
+	if a.Attestation1 == nil {
+		a.Attestation1 = new(IndexedAttestation)
+	}
+	size += a.Attestation1.SizeSSZ()
+
+	// Field (1) 'Attestation2'
+	if a.Attestation2 == nil {
+		a.Attestation2 = new(IndexedAttestation)
+	}
+	size += a.Attestation2.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the AttesterSlashing object
+func (a *AttesterSlashing) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(a)
+}
+
+// HashTreeRootWith ssz hashes the AttesterSlashing object with a hasher
+func (a *AttesterSlashing) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Attestation1'
+	if err = a.Attestation1.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Attestation2'
+	if err = a.Attestation2.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the AttesterSlashing object
+func (a *AttesterSlashing) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(a)
+}
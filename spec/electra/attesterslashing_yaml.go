@@ -0,0 +1,57 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/goccy/go-yaml"
+	"github.com/pkg/errors"
+)
+
+// attesterSlashingYAML is the spec representation of the struct.
+type attesterSlashingYAML struct {
+	Attestation1 *IndexedAttestation `yaml:"attestation_1"`
+	Attestation2 *IndexedAttestation `yaml:"attestation_2"`
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (a *AttesterSlashing) MarshalYAML() ([]byte, error) {
+	yamlBytes, err := yaml.MarshalWithOptions(&attesterSlashingYAML{
+		Attestation1: a.Attestation1,
+		Attestation2: a.Attestation2,
+	}, yaml.Flow(true))
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.ReplaceAll(yamlBytes, []byte(`"`), []byte(`'`)), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (a *AttesterSlashing) UnmarshalYAML(input []byte) error {
+	// This is very inefficient, but YAML is only used for spec tests so we do this
+	// rather than maintain a custom YAML unmarshaller.
+	var data attesterSlashingJSON
+	if err := yaml.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "failed to unmarshal YAML")
+	}
+	bytes, err := json.Marshal(data)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal JSON")
+	}
+
+	return a.UnmarshalJSON(bytes)
+}
@@ -0,0 +1,151 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/goccy/go-yaml"
+	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestConsolidationRequestJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "SourceAddressMissing",
+			input: []byte(`{"source_pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","target_pubkey":"0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b"}`),
+			err:   "source_address: missing",
+		},
+		{
+			name:  "SourceAddressWrongType",
+			input: []byte(`{"source_address":true,"source_pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","target_pubkey":"0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b"}`),
+			err:   "source_address: invalid prefix",
+		},
+		{
+			name:  "SourceAddressShort",
+			input: []byte(`{"source_address":"0x58E809C71e4885cB7B3f1D5c793AB04eD239d7","source_pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","target_pubkey":"0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b"}`),
+			err:   "source_address: incorrect length",
+		},
+		{
+			name:  "SourcePubkeyMissing",
+			input: []byte(`{"source_address":"0x58E809C71e4885cB7B3f1D5c793AB04eD239d779","target_pubkey":"0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b"}`),
+			err:   "source_pubkey: missing",
+		},
+		{
+			name:  "SourcePubkeyWrongType",
+			input: []byte(`{"source_address":"0x58E809C71e4885cB7B3f1D5c793AB04eD239d779","source_pubkey":true,"target_pubkey":"0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b"}`),
+			err:   "source_pubkey: invalid prefix",
+		},
+		{
+			name:  "SourcePubkeyShort",
+			input: []byte(`{"source_address":"0x58E809C71e4885cB7B3f1D5c793AB04eD239d779","source_pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e4","target_pubkey":"0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b"}`),
+			err:   "source_pubkey: incorrect length",
+		},
+		{
+			name:  "TargetPubkeyMissing",
+			input: []byte(`{"source_address":"0x58E809C71e4885cB7B3f1D5c793AB04eD239d779","source_pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c"}`),
+			err:   "target_pubkey: missing",
+		},
+		{
+			name:  "TargetPubkeyWrongType",
+			input: []byte(`{"source_address":"0x58E809C71e4885cB7B3f1D5c793AB04eD239d779","source_pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","target_pubkey":true}`),
+			err:   "target_pubkey: invalid prefix",
+		},
+		{
+			name:  "TargetPubkeyShort",
+			input: []byte(`{"source_address":"0x58E809C71e4885cB7B3f1D5c793AB04eD239d779","source_pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","target_pubkey":"0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a"}`),
+			err:   "target_pubkey: incorrect length",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"source_address":"0x58E809C71e4885cB7B3f1D5c793AB04eD239d779","source_pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","target_pubkey":"0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res electra.ConsolidationRequest
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
+
+func TestConsolidationRequestYAML(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "Good",
+			input: []byte(`{source_address: '0x58E809C71e4885cB7B3f1D5c793AB04eD239d779', source_pubkey: '0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c', target_pubkey: '0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b'}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res electra.ConsolidationRequest
+			err := yaml.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := yaml.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(rt), res.String())
+				rt = bytes.TrimSuffix(rt, []byte("\n"))
+				assert.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
+
+func TestConsolidationRequestSSZ(t *testing.T) {
+	var res electra.ConsolidationRequest
+	require.NoError(t, yaml.Unmarshal([]byte(`{source_address: '0x58E809C71e4885cB7B3f1D5c793AB04eD239d779', source_pubkey: '0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c', target_pubkey: '0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b'}`), &res))
+
+	data, err := res.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, res.SizeSSZ())
+
+	var rt electra.ConsolidationRequest
+	require.NoError(t, rt.UnmarshalSSZ(data))
+	require.Equal(t, res, rt)
+
+	root, err := res.HashTreeRoot()
+	require.NoError(t, err)
+	rtRoot, err := rt.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, root, rtRoot)
+}
@@ -0,0 +1,89 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// depositRequestJSON is the spec representation of the struct.
+type depositRequestJSON struct {
+	Pubkey                phase0.BLSPubKey    `json:"pubkey"`
+	WithdrawalCredentials string              `json:"withdrawal_credentials"`
+	Amount                string              `json:"amount"`
+	Signature             phase0.BLSSignature `json:"signature"`
+	Index                 string              `json:"index"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d *DepositRequest) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&depositRequestJSON{
+		Pubkey:                d.Pubkey,
+		WithdrawalCredentials: fmt.Sprintf("%#x", d.WithdrawalCredentials),
+		Amount:                fmt.Sprintf("%d", d.Amount),
+		Signature:             d.Signature,
+		Index:                 strconv.FormatUint(d.Index, 10),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *DepositRequest) UnmarshalJSON(input []byte) error {
+	raw, err := codecs.RawJSON(&depositRequestJSON{}, input)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Pubkey.UnmarshalJSON(raw["pubkey"]); err != nil {
+		return errors.Wrap(err, "pubkey")
+	}
+
+	withdrawalCredentials := raw["withdrawal_credentials"]
+	if !bytes.HasPrefix(withdrawalCredentials, []byte{'"', '0', 'x'}) {
+		return errors.New("withdrawal_credentials: invalid prefix")
+	}
+	if !bytes.HasSuffix(withdrawalCredentials, []byte{'"'}) {
+		return errors.New("withdrawal_credentials: invalid suffix")
+	}
+	if len(withdrawalCredentials) != 1+2+32*2+1 {
+		return errors.New("withdrawal_credentials: incorrect length")
+	}
+	d.WithdrawalCredentials = make([]byte, 32)
+	if _, err := hex.Decode(d.WithdrawalCredentials, withdrawalCredentials[3:3+32*2]); err != nil {
+		return errors.Wrap(err, "withdrawal_credentials")
+	}
+
+	if err := d.Amount.UnmarshalJSON(raw["amount"]); err != nil {
+		return errors.Wrap(err, "amount")
+	}
+
+	if err := d.Signature.UnmarshalJSON(raw["signature"]); err != nil {
+		return errors.Wrap(err, "signature")
+	}
+
+	tmpUint, err := strconv.ParseUint(string(bytes.Trim(raw["index"], `"`)), 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "index")
+	}
+	d.Index = tmpUint
+
+	return nil
+}
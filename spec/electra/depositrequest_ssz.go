@@ -0,0 +1,112 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: 24c64477d3f8092313ecc702f3f14a341820c87c8b45b7574d86ec07e20b8e60
+// Version: 0.1.3
+package electra
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// MarshalSSZ ssz marshals the DepositRequest object
+func (d *DepositRequest) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(d)
+}
+
+// MarshalSSZTo ssz marshals the DepositRequest object to a target array
+func (d *DepositRequest) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Pubkey'
+	dst = append(dst, d.Pubkey[:]...)
+
+	// Field (1) 'WithdrawalCredentials'
+	if size := len(d.WithdrawalCredentials); size != 32 {
+		err = ssz.ErrBytesLengthFn("DepositRequest.WithdrawalCredentials", size, 32)
+		return
+	}
+	dst = append(dst, d.WithdrawalCredentials...)
+
+	// Field (2) 'Amount'
+	dst = ssz.MarshalUint64(dst, uint64(d.Amount))
+
+	// Field (3) 'Signature'
+	dst = append(dst, d.Signature[:]...)
+
+	// Field (4) 'Index'
+	dst = ssz.MarshalUint64(dst, d.Index)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the DepositRequest object
+func (d *DepositRequest) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 192 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Pubkey'
+	copy(d.Pubkey[:], buf[0:48])
+
+	// Field (1) 'WithdrawalCredentials'
+	if cap(d.WithdrawalCredentials) == 0 {
+		d.WithdrawalCredentials = make([]byte, 0, len(buf[48:80]))
+	}
+	d.WithdrawalCredentials = append(d.WithdrawalCredentials, buf[48:80]...)
+
+	// Field (2) 'Amount'
+	d.Amount = phase0.Gwei(ssz.UnmarshallUint64(buf[80:88]))
+
+	// Field (3) 'Signature'
+	copy(d.Signature[:], buf[88:184])
+
+	// Field (4) 'Index'
+	d.Index = ssz.UnmarshallUint64(buf[184:192])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the DepositRequest object
+func (d *DepositRequest) SizeSSZ() (size int) {
+	size = 192
+	return
+}
+
+// HashTreeRoot ssz hashes the DepositRequest object
+func (d *DepositRequest) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(d)
+}
+
+// HashTreeRootWith ssz hashes the DepositRequest object with a hasher
+func (d *DepositRequest) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Pubkey'
+	hh.PutBytes(d.Pubkey[:])
+
+	// Field (1) 'WithdrawalCredentials'
+	if size := len(d.WithdrawalCredentials); size != 32 {
+		err = ssz.ErrBytesLengthFn("DepositRequest.WithdrawalCredentials", size, 32)
+		return
+	}
+	hh.PutBytes(d.WithdrawalCredentials)
+
+	// Field (2) 'Amount'
+	hh.PutUint64(uint64(d.Amount))
+
+	// Field (3) 'Signature'
+	hh.PutBytes(d.Signature[:])
+
+	// Field (4) 'Index'
+	hh.PutUint64(d.Index)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the DepositRequest object
+func (d *DepositRequest) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(d)
+}
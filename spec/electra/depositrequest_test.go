@@ -0,0 +1,171 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/goccy/go-yaml"
+	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestDepositRequestJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "PubkeyMissing",
+			input: []byte(`{"withdrawal_credentials":"0x813b05d7c10dc4bdf45201a3539ec805ff4e016fbadd98a8b24cbf1f428ec799","amount":"32000000000","signature":"0xb7a757120b7b4bb1ac9d7f9b67a2c1f9bb1e9adc4a1ff3b8ca8e8b2b3a7e90f6a7f3a0d55f2ab1b6f8a5c6fd5cd1e0b61107c9a13f2eb6e6e0c8aef9b6e3c8f1e7c1b1a2b5d0e3f4a6b8c9d0e1f2a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4","index":"12345"}`),
+			err:   "pubkey: missing",
+		},
+		{
+			name:  "PubkeyWrongType",
+			input: []byte(`{"pubkey":true,"withdrawal_credentials":"0x813b05d7c10dc4bdf45201a3539ec805ff4e016fbadd98a8b24cbf1f428ec799","amount":"32000000000","signature":"0xb7a757120b7b4bb1ac9d7f9b67a2c1f9bb1e9adc4a1ff3b8ca8e8b2b3a7e90f6a7f3a0d55f2ab1b6f8a5c6fd5cd1e0b61107c9a13f2eb6e6e0c8aef9b6e3c8f1e7c1b1a2b5d0e3f4a6b8c9d0e1f2a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4","index":"12345"}`),
+			err:   "pubkey: invalid prefix",
+		},
+		{
+			name:  "PubkeyShort",
+			input: []byte(`{"pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e4","withdrawal_credentials":"0x813b05d7c10dc4bdf45201a3539ec805ff4e016fbadd98a8b24cbf1f428ec799","amount":"32000000000","signature":"0xb7a757120b7b4bb1ac9d7f9b67a2c1f9bb1e9adc4a1ff3b8ca8e8b2b3a7e90f6a7f3a0d55f2ab1b6f8a5c6fd5cd1e0b61107c9a13f2eb6e6e0c8aef9b6e3c8f1e7c1b1a2b5d0e3f4a6b8c9d0e1f2a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4","index":"12345"}`),
+			err:   "pubkey: incorrect length",
+		},
+		{
+			name:  "WithdrawalCredentialsMissing",
+			input: []byte(`{"pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","amount":"32000000000","signature":"0xb7a757120b7b4bb1ac9d7f9b67a2c1f9bb1e9adc4a1ff3b8ca8e8b2b3a7e90f6a7f3a0d55f2ab1b6f8a5c6fd5cd1e0b61107c9a13f2eb6e6e0c8aef9b6e3c8f1e7c1b1a2b5d0e3f4a6b8c9d0e1f2a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4","index":"12345"}`),
+			err:   "withdrawal_credentials: missing",
+		},
+		{
+			name:  "WithdrawalCredentialsWrongType",
+			input: []byte(`{"pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","withdrawal_credentials":true,"amount":"32000000000","signature":"0xb7a757120b7b4bb1ac9d7f9b67a2c1f9bb1e9adc4a1ff3b8ca8e8b2b3a7e90f6a7f3a0d55f2ab1b6f8a5c6fd5cd1e0b61107c9a13f2eb6e6e0c8aef9b6e3c8f1e7c1b1a2b5d0e3f4a6b8c9d0e1f2a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4","index":"12345"}`),
+			err:   "withdrawal_credentials: invalid prefix",
+		},
+		{
+			name:  "WithdrawalCredentialsShort",
+			input: []byte(`{"pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","withdrawal_credentials":"0x813b05d7c10dc4bdf45201a3539ec805ff4e016fbadd98a8b24cbf1f428ec7","amount":"32000000000","signature":"0xb7a757120b7b4bb1ac9d7f9b67a2c1f9bb1e9adc4a1ff3b8ca8e8b2b3a7e90f6a7f3a0d55f2ab1b6f8a5c6fd5cd1e0b61107c9a13f2eb6e6e0c8aef9b6e3c8f1e7c1b1a2b5d0e3f4a6b8c9d0e1f2a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4","index":"12345"}`),
+			err:   "withdrawal_credentials: incorrect length",
+		},
+		{
+			name:  "AmountMissing",
+			input: []byte(`{"pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","withdrawal_credentials":"0x813b05d7c10dc4bdf45201a3539ec805ff4e016fbadd98a8b24cbf1f428ec799","signature":"0xb7a757120b7b4bb1ac9d7f9b67a2c1f9bb1e9adc4a1ff3b8ca8e8b2b3a7e90f6a7f3a0d55f2ab1b6f8a5c6fd5cd1e0b61107c9a13f2eb6e6e0c8aef9b6e3c8f1e7c1b1a2b5d0e3f4a6b8c9d0e1f2a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4","index":"12345"}`),
+			err:   "amount: missing",
+		},
+		{
+			name:  "AmountWrongType",
+			input: []byte(`{"pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","withdrawal_credentials":"0x813b05d7c10dc4bdf45201a3539ec805ff4e016fbadd98a8b24cbf1f428ec799","amount":true,"signature":"0xb7a757120b7b4bb1ac9d7f9b67a2c1f9bb1e9adc4a1ff3b8ca8e8b2b3a7e90f6a7f3a0d55f2ab1b6f8a5c6fd5cd1e0b61107c9a13f2eb6e6e0c8aef9b6e3c8f1e7c1b1a2b5d0e3f4a6b8c9d0e1f2a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4","index":"12345"}`),
+			err:   "amount: invalid prefix",
+		},
+		{
+			name:  "SignatureMissing",
+			input: []byte(`{"pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","withdrawal_credentials":"0x813b05d7c10dc4bdf45201a3539ec805ff4e016fbadd98a8b24cbf1f428ec799","amount":"32000000000","index":"12345"}`),
+			err:   "signature: missing",
+		},
+		{
+			name:  "SignatureWrongType",
+			input: []byte(`{"pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","withdrawal_credentials":"0x813b05d7c10dc4bdf45201a3539ec805ff4e016fbadd98a8b24cbf1f428ec799","amount":"32000000000","signature":true,"index":"12345"}`),
+			err:   "signature: invalid prefix",
+		},
+		{
+			name:  "SignatureShort",
+			input: []byte(`{"pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","withdrawal_credentials":"0x813b05d7c10dc4bdf45201a3539ec805ff4e016fbadd98a8b24cbf1f428ec799","amount":"32000000000","signature":"0xb7a757120b7b4bb1ac9d7f9b67a2c1f9bb1e9adc4a1ff3b8ca8e8b2b3a7e90f6a7f3a0d55f2ab1b6f8a5c6fd5cd1e0b61107c9a13f2eb6e6e0c8aef9b6e3c8f1e7c1b1a2b5d0e3f4a6b8c9d0e1f2a3b4c5d6e7f8091a2b3c4d5e6f708192a3","index":"12345"}`),
+			err:   "signature: incorrect length",
+		},
+		{
+			name:  "IndexMissing",
+			input: []byte(`{"pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","withdrawal_credentials":"0x813b05d7c10dc4bdf45201a3539ec805ff4e016fbadd98a8b24cbf1f428ec799","amount":"32000000000","signature":"0xb7a757120b7b4bb1ac9d7f9b67a2c1f9bb1e9adc4a1ff3b8ca8e8b2b3a7e90f6a7f3a0d55f2ab1b6f8a5c6fd5cd1e0b61107c9a13f2eb6e6e0c8aef9b6e3c8f1e7c1b1a2b5d0e3f4a6b8c9d0e1f2a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4"}`),
+			err:   "index: missing",
+		},
+		{
+			name:  "IndexWrongType",
+			input: []byte(`{"pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","withdrawal_credentials":"0x813b05d7c10dc4bdf45201a3539ec805ff4e016fbadd98a8b24cbf1f428ec799","amount":"32000000000","signature":"0xb7a757120b7b4bb1ac9d7f9b67a2c1f9bb1e9adc4a1ff3b8ca8e8b2b3a7e90f6a7f3a0d55f2ab1b6f8a5c6fd5cd1e0b61107c9a13f2eb6e6e0c8aef9b6e3c8f1e7c1b1a2b5d0e3f4a6b8c9d0e1f2a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4","index":true}`),
+			err:   "index: strconv.ParseUint: parsing \"true\": invalid syntax",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","withdrawal_credentials":"0x813b05d7c10dc4bdf45201a3539ec805ff4e016fbadd98a8b24cbf1f428ec799","amount":"32000000000","signature":"0xb7a757120b7b4bb1ac9d7f9b67a2c1f9bb1e9adc4a1ff3b8ca8e8b2b3a7e90f6a7f3a0d55f2ab1b6f8a5c6fd5cd1e0b61107c9a13f2eb6e6e0c8aef9b6e3c8f1e7c1b1a2b5d0e3f4a6b8c9d0e1f2a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4","index":"12345"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res electra.DepositRequest
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
+
+func TestDepositRequestYAML(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "Good",
+			input: []byte(`{pubkey: '0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c', withdrawal_credentials: '0x813b05d7c10dc4bdf45201a3539ec805ff4e016fbadd98a8b24cbf1f428ec799', amount: 32000000000, signature: '0xb7a757120b7b4bb1ac9d7f9b67a2c1f9bb1e9adc4a1ff3b8ca8e8b2b3a7e90f6a7f3a0d55f2ab1b6f8a5c6fd5cd1e0b61107c9a13f2eb6e6e0c8aef9b6e3c8f1e7c1b1a2b5d0e3f4a6b8c9d0e1f2a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4', index: 12345}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res electra.DepositRequest
+			err := yaml.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := yaml.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(rt), res.String())
+				rt = bytes.TrimSuffix(rt, []byte("\n"))
+				assert.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
+
+func TestDepositRequestSSZ(t *testing.T) {
+	var res electra.DepositRequest
+	require.NoError(t, yaml.Unmarshal([]byte(`{pubkey: '0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c', withdrawal_credentials: '0x813b05d7c10dc4bdf45201a3539ec805ff4e016fbadd98a8b24cbf1f428ec799', amount: 32000000000, signature: '0xb7a757120b7b4bb1ac9d7f9b67a2c1f9bb1e9adc4a1ff3b8ca8e8b2b3a7e90f6a7f3a0d55f2ab1b6f8a5c6fd5cd1e0b61107c9a13f2eb6e6e0c8aef9b6e3c8f1e7c1b1a2b5d0e3f4a6b8c9d0e1f2a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4', index: 12345}`), &res))
+
+	data, err := res.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, res.SizeSSZ())
+
+	var rt electra.DepositRequest
+	require.NoError(t, rt.UnmarshalSSZ(data))
+	require.Equal(t, res, rt)
+
+	root, err := res.HashTreeRoot()
+	require.NoError(t, err)
+	rtRoot, err := rt.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, root, rtRoot)
+}
@@ -0,0 +1,74 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: 24c64477d3f8092313ecc702f3f14a341820c87c8b45b7574d86ec07e20b8e60
+// Version: 0.1.3
+package electra
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// MarshalSSZ ssz marshals the PendingBalanceDeposit object
+func (p *PendingBalanceDeposit) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(p)
+}
+
+// MarshalSSZTo ssz marshals the PendingBalanceDeposit object to a target array
+func (p *PendingBalanceDeposit) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Index'
+	dst = ssz.MarshalUint64(dst, uint64(p.Index))
+
+	// Field (1) 'Amount'
+	dst = ssz.MarshalUint64(dst, uint64(p.Amount))
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the PendingBalanceDeposit object
+func (p *PendingBalanceDeposit) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 16 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Index'
+	p.Index = phase0.ValidatorIndex(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'Amount'
+	p.Amount = phase0.Gwei(ssz.UnmarshallUint64(buf[8:16]))
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the PendingBalanceDeposit object
+func (p *PendingBalanceDeposit) SizeSSZ() (size int) {
+	size = 16
+	return
+}
+
+// HashTreeRoot ssz hashes the PendingBalanceDeposit object
+func (p *PendingBalanceDeposit) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(p)
+}
+
+// HashTreeRootWith ssz hashes the PendingBalanceDeposit object with a hasher
+func (p *PendingBalanceDeposit) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Index'
+	hh.PutUint64(uint64(p.Index))
+
+	// Field (1) 'Amount'
+	hh.PutUint64(uint64(p.Amount))
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the PendingBalanceDeposit object
+func (p *PendingBalanceDeposit) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(p)
+}
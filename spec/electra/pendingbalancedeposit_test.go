@@ -0,0 +1,126 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/goccy/go-yaml"
+	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestPendingBalanceDepositJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "IndexMissing",
+			input: []byte(`{"amount":"32000000000"}`),
+			err:   "index: missing",
+		},
+		{
+			name:  "IndexWrongType",
+			input: []byte(`{"index":true,"amount":"32000000000"}`),
+			err:   "index: invalid prefix",
+		},
+		{
+			name:  "AmountMissing",
+			input: []byte(`{"index":"12345"}`),
+			err:   "amount: missing",
+		},
+		{
+			name:  "AmountWrongType",
+			input: []byte(`{"index":"12345","amount":true}`),
+			err:   "amount: invalid prefix",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"index":"12345","amount":"32000000000"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res electra.PendingBalanceDeposit
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
+
+func TestPendingBalanceDepositYAML(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "Good",
+			input: []byte(`{index: 12345, amount: 32000000000}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res electra.PendingBalanceDeposit
+			err := yaml.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := yaml.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(rt), res.String())
+				rt = bytes.TrimSuffix(rt, []byte("\n"))
+				assert.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
+
+func TestPendingBalanceDepositSSZ(t *testing.T) {
+	var res electra.PendingBalanceDeposit
+	require.NoError(t, yaml.Unmarshal([]byte(`{index: 12345, amount: 32000000000}`), &res))
+
+	data, err := res.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, res.SizeSSZ())
+
+	var rt electra.PendingBalanceDeposit
+	require.NoError(t, rt.UnmarshalSSZ(data))
+	require.Equal(t, res, rt)
+
+	root, err := res.HashTreeRoot()
+	require.NoError(t, err)
+	rtRoot, err := rt.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, root, rtRoot)
+}
@@ -0,0 +1,126 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/goccy/go-yaml"
+	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestPendingConsolidationJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "SourceIndexMissing",
+			input: []byte(`{"target_index":"23456"}`),
+			err:   "source_index: missing",
+		},
+		{
+			name:  "SourceIndexWrongType",
+			input: []byte(`{"source_index":true,"target_index":"23456"}`),
+			err:   "source_index: invalid prefix",
+		},
+		{
+			name:  "TargetIndexMissing",
+			input: []byte(`{"source_index":"12345"}`),
+			err:   "target_index: missing",
+		},
+		{
+			name:  "TargetIndexWrongType",
+			input: []byte(`{"source_index":"12345","target_index":true}`),
+			err:   "target_index: invalid prefix",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"source_index":"12345","target_index":"23456"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res electra.PendingConsolidation
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
+
+func TestPendingConsolidationYAML(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "Good",
+			input: []byte(`{source_index: 12345, target_index: 23456}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res electra.PendingConsolidation
+			err := yaml.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := yaml.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(rt), res.String())
+				rt = bytes.TrimSuffix(rt, []byte("\n"))
+				assert.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
+
+func TestPendingConsolidationSSZ(t *testing.T) {
+	var res electra.PendingConsolidation
+	require.NoError(t, yaml.Unmarshal([]byte(`{source_index: 12345, target_index: 23456}`), &res))
+
+	data, err := res.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, res.SizeSSZ())
+
+	var rt electra.PendingConsolidation
+	require.NoError(t, rt.UnmarshalSSZ(data))
+	require.Equal(t, res, rt)
+
+	root, err := res.HashTreeRoot()
+	require.NoError(t, err)
+	rtRoot, err := rt.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, root, rtRoot)
+}
@@ -0,0 +1,59 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/goccy/go-yaml"
+	"github.com/pkg/errors"
+)
+
+// pendingPartialWithdrawalYAML is the spec representation of the struct.
+type pendingPartialWithdrawalYAML struct {
+	Index             uint64 `yaml:"index"`
+	Amount            uint64 `yaml:"amount"`
+	WithdrawableEpoch uint64 `yaml:"withdrawable_epoch"`
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (p *PendingPartialWithdrawal) MarshalYAML() ([]byte, error) {
+	yamlBytes, err := yaml.MarshalWithOptions(&pendingPartialWithdrawalYAML{
+		Index:             uint64(p.Index),
+		Amount:            uint64(p.Amount),
+		WithdrawableEpoch: uint64(p.WithdrawableEpoch),
+	}, yaml.Flow(true))
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.ReplaceAll(yamlBytes, []byte(`"`), []byte(`'`)), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (p *PendingPartialWithdrawal) UnmarshalYAML(input []byte) error {
+	// This is very inefficient, but YAML is only used for spec tests so we do this
+	// rather than maintain a custom YAML unmarshaller.
+	var data pendingPartialWithdrawalJSON
+	if err := yaml.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "failed to unmarshal YAML")
+	}
+	bytes, err := json.Marshal(data)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal JSON")
+	}
+
+	return p.UnmarshalJSON(bytes)
+}
@@ -0,0 +1,146 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/goccy/go-yaml"
+	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestWithdrawalRequestJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "SourceAddressMissing",
+			input: []byte(`{"validator_pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","amount":"1000000000"}`),
+			err:   "source_address: missing",
+		},
+		{
+			name:  "SourceAddressWrongType",
+			input: []byte(`{"source_address":true,"validator_pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","amount":"1000000000"}`),
+			err:   "source_address: invalid prefix",
+		},
+		{
+			name:  "SourceAddressShort",
+			input: []byte(`{"source_address":"0x58E809C71e4885cB7B3f1D5c793AB04eD239d7","validator_pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","amount":"1000000000"}`),
+			err:   "source_address: incorrect length",
+		},
+		{
+			name:  "ValidatorPubkeyMissing",
+			input: []byte(`{"source_address":"0x58E809C71e4885cB7B3f1D5c793AB04eD239d779","amount":"1000000000"}`),
+			err:   "validator_pubkey: missing",
+		},
+		{
+			name:  "ValidatorPubkeyWrongType",
+			input: []byte(`{"source_address":"0x58E809C71e4885cB7B3f1D5c793AB04eD239d779","validator_pubkey":true,"amount":"1000000000"}`),
+			err:   "validator_pubkey: invalid prefix",
+		},
+		{
+			name:  "ValidatorPubkeyShort",
+			input: []byte(`{"source_address":"0x58E809C71e4885cB7B3f1D5c793AB04eD239d779","validator_pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e4","amount":"1000000000"}`),
+			err:   "validator_pubkey: incorrect length",
+		},
+		{
+			name:  "AmountMissing",
+			input: []byte(`{"source_address":"0x58E809C71e4885cB7B3f1D5c793AB04eD239d779","validator_pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c"}`),
+			err:   "amount: missing",
+		},
+		{
+			name:  "AmountWrongType",
+			input: []byte(`{"source_address":"0x58E809C71e4885cB7B3f1D5c793AB04eD239d779","validator_pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","amount":true}`),
+			err:   "amount: invalid prefix",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"source_address":"0x58E809C71e4885cB7B3f1D5c793AB04eD239d779","validator_pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","amount":"1000000000"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res electra.WithdrawalRequest
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
+
+func TestWithdrawalRequestYAML(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "Good",
+			input: []byte(`{source_address: '0x58E809C71e4885cB7B3f1D5c793AB04eD239d779', validator_pubkey: '0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c', amount: 1000000000}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res electra.WithdrawalRequest
+			err := yaml.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := yaml.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(rt), res.String())
+				rt = bytes.TrimSuffix(rt, []byte("\n"))
+				assert.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
+
+func TestWithdrawalRequestSSZ(t *testing.T) {
+	var res electra.WithdrawalRequest
+	require.NoError(t, yaml.Unmarshal([]byte(`{source_address: '0x58E809C71e4885cB7B3f1D5c793AB04eD239d779', validator_pubkey: '0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c', amount: 1000000000}`), &res))
+
+	data, err := res.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, res.SizeSSZ())
+
+	var rt electra.WithdrawalRequest
+	require.NoError(t, rt.UnmarshalSSZ(data))
+	require.Equal(t, res, rt)
+
+	root, err := res.HashTreeRoot()
+	require.NoError(t, err)
+	rtRoot, err := rt.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, root, rtRoot)
+}
@@ -0,0 +1,316 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+import (
+	"errors"
+
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+)
+
+// VersionedAttestation contains a versioned attestation.
+type VersionedAttestation struct {
+	Version   DataVersion
+	Phase0    *phase0.Attestation
+	Altair    *phase0.Attestation
+	Bellatrix *phase0.Attestation
+	Capella   *phase0.Attestation
+	Deneb     *phase0.Attestation
+	Electra   *electra.Attestation
+}
+
+// IsEmpty returns true if there is no attestation.
+func (v *VersionedAttestation) IsEmpty() bool {
+	return v.Phase0 == nil && v.Altair == nil && v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil && v.Electra == nil
+}
+
+// AggregationBits returns the aggregation bits of the attestation.
+func (v *VersionedAttestation) AggregationBits() (bitfield.Bitlist, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return nil, errors.New("no phase0 attestation")
+		}
+
+		return v.Phase0.AggregationBits, nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no altair attestation")
+		}
+
+		return v.Altair.AggregationBits, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no bellatrix attestation")
+		}
+
+		return v.Bellatrix.AggregationBits, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no capella attestation")
+		}
+
+		return v.Capella.AggregationBits, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no deneb attestation")
+		}
+
+		return v.Deneb.AggregationBits, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no electra attestation")
+		}
+
+		return v.Electra.AggregationBits, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// Data returns the data of the attestation.
+func (v *VersionedAttestation) Data() (*phase0.AttestationData, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return nil, errors.New("no phase0 attestation")
+		}
+
+		return v.Phase0.Data, nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no altair attestation")
+		}
+
+		return v.Altair.Data, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no bellatrix attestation")
+		}
+
+		return v.Bellatrix.Data, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no capella attestation")
+		}
+
+		return v.Capella.Data, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no deneb attestation")
+		}
+
+		return v.Deneb.Data, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no electra attestation")
+		}
+
+		return v.Electra.Data, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// CommitteeBits returns the committee bits of the attestation.
+// Attestations prior to Electra do not have committee bits.
+func (v *VersionedAttestation) CommitteeBits() (bitfield.Bitvector64, error) {
+	switch v.Version {
+	case DataVersionPhase0, DataVersionAltair, DataVersionBellatrix, DataVersionCapella, DataVersionDeneb:
+		return nil, errors.New("attestation does not have committee bits")
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no electra attestation")
+		}
+
+		return v.Electra.CommitteeBits, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// CommitteeIndex returns the index of the committee that created the attestation.
+// Prior to Electra this is held in the attestation data, and from Electra onwards
+// it is the single bit set in the committee bits.
+func (v *VersionedAttestation) CommitteeIndex() (phase0.CommitteeIndex, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil || v.Phase0.Data == nil {
+			return 0, errors.New("no phase0 attestation")
+		}
+
+		return v.Phase0.Data.Index, nil
+	case DataVersionAltair:
+		if v.Altair == nil || v.Altair.Data == nil {
+			return 0, errors.New("no altair attestation")
+		}
+
+		return v.Altair.Data.Index, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.Data == nil {
+			return 0, errors.New("no bellatrix attestation")
+		}
+
+		return v.Bellatrix.Data.Index, nil
+	case DataVersionCapella:
+		if v.Capella == nil || v.Capella.Data == nil {
+			return 0, errors.New("no capella attestation")
+		}
+
+		return v.Capella.Data.Index, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.Data == nil {
+			return 0, errors.New("no deneb attestation")
+		}
+
+		return v.Deneb.Data.Index, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no electra attestation")
+		}
+		indices := v.Electra.CommitteeBits.BitIndices()
+		if len(indices) != 1 {
+			return 0, errors.New("attestation does not have a single committee bit set")
+		}
+
+		return phase0.CommitteeIndex(indices[0]), nil
+	default:
+		return 0, errors.New("unknown version")
+	}
+}
+
+// Signature returns the signature of the attestation.
+func (v *VersionedAttestation) Signature() (phase0.BLSSignature, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return phase0.BLSSignature{}, errors.New("no phase0 attestation")
+		}
+
+		return v.Phase0.Signature, nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return phase0.BLSSignature{}, errors.New("no altair attestation")
+		}
+
+		return v.Altair.Signature, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return phase0.BLSSignature{}, errors.New("no bellatrix attestation")
+		}
+
+		return v.Bellatrix.Signature, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return phase0.BLSSignature{}, errors.New("no capella attestation")
+		}
+
+		return v.Capella.Signature, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return phase0.BLSSignature{}, errors.New("no deneb attestation")
+		}
+
+		return v.Deneb.Signature, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.BLSSignature{}, errors.New("no electra attestation")
+		}
+
+		return v.Electra.Signature, nil
+	default:
+		return phase0.BLSSignature{}, errors.New("unknown version")
+	}
+}
+
+// String returns a string version of the structure.
+func (v *VersionedAttestation) String() string {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return ""
+		}
+
+		return v.Phase0.String()
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return ""
+		}
+
+		return v.Altair.String()
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return ""
+		}
+
+		return v.Bellatrix.String()
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return ""
+		}
+
+		return v.Capella.String()
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return ""
+		}
+
+		return v.Deneb.String()
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return ""
+		}
+
+		return v.Electra.String()
+	default:
+		return "unknown version"
+	}
+}
+
+// NewVersionedAttestations wraps a list of attestations from a pre-Electra block as versioned attestations.
+func NewVersionedAttestations(version DataVersion, attestations []*phase0.Attestation) []*VersionedAttestation {
+	res := make([]*VersionedAttestation, len(attestations))
+	for i := range attestations {
+		res[i] = &VersionedAttestation{Version: version}
+		switch version {
+		case DataVersionPhase0:
+			res[i].Phase0 = attestations[i]
+		case DataVersionAltair:
+			res[i].Altair = attestations[i]
+		case DataVersionBellatrix:
+			res[i].Bellatrix = attestations[i]
+		case DataVersionCapella:
+			res[i].Capella = attestations[i]
+		case DataVersionDeneb:
+			res[i].Deneb = attestations[i]
+		}
+	}
+
+	return res
+}
+
+// NewVersionedElectraAttestations wraps a list of attestations from an Electra block as versioned attestations.
+func NewVersionedElectraAttestations(attestations []*electra.Attestation) []*VersionedAttestation {
+	res := make([]*VersionedAttestation, len(attestations))
+	for i := range attestations {
+		res[i] = &VersionedAttestation{
+			Version: DataVersionElectra,
+			Electra: attestations[i],
+		}
+	}
+
+	return res
+}
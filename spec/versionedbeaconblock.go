@@ -20,6 +20,7 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
@@ -31,11 +32,12 @@ type VersionedBeaconBlock struct {
 	Bellatrix *bellatrix.BeaconBlock
 	Capella   *capella.BeaconBlock
 	Deneb     *deneb.BeaconBlock
+	Electra   *electra.BeaconBlock
 }
 
 // IsEmpty returns true if there is no block.
 func (v *VersionedBeaconBlock) IsEmpty() bool {
-	return v.Phase0 == nil && v.Altair == nil && v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil
+	return v.Phase0 == nil && v.Altair == nil && v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil && v.Electra == nil
 }
 
 // Slot returns the slot of the beacon block.
@@ -71,6 +73,12 @@ func (v *VersionedBeaconBlock) Slot() (phase0.Slot, error) {
 		}
 
 		return v.Deneb.Slot, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no electra block contents")
+		}
+
+		return v.Electra.Slot, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -124,6 +132,15 @@ func (v *VersionedBeaconBlock) RandaoReveal() (phase0.BLSSignature, error) {
 		}
 
 		return v.Deneb.Body.RANDAOReveal, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.BLSSignature{}, errors.New("no electra block contents")
+		}
+		if v.Electra.Body == nil {
+			return phase0.BLSSignature{}, errors.New("no electra block body")
+		}
+
+		return v.Electra.Body.RANDAOReveal, nil
 	default:
 		return phase0.BLSSignature{}, errors.New("unknown version")
 	}
@@ -177,6 +194,15 @@ func (v *VersionedBeaconBlock) Graffiti() ([32]byte, error) {
 		}
 
 		return v.Deneb.Body.Graffiti, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return [32]byte{}, errors.New("no electra block contents")
+		}
+		if v.Electra.Body == nil {
+			return [32]byte{}, errors.New("no electra block body")
+		}
+
+		return v.Electra.Body.Graffiti, nil
 	default:
 		return [32]byte{}, errors.New("unknown version")
 	}
@@ -215,6 +241,12 @@ func (v *VersionedBeaconBlock) ProposerIndex() (phase0.ValidatorIndex, error) {
 		}
 
 		return v.Deneb.ProposerIndex, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no electra block contents")
+		}
+
+		return v.Electra.ProposerIndex, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -253,6 +285,12 @@ func (v *VersionedBeaconBlock) Root() (phase0.Root, error) {
 		}
 
 		return v.Deneb.HashTreeRoot()
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, errors.New("no electra block contents")
+		}
+
+		return v.Electra.HashTreeRoot()
 	default:
 		return phase0.Root{}, errors.New("unknown version")
 	}
@@ -291,6 +329,12 @@ func (v *VersionedBeaconBlock) BodyRoot() (phase0.Root, error) {
 		}
 
 		return v.Deneb.Body.HashTreeRoot()
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, errors.New("no electra block contents")
+		}
+
+		return v.Electra.Body.HashTreeRoot()
 	default:
 		return phase0.Root{}, errors.New("unknown version")
 	}
@@ -329,6 +373,12 @@ func (v *VersionedBeaconBlock) ParentRoot() (phase0.Root, error) {
 		}
 
 		return v.Deneb.ParentRoot, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, errors.New("no electra block contents")
+		}
+
+		return v.Electra.ParentRoot, nil
 	default:
 		return phase0.Root{}, errors.New("unknown version")
 	}
@@ -367,12 +417,19 @@ func (v *VersionedBeaconBlock) StateRoot() (phase0.Root, error) {
 		}
 
 		return v.Deneb.StateRoot, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, errors.New("no electra block contents")
+		}
+
+		return v.Electra.StateRoot, nil
 	default:
 		return phase0.Root{}, errors.New("unknown version")
 	}
 }
 
 // Attestations returns the attestations of the beacon block.
+// Electra attestations are not available through this function; use VersionedAttestations() instead.
 func (v *VersionedBeaconBlock) Attestations() ([]*phase0.Attestation, error) {
 	switch v.Version {
 	case DataVersionPhase0:
@@ -405,12 +462,59 @@ func (v *VersionedBeaconBlock) Attestations() ([]*phase0.Attestation, error) {
 		}
 
 		return v.Deneb.Body.Attestations, nil
+	case DataVersionElectra:
+		return nil, errors.New("electra attestations not available; use VersionedAttestations()")
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// VersionedAttestations returns the versioned attestations of the beacon block.
+func (v *VersionedBeaconBlock) VersionedAttestations() ([]*VersionedAttestation, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil || v.Phase0.Body == nil {
+			return nil, errors.New("no phase0 block")
+		}
+
+		return NewVersionedAttestations(DataVersionPhase0, v.Phase0.Body.Attestations), nil
+	case DataVersionAltair:
+		if v.Altair == nil || v.Altair.Body == nil {
+			return nil, errors.New("no altair block")
+		}
+
+		return NewVersionedAttestations(DataVersionAltair, v.Altair.Body.Attestations), nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.Body == nil {
+			return nil, errors.New("no bellatrix block")
+		}
+
+		return NewVersionedAttestations(DataVersionBellatrix, v.Bellatrix.Body.Attestations), nil
+	case DataVersionCapella:
+		if v.Capella == nil || v.Capella.Body == nil {
+			return nil, errors.New("no capella block")
+		}
+
+		return NewVersionedAttestations(DataVersionCapella, v.Capella.Body.Attestations), nil
+	case DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.Body == nil {
+			return nil, errors.New("no deneb block")
+		}
+
+		return NewVersionedAttestations(DataVersionDeneb, v.Deneb.Body.Attestations), nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Body == nil {
+			return nil, errors.New("no electra block")
+		}
+
+		return NewVersionedElectraAttestations(v.Electra.Body.Attestations), nil
 	default:
 		return nil, errors.New("unknown version")
 	}
 }
 
 // AttesterSlashings returns the attester slashings of the beacon block.
+// Electra attester slashings are not available through this function; use VersionedAttesterSlashings() instead.
 func (v *VersionedBeaconBlock) AttesterSlashings() ([]*phase0.AttesterSlashing, error) {
 	switch v.Version {
 	case DataVersionPhase0:
@@ -443,6 +547,52 @@ func (v *VersionedBeaconBlock) AttesterSlashings() ([]*phase0.AttesterSlashing,
 		}
 
 		return v.Deneb.Body.AttesterSlashings, nil
+	case DataVersionElectra:
+		return nil, errors.New("electra attester slashings not available; use VersionedAttesterSlashings()")
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// VersionedAttesterSlashings returns the versioned attester slashings of the beacon block.
+func (v *VersionedBeaconBlock) VersionedAttesterSlashings() ([]*VersionedAttesterSlashing, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil || v.Phase0.Body == nil {
+			return nil, errors.New("no phase0 block")
+		}
+
+		return NewVersionedAttesterSlashings(DataVersionPhase0, v.Phase0.Body.AttesterSlashings), nil
+	case DataVersionAltair:
+		if v.Altair == nil || v.Altair.Body == nil {
+			return nil, errors.New("no altair block")
+		}
+
+		return NewVersionedAttesterSlashings(DataVersionAltair, v.Altair.Body.AttesterSlashings), nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.Body == nil {
+			return nil, errors.New("no bellatrix block")
+		}
+
+		return NewVersionedAttesterSlashings(DataVersionBellatrix, v.Bellatrix.Body.AttesterSlashings), nil
+	case DataVersionCapella:
+		if v.Capella == nil || v.Capella.Body == nil {
+			return nil, errors.New("no capella block")
+		}
+
+		return NewVersionedAttesterSlashings(DataVersionCapella, v.Capella.Body.AttesterSlashings), nil
+	case DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.Body == nil {
+			return nil, errors.New("no deneb block")
+		}
+
+		return NewVersionedAttesterSlashings(DataVersionDeneb, v.Deneb.Body.AttesterSlashings), nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Body == nil {
+			return nil, errors.New("no electra block")
+		}
+
+		return NewVersionedElectraAttesterSlashings(v.Electra.Body.AttesterSlashings), nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -481,6 +631,12 @@ func (v *VersionedBeaconBlock) ProposerSlashings() ([]*phase0.ProposerSlashing,
 		}
 
 		return v.Deneb.Body.ProposerSlashings, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Body == nil {
+			return nil, errors.New("no electra block")
+		}
+
+		return v.Electra.Body.ProposerSlashings, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -519,6 +675,12 @@ func (v *VersionedBeaconBlock) String() string {
 		}
 
 		return v.Deneb.String()
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return ""
+		}
+
+		return v.Electra.String()
 	default:
 		return "unknown version"
 	}
@@ -20,6 +20,7 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
@@ -31,11 +32,12 @@ type VersionedBeaconState struct {
 	Bellatrix *bellatrix.BeaconState
 	Capella   *capella.BeaconState
 	Deneb     *deneb.BeaconState
+	Electra   *electra.BeaconState
 }
 
 // IsEmpty returns true if there is no block.
 func (v *VersionedBeaconState) IsEmpty() bool {
-	return v.Phase0 == nil && v.Altair == nil && v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil
+	return v.Phase0 == nil && v.Altair == nil && v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil && v.Electra == nil
 }
 
 // Slot returns the slot of the state.
@@ -71,6 +73,12 @@ func (v *VersionedBeaconState) Slot() (phase0.Slot, error) {
 		}
 
 		return v.Deneb.Slot, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no Electra state")
+		}
+
+		return v.Electra.Slot, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -93,6 +101,12 @@ func (v *VersionedBeaconState) NextWithdrawalValidatorIndex() (phase0.ValidatorI
 		}
 
 		return v.Deneb.NextWithdrawalValidatorIndex, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no Electra state")
+		}
+
+		return v.Electra.NextWithdrawalValidatorIndex, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -131,6 +145,12 @@ func (v *VersionedBeaconState) Validators() ([]*phase0.Validator, error) {
 		}
 
 		return v.Deneb.Validators, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no Electra state")
+		}
+
+		return v.Electra.Validators, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -169,6 +189,268 @@ func (v *VersionedBeaconState) ValidatorBalances() ([]phase0.Gwei, error) {
 		}
 
 		return v.Deneb.Balances, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no Electra state")
+		}
+
+		return v.Electra.Balances, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// BlockRoots returns the block roots of the state.
+func (v *VersionedBeaconState) BlockRoots() ([]phase0.Root, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return nil, errors.New("no Phase0 state")
+		}
+
+		return v.Phase0.BlockRoots, nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no Altair state")
+		}
+
+		return v.Altair.BlockRoots, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no Bellatrix state")
+		}
+
+		return v.Bellatrix.BlockRoots, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no Capella state")
+		}
+
+		return v.Capella.BlockRoots, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no Deneb state")
+		}
+
+		return v.Deneb.BlockRoots, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no Electra state")
+		}
+
+		return v.Electra.BlockRoots, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// StateRoots returns the state roots of the state.
+func (v *VersionedBeaconState) StateRoots() ([]phase0.Root, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return nil, errors.New("no Phase0 state")
+		}
+
+		return v.Phase0.StateRoots, nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no Altair state")
+		}
+
+		return v.Altair.StateRoots, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no Bellatrix state")
+		}
+
+		return v.Bellatrix.StateRoots, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no Capella state")
+		}
+
+		return v.Capella.StateRoots, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no Deneb state")
+		}
+
+		return v.Deneb.StateRoots, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no Electra state")
+		}
+
+		return v.Electra.StateRoots, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// FinalizedCheckpoint returns the finalized checkpoint of the state.
+func (v *VersionedBeaconState) FinalizedCheckpoint() (*phase0.Checkpoint, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return nil, errors.New("no Phase0 state")
+		}
+
+		return v.Phase0.FinalizedCheckpoint, nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no Altair state")
+		}
+
+		return v.Altair.FinalizedCheckpoint, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no Bellatrix state")
+		}
+
+		return v.Bellatrix.FinalizedCheckpoint, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no Capella state")
+		}
+
+		return v.Capella.FinalizedCheckpoint, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no Deneb state")
+		}
+
+		return v.Deneb.FinalizedCheckpoint, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no Electra state")
+		}
+
+		return v.Electra.FinalizedCheckpoint, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// RANDAOMixes returns the RANDAO mixes of the state.
+func (v *VersionedBeaconState) RANDAOMixes() ([]phase0.Root, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return nil, errors.New("no Phase0 state")
+		}
+
+		return v.Phase0.RANDAOMixes, nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no Altair state")
+		}
+
+		return v.Altair.RANDAOMixes, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no Bellatrix state")
+		}
+
+		return v.Bellatrix.RANDAOMixes, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no Capella state")
+		}
+
+		return v.Capella.RANDAOMixes, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no Deneb state")
+		}
+
+		return v.Deneb.RANDAOMixes, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no Electra state")
+		}
+
+		return v.Electra.RANDAOMixes, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// CurrentSyncCommittee returns the current sync committee of the state.
+func (v *VersionedBeaconState) CurrentSyncCommittee() (*altair.SyncCommittee, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		return nil, errors.New("state does not provide current sync committee")
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no Altair state")
+		}
+
+		return v.Altair.CurrentSyncCommittee, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no Bellatrix state")
+		}
+
+		return v.Bellatrix.CurrentSyncCommittee, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no Capella state")
+		}
+
+		return v.Capella.CurrentSyncCommittee, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no Deneb state")
+		}
+
+		return v.Deneb.CurrentSyncCommittee, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no Electra state")
+		}
+
+		return v.Electra.CurrentSyncCommittee, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// NextSyncCommittee returns the next sync committee of the state.
+func (v *VersionedBeaconState) NextSyncCommittee() (*altair.SyncCommittee, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		return nil, errors.New("state does not provide next sync committee")
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no Altair state")
+		}
+
+		return v.Altair.NextSyncCommittee, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no Bellatrix state")
+		}
+
+		return v.Bellatrix.NextSyncCommittee, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no Capella state")
+		}
+
+		return v.Capella.NextSyncCommittee, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no Deneb state")
+		}
+
+		return v.Deneb.NextSyncCommittee, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no Electra state")
+		}
+
+		return v.Electra.NextSyncCommittee, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -207,6 +489,12 @@ func (v *VersionedBeaconState) String() string {
 		}
 
 		return v.Deneb.String()
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return ""
+		}
+
+		return v.Electra.String()
 	default:
 		return "unknown version"
 	}
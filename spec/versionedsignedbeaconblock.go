@@ -20,6 +20,7 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
@@ -31,6 +32,7 @@ type VersionedSignedBeaconBlock struct {
 	Bellatrix *bellatrix.SignedBeaconBlock
 	Capella   *capella.SignedBeaconBlock
 	Deneb     *deneb.SignedBeaconBlock
+	Electra   *electra.SignedBeaconBlock
 }
 
 // Slot returns the slot of the signed beacon block.
@@ -66,6 +68,12 @@ func (v *VersionedSignedBeaconBlock) Slot() (phase0.Slot, error) {
 		}
 
 		return v.Deneb.Message.Slot, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil {
+			return 0, errors.New("no electra block")
+		}
+
+		return v.Electra.Message.Slot, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -104,6 +112,12 @@ func (v *VersionedSignedBeaconBlock) ProposerIndex() (phase0.ValidatorIndex, err
 		}
 
 		return v.Deneb.Message.ProposerIndex, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil {
+			return 0, errors.New("no electra block")
+		}
+
+		return v.Electra.Message.ProposerIndex, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -130,6 +144,12 @@ func (v *VersionedSignedBeaconBlock) ExecutionBlockHash() (phase0.Hash32, error)
 		}
 
 		return v.Deneb.Message.Body.ExecutionPayload.BlockHash, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil || v.Electra.Message.Body == nil || v.Electra.Message.Body.ExecutionPayload == nil {
+			return phase0.Hash32{}, errors.New("no electra block")
+		}
+
+		return v.Electra.Message.Body.ExecutionPayload.BlockHash, nil
 	default:
 		return phase0.Hash32{}, errors.New("unknown version")
 	}
@@ -156,6 +176,12 @@ func (v *VersionedSignedBeaconBlock) ExecutionBlockNumber() (uint64, error) {
 		}
 
 		return v.Deneb.Message.Body.ExecutionPayload.BlockNumber, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil || v.Electra.Message.Body == nil || v.Electra.Message.Body.ExecutionPayload == nil {
+			return 0, errors.New("no electra block")
+		}
+
+		return v.Electra.Message.Body.ExecutionPayload.BlockNumber, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -186,6 +212,12 @@ func (v *VersionedSignedBeaconBlock) ExecutionTransactions() ([]bellatrix.Transa
 		}
 
 		return v.Deneb.Message.Body.ExecutionPayload.Transactions, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil || v.Electra.Message.Body == nil || v.Electra.Message.Body.ExecutionPayload == nil {
+			return nil, errors.New("no electra block")
+		}
+
+		return v.Electra.Message.Body.ExecutionPayload.Transactions, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -224,12 +256,19 @@ func (v *VersionedSignedBeaconBlock) Graffiti() ([32]byte, error) {
 		}
 
 		return v.Deneb.Message.Body.Graffiti, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil || v.Electra.Message.Body == nil || v.Electra.Message.Body.ExecutionPayload == nil {
+			return [32]byte{}, errors.New("no electra block")
+		}
+
+		return v.Electra.Message.Body.Graffiti, nil
 	default:
 		return [32]byte{}, errors.New("unknown version")
 	}
 }
 
 // Attestations returns the attestations of the beacon block.
+// Electra attestations are not available through this function; use VersionedAttestations() instead.
 func (v *VersionedSignedBeaconBlock) Attestations() ([]*phase0.Attestation, error) {
 	switch v.Version {
 	case DataVersionPhase0:
@@ -262,6 +301,52 @@ func (v *VersionedSignedBeaconBlock) Attestations() ([]*phase0.Attestation, erro
 		}
 
 		return v.Deneb.Message.Body.Attestations, nil
+	case DataVersionElectra:
+		return nil, errors.New("electra attestations not available; use VersionedAttestations()")
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// VersionedAttestations returns the versioned attestations of the beacon block.
+func (v *VersionedSignedBeaconBlock) VersionedAttestations() ([]*VersionedAttestation, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil || v.Phase0.Message == nil || v.Phase0.Message.Body == nil {
+			return nil, errors.New("no phase0 block")
+		}
+
+		return NewVersionedAttestations(DataVersionPhase0, v.Phase0.Message.Body.Attestations), nil
+	case DataVersionAltair:
+		if v.Altair == nil || v.Altair.Message == nil || v.Altair.Message.Body == nil {
+			return nil, errors.New("no altair block")
+		}
+
+		return NewVersionedAttestations(DataVersionAltair, v.Altair.Message.Body.Attestations), nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.Message == nil || v.Bellatrix.Message.Body == nil {
+			return nil, errors.New("no bellatrix block")
+		}
+
+		return NewVersionedAttestations(DataVersionBellatrix, v.Bellatrix.Message.Body.Attestations), nil
+	case DataVersionCapella:
+		if v.Capella == nil || v.Capella.Message == nil || v.Capella.Message.Body == nil {
+			return nil, errors.New("no capella block")
+		}
+
+		return NewVersionedAttestations(DataVersionCapella, v.Capella.Message.Body.Attestations), nil
+	case DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.Message == nil || v.Deneb.Message.Body == nil {
+			return nil, errors.New("no deneb block")
+		}
+
+		return NewVersionedAttestations(DataVersionDeneb, v.Deneb.Message.Body.Attestations), nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil || v.Electra.Message.Body == nil {
+			return nil, errors.New("no electra block")
+		}
+
+		return NewVersionedElectraAttestations(v.Electra.Message.Body.Attestations), nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -300,6 +385,12 @@ func (v *VersionedSignedBeaconBlock) Root() (phase0.Root, error) {
 		}
 
 		return v.Deneb.Message.HashTreeRoot()
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil {
+			return phase0.Root{}, errors.New("no electra block")
+		}
+
+		return v.Electra.Message.HashTreeRoot()
 	default:
 		return phase0.Root{}, errors.New("unknown version")
 	}
@@ -338,6 +429,12 @@ func (v *VersionedSignedBeaconBlock) BodyRoot() (phase0.Root, error) {
 		}
 
 		return v.Deneb.Message.Body.HashTreeRoot()
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil || v.Electra.Message.Body == nil {
+			return phase0.Root{}, errors.New("no electra block")
+		}
+
+		return v.Electra.Message.Body.HashTreeRoot()
 	default:
 		return phase0.Root{}, errors.New("unknown version")
 	}
@@ -376,6 +473,12 @@ func (v *VersionedSignedBeaconBlock) ParentRoot() (phase0.Root, error) {
 		}
 
 		return v.Deneb.Message.ParentRoot, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil {
+			return phase0.Root{}, errors.New("no electra block")
+		}
+
+		return v.Electra.Message.ParentRoot, nil
 	default:
 		return phase0.Root{}, errors.New("unknown version")
 	}
@@ -414,6 +517,12 @@ func (v *VersionedSignedBeaconBlock) StateRoot() (phase0.Root, error) {
 		}
 
 		return v.Deneb.Message.StateRoot, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil {
+			return phase0.Root{}, errors.New("no electra block")
+		}
+
+		return v.Electra.Message.StateRoot, nil
 	default:
 		return phase0.Root{}, errors.New("unknown version")
 	}
@@ -452,6 +561,12 @@ func (v *VersionedSignedBeaconBlock) RandaoReveal() (phase0.BLSSignature, error)
 		}
 
 		return v.Deneb.Message.Body.RANDAOReveal, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil || v.Electra.Message.Body == nil {
+			return phase0.BLSSignature{}, errors.New("no electra block")
+		}
+
+		return v.Electra.Message.Body.RANDAOReveal, nil
 	default:
 		return phase0.BLSSignature{}, errors.New("unknown version")
 	}
@@ -490,6 +605,12 @@ func (v *VersionedSignedBeaconBlock) ETH1Data() (*phase0.ETH1Data, error) {
 		}
 
 		return v.Deneb.Message.Body.ETH1Data, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil || v.Electra.Message.Body == nil {
+			return nil, errors.New("no electra block")
+		}
+
+		return v.Electra.Message.Body.ETH1Data, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -528,6 +649,12 @@ func (v *VersionedSignedBeaconBlock) Deposits() ([]*phase0.Deposit, error) {
 		}
 
 		return v.Deneb.Message.Body.Deposits, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil || v.Electra.Message.Body == nil {
+			return nil, errors.New("no electra block")
+		}
+
+		return v.Electra.Message.Body.Deposits, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -566,12 +693,19 @@ func (v *VersionedSignedBeaconBlock) VoluntaryExits() ([]*phase0.SignedVoluntary
 		}
 
 		return v.Deneb.Message.Body.VoluntaryExits, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil || v.Electra.Message.Body == nil {
+			return nil, errors.New("no electra block")
+		}
+
+		return v.Electra.Message.Body.VoluntaryExits, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
 }
 
 // AttesterSlashings returns the attester slashings of the beacon block.
+// Electra attester slashings are not available through this function; use VersionedAttesterSlashings() instead.
 func (v *VersionedSignedBeaconBlock) AttesterSlashings() ([]*phase0.AttesterSlashing, error) {
 	switch v.Version {
 	case DataVersionPhase0:
@@ -604,6 +738,52 @@ func (v *VersionedSignedBeaconBlock) AttesterSlashings() ([]*phase0.AttesterSlas
 		}
 
 		return v.Deneb.Message.Body.AttesterSlashings, nil
+	case DataVersionElectra:
+		return nil, errors.New("electra attester slashings not available; use VersionedAttesterSlashings()")
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// VersionedAttesterSlashings returns the versioned attester slashings of the beacon block.
+func (v *VersionedSignedBeaconBlock) VersionedAttesterSlashings() ([]*VersionedAttesterSlashing, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return nil, errors.New("no phase0 block")
+		}
+
+		return NewVersionedAttesterSlashings(DataVersionPhase0, v.Phase0.Message.Body.AttesterSlashings), nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no altair block")
+		}
+
+		return NewVersionedAttesterSlashings(DataVersionAltair, v.Altair.Message.Body.AttesterSlashings), nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no bellatrix block")
+		}
+
+		return NewVersionedAttesterSlashings(DataVersionBellatrix, v.Bellatrix.Message.Body.AttesterSlashings), nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no capella block")
+		}
+
+		return NewVersionedAttesterSlashings(DataVersionCapella, v.Capella.Message.Body.AttesterSlashings), nil
+	case DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.Message == nil || v.Deneb.Message.Body == nil {
+			return nil, errors.New("no deneb block")
+		}
+
+		return NewVersionedAttesterSlashings(DataVersionDeneb, v.Deneb.Message.Body.AttesterSlashings), nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil || v.Electra.Message.Body == nil {
+			return nil, errors.New("no electra block")
+		}
+
+		return NewVersionedElectraAttesterSlashings(v.Electra.Message.Body.AttesterSlashings), nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -642,6 +822,12 @@ func (v *VersionedSignedBeaconBlock) ProposerSlashings() ([]*phase0.ProposerSlas
 		}
 
 		return v.Deneb.Message.Body.ProposerSlashings, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil || v.Electra.Message.Body == nil {
+			return nil, errors.New("no electra block")
+		}
+
+		return v.Electra.Message.Body.ProposerSlashings, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -676,6 +862,12 @@ func (v *VersionedSignedBeaconBlock) SyncAggregate() (*altair.SyncAggregate, err
 		}
 
 		return v.Deneb.Message.Body.SyncAggregate, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil || v.Electra.Message.Body == nil {
+			return nil, errors.New("no electra block")
+		}
+
+		return v.Electra.Message.Body.SyncAggregate, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -702,6 +894,12 @@ func (v *VersionedSignedBeaconBlock) BLSToExecutionChanges() ([]*capella.SignedB
 		}
 
 		return v.Deneb.Message.Body.BLSToExecutionChanges, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil || v.Electra.Message.Body == nil {
+			return nil, errors.New("no electra block")
+		}
+
+		return v.Electra.Message.Body.BLSToExecutionChanges, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -728,6 +926,12 @@ func (v *VersionedSignedBeaconBlock) Withdrawals() ([]*capella.Withdrawal, error
 		}
 
 		return v.Deneb.Message.Body.ExecutionPayload.Withdrawals, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil || v.Electra.Message.Body == nil || v.Electra.Message.Body.ExecutionPayload == nil {
+			return nil, errors.New("no electra block")
+		}
+
+		return v.Electra.Message.Body.ExecutionPayload.Withdrawals, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -748,6 +952,12 @@ func (v *VersionedSignedBeaconBlock) BlobKZGCommitments() ([]deneb.KZGCommitment
 		}
 
 		return v.Deneb.Message.Body.BlobKZGCommitments, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil || v.Electra.Message.Body == nil {
+			return nil, errors.New("no electra block")
+		}
+
+		return v.Electra.Message.Body.BlobKZGCommitments, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -774,6 +984,11 @@ func (v *VersionedSignedBeaconBlock) BlockNumber() (uint64, error) {
 			return 0, errors.New("no deneb block body")
 		}
 		return v.Deneb.Message.Body.ExecutionPayload.BlockNumber, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no electra block body")
+		}
+		return v.Electra.Message.Body.ExecutionPayload.BlockNumber, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -812,6 +1027,12 @@ func (v *VersionedSignedBeaconBlock) String() string {
 		}
 
 		return v.Deneb.String()
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return ""
+		}
+
+		return v.Electra.String()
 	default:
 		return "unknown version"
 	}
@@ -25,6 +25,7 @@ import (
 	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
@@ -200,6 +201,24 @@ func (s *Erroring) AggregateAttestation(ctx context.Context,
 	return next.AggregateAttestation(ctx, opts)
 }
 
+// VersionedAggregateAttestation fetches the aggregate attestation given an attestation.
+func (s *Erroring) VersionedAggregateAttestation(ctx context.Context,
+	opts *api.AggregateAttestationOpts,
+) (
+	*api.Response[*spec.VersionedAttestation],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.VersionedAggregateAttestationProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.VersionedAggregateAttestation(ctx, opts)
+}
+
 // SubmitAggregateAttestations submits aggregate attestations.
 func (s *Erroring) SubmitAggregateAttestations(ctx context.Context, aggregateAndProofs []*phase0.SignedAggregateAndProof) error {
 	if err := s.maybeError(ctx); err != nil {
@@ -213,6 +232,19 @@ func (s *Erroring) SubmitAggregateAttestations(ctx context.Context, aggregateAnd
 	return next.SubmitAggregateAttestations(ctx, aggregateAndProofs)
 }
 
+// SubmitVersionedAggregateAttestations submits aggregate attestations.
+func (s *Erroring) SubmitVersionedAggregateAttestations(ctx context.Context, opts *api.SubmitAggregateAttestationsOpts) error {
+	if err := s.maybeError(ctx); err != nil {
+		return err
+	}
+	next, isNext := s.next.(consensusclient.VersionedAggregateAttestationsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SubmitVersionedAggregateAttestations(ctx, opts)
+}
+
 // AttestationData fetches the attestation data for the given slot and committee index.
 func (s *Erroring) AttestationData(ctx context.Context,
 	opts *api.AttestationDataOpts,
@@ -249,6 +281,24 @@ func (s *Erroring) AttestationPool(ctx context.Context,
 	return next.AttestationPool(ctx, opts)
 }
 
+// VersionedAttestationPool fetches the attestation pool for the given slot.
+func (s *Erroring) VersionedAttestationPool(ctx context.Context,
+	opts *api.AttestationPoolOpts,
+) (
+	*api.Response[[]*spec.VersionedAttestation],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.VersionedAttestationPoolProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.VersionedAttestationPool(ctx, opts)
+}
+
 // SubmitAttestations submits attestations.
 func (s *Erroring) SubmitAttestations(ctx context.Context, attestations []*phase0.Attestation) error {
 	if err := s.maybeError(ctx); err != nil {
@@ -262,6 +312,19 @@ func (s *Erroring) SubmitAttestations(ctx context.Context, attestations []*phase
 	return next.SubmitAttestations(ctx, attestations)
 }
 
+// SubmitVersionedAttestations submits attestations.
+func (s *Erroring) SubmitVersionedAttestations(ctx context.Context, opts *api.SubmitAttestationsOpts) error {
+	if err := s.maybeError(ctx); err != nil {
+		return err
+	}
+	next, isNext := s.next.(consensusclient.VersionedAttestationsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SubmitVersionedAttestations(ctx, opts)
+}
+
 // SubmitProposalPreparations submits proposal preparations.
 func (s *Erroring) SubmitProposalPreparations(ctx context.Context, preparations []*apiv1.ProposalPreparation) error {
 	if err := s.maybeError(ctx); err != nil {
@@ -338,6 +401,24 @@ func (s *Erroring) BeaconBlockHeader(ctx context.Context,
 	return next.BeaconBlockHeader(ctx, opts)
 }
 
+// BeaconBlockHeaders provides the block headers matching the opts.
+func (s *Erroring) BeaconBlockHeaders(ctx context.Context,
+	opts *api.BeaconBlockHeadersOpts,
+) (
+	*api.Response[[]*apiv1.BeaconBlockHeader],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.BeaconBlockHeadersListProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.BeaconBlockHeaders(ctx, opts)
+}
+
 // BeaconBlockRoot fetches a block's root given a block ID.
 func (s *Erroring) BeaconBlockRoot(ctx context.Context,
 	opts *api.BeaconBlockRootOpts,
@@ -420,6 +501,42 @@ func (s *Erroring) SubmitBeaconCommitteeSubscriptions(ctx context.Context, subsc
 	return next.SubmitBeaconCommitteeSubscriptions(ctx, subscriptions)
 }
 
+// SyncCommitteeSelections exchanges partial sync committee selections for aggregated sync committee selections.
+func (s *Erroring) SyncCommitteeSelections(ctx context.Context,
+	opts *api.SyncCommitteeSelectionsOpts,
+) (
+	*api.Response[[]*apiv1.SyncCommitteeSelection],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.SyncCommitteeSelectionsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SyncCommitteeSelections(ctx, opts)
+}
+
+// BeaconCommitteeSelections exchanges partial beacon committee selections for aggregated beacon committee selections.
+func (s *Erroring) BeaconCommitteeSelections(ctx context.Context,
+	opts *api.BeaconCommitteeSelectionsOpts,
+) (
+	*api.Response[[]*apiv1.BeaconCommitteeSelection],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.BeaconCommitteeSelectionsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.BeaconCommitteeSelections(ctx, opts)
+}
+
 // SubmitBlindedBeaconBlock submits a blinded beacon block.
 //
 // Deprecated: this will not work from the deneb hard-fork onwards.  Use SubmitBlindedProposal() instead.
@@ -487,6 +604,24 @@ func (s *Erroring) Events(ctx context.Context, topics []string, handler consensu
 	return next.Events(ctx, topics, handler)
 }
 
+// ExpectedWithdrawals provides the withdrawals expected in the payload of a proposal.
+func (s *Erroring) ExpectedWithdrawals(ctx context.Context,
+	opts *api.ExpectedWithdrawalsOpts,
+) (
+	*api.Response[[]*capella.Withdrawal],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.ExpectedWithdrawalsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.ExpectedWithdrawals(ctx, opts)
+}
+
 // Finality provides the finality given a state ID.
 func (s *Erroring) Finality(ctx context.Context, opts *api.FinalityOpts) (*api.Response[*apiv1.Finality], error) {
 	if err := s.maybeError(ctx); err != nil {
@@ -557,6 +692,60 @@ func (s *Erroring) NodeSyncing(ctx context.Context, opts *api.NodeSyncingOpts) (
 	return next.NodeSyncing(ctx, opts)
 }
 
+// NodeHealth provides the health of the node.
+func (s *Erroring) NodeHealth(ctx context.Context,
+	opts *api.NodeHealthOpts,
+) (
+	*api.Response[apiv1.NodeHealth],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.NodeHealthProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.NodeHealth(ctx, opts)
+}
+
+// NodeIdentity provides the identity of the node.
+func (s *Erroring) NodeIdentity(ctx context.Context,
+	opts *api.NodeIdentityOpts,
+) (
+	*api.Response[*apiv1.NodeIdentity],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.NodeIdentityProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.NodeIdentity(ctx, opts)
+}
+
+// NodePeerCount provides the number of peers of the node in each connection state.
+func (s *Erroring) NodePeerCount(ctx context.Context,
+	opts *api.NodePeerCountOpts,
+) (
+	*api.Response[*apiv1.PeerCount],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.NodePeerCountProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.NodePeerCount(ctx, opts)
+}
+
 // NodePeers provides the peers of the node.
 func (s *Erroring) NodePeers(ctx context.Context, opts *api.NodePeersOpts) (*api.Response[[]*apiv1.Peer], error) {
 	if err := s.maybeError(ctx); err != nil {
@@ -659,6 +848,78 @@ func (s *Erroring) ValidatorBalances(ctx context.Context,
 	return next.ValidatorBalances(ctx, opts)
 }
 
+// ValidatorLiveness provides the liveness of validators for the given options.
+func (s *Erroring) ValidatorLiveness(ctx context.Context,
+	opts *api.ValidatorLivenessOpts,
+) (
+	*api.Response[[]*apiv1.ValidatorLiveness],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.ValidatorLivenessProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.ValidatorLiveness(ctx, opts)
+}
+
+// AttestationRewards provides the attestation rewards for the given options.
+func (s *Erroring) AttestationRewards(ctx context.Context,
+	opts *api.AttestationRewardsOpts,
+) (
+	*api.Response[*apiv1.AttestationRewards],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.AttestationRewardsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.AttestationRewards(ctx, opts)
+}
+
+// BlockRewards provides the block rewards for the given options.
+func (s *Erroring) BlockRewards(ctx context.Context,
+	opts *api.BlockRewardsOpts,
+) (
+	*api.Response[*apiv1.BlockRewards],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.BlockRewardsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.BlockRewards(ctx, opts)
+}
+
+// SyncCommitteeRewards provides the sync committee rewards for the given options.
+func (s *Erroring) SyncCommitteeRewards(ctx context.Context,
+	opts *api.SyncCommitteeRewardsOpts,
+) (
+	*api.Response[[]*apiv1.SyncCommitteeReward],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.SyncCommitteeRewardsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.SyncCommitteeRewards(ctx, opts)
+}
+
 // Validators provides the validators, with their balance and status, for a given state.
 func (s *Erroring) Validators(ctx context.Context,
 	opts *api.ValidatorsOpts,
@@ -703,6 +964,60 @@ func (s *Erroring) VoluntaryExitPool(ctx context.Context, opts *api.VoluntaryExi
 	return next.VoluntaryExitPool(ctx, opts)
 }
 
+// BLSToExecutionChangePool obtains the BLS to execution change pool.
+func (s *Erroring) BLSToExecutionChangePool(ctx context.Context,
+	opts *api.BLSToExecutionChangePoolOpts,
+) (
+	*api.Response[[]*capella.SignedBLSToExecutionChange],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.BLSToExecutionChangePoolProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.BLSToExecutionChangePool(ctx, opts)
+}
+
+// ProposerSlashingPool obtains the proposer slashing pool.
+func (s *Erroring) ProposerSlashingPool(ctx context.Context,
+	opts *api.ProposerSlashingPoolOpts,
+) (
+	*api.Response[[]*phase0.ProposerSlashing],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.ProposerSlashingPoolProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.ProposerSlashingPool(ctx, opts)
+}
+
+// AttesterSlashingPool obtains the attester slashing pool.
+func (s *Erroring) AttesterSlashingPool(ctx context.Context,
+	opts *api.AttesterSlashingPoolOpts,
+) (
+	*api.Response[[]*phase0.AttesterSlashing],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.AttesterSlashingPoolProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.AttesterSlashingPool(ctx, opts)
+}
+
 // Domain provides a domain for a given domain type at a given epoch.
 func (s *Erroring) Domain(ctx context.Context, domainType phase0.DomainType, epoch phase0.Epoch) (phase0.Domain, error) {
 	if err := s.maybeError(ctx); err != nil {
@@ -757,6 +1072,24 @@ func (s *Erroring) DepositContract(ctx context.Context, opts *api.DepositContrac
 	return next.DepositContract(ctx, opts)
 }
 
+// DepositSnapshot provides the EIP-4881 snapshot of the finalized deposit tree.
+func (s *Erroring) DepositSnapshot(ctx context.Context,
+	opts *api.DepositSnapshotOpts,
+) (
+	*api.Response[*apiv1.DepositSnapshot],
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.DepositSnapshotProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+
+	return next.DepositSnapshot(ctx, opts)
+}
+
 // SignedBeaconBlock fetches a signed beacon block given a block ID.
 func (s *Erroring) SignedBeaconBlock(ctx context.Context, opts *api.SignedBeaconBlockOpts) (*api.Response[*spec.VersionedSignedBeaconBlock], error) {
 	if err := s.maybeError(ctx); err != nil {
@@ -25,6 +25,7 @@ import (
 	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
@@ -182,6 +183,22 @@ func (s *Sleepy) AggregateAttestation(ctx context.Context,
 	return next.AggregateAttestation(ctx, opts)
 }
 
+// VersionedAggregateAttestation fetches the aggregate attestation given an attestation.
+func (s *Sleepy) VersionedAggregateAttestation(ctx context.Context,
+	opts *api.AggregateAttestationOpts,
+) (
+	*api.Response[*spec.VersionedAttestation],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.VersionedAggregateAttestationProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.VersionedAggregateAttestation(ctx, opts)
+}
+
 // SubmitAggregateAttestations submits aggregate attestations.
 func (s *Sleepy) SubmitAggregateAttestations(ctx context.Context, aggregateAndProofs []*phase0.SignedAggregateAndProof) error {
 	s.sleep(ctx)
@@ -193,6 +210,17 @@ func (s *Sleepy) SubmitAggregateAttestations(ctx context.Context, aggregateAndPr
 	return next.SubmitAggregateAttestations(ctx, aggregateAndProofs)
 }
 
+// SubmitVersionedAggregateAttestations submits aggregate attestations.
+func (s *Sleepy) SubmitVersionedAggregateAttestations(ctx context.Context, opts *api.SubmitAggregateAttestationsOpts) error {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.VersionedAggregateAttestationsSubmitter)
+	if !isNext {
+		return errors.New("next does not support this call")
+	}
+
+	return next.SubmitVersionedAggregateAttestations(ctx, opts)
+}
+
 // AttestationData fetches the attestation data for the given slot and committee index.
 func (s *Sleepy) AttestationData(ctx context.Context,
 	opts *api.AttestationDataOpts,
@@ -225,6 +253,22 @@ func (s *Sleepy) AttestationPool(ctx context.Context,
 	return next.AttestationPool(ctx, opts)
 }
 
+// VersionedAttestationPool fetches the attestation pool for the given slot.
+func (s *Sleepy) VersionedAttestationPool(ctx context.Context,
+	opts *api.AttestationPoolOpts,
+) (
+	*api.Response[[]*spec.VersionedAttestation],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.VersionedAttestationPoolProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.VersionedAttestationPool(ctx, opts)
+}
+
 // SubmitAttestations submits attestations.
 func (s *Sleepy) SubmitAttestations(ctx context.Context, attestations []*phase0.Attestation) error {
 	s.sleep(ctx)
@@ -236,6 +280,17 @@ func (s *Sleepy) SubmitAttestations(ctx context.Context, attestations []*phase0.
 	return next.SubmitAttestations(ctx, attestations)
 }
 
+// SubmitVersionedAttestations submits attestations.
+func (s *Sleepy) SubmitVersionedAttestations(ctx context.Context, opts *api.SubmitAttestationsOpts) error {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.VersionedAttestationsSubmitter)
+	if !isNext {
+		return errors.New("next does not support this call")
+	}
+
+	return next.SubmitVersionedAttestations(ctx, opts)
+}
+
 // AttesterDuties obtains attester duties.
 // If validatorIndicess is nil it will return all duties for the given epoch.
 func (s *Sleepy) AttesterDuties(ctx context.Context,
@@ -269,6 +324,22 @@ func (s *Sleepy) BeaconBlockHeader(ctx context.Context,
 	return next.BeaconBlockHeader(ctx, opts)
 }
 
+// BeaconBlockHeaders provides the block headers matching the opts.
+func (s *Sleepy) BeaconBlockHeaders(ctx context.Context,
+	opts *api.BeaconBlockHeadersOpts,
+) (
+	*api.Response[[]*apiv1.BeaconBlockHeader],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.BeaconBlockHeadersListProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.BeaconBlockHeaders(ctx, opts)
+}
+
 // Proposal fetches a proposal for signing.
 func (s *Sleepy) Proposal(ctx context.Context,
 	opts *api.ProposalOpts,
@@ -309,6 +380,38 @@ func (s *Sleepy) SubmitBeaconCommitteeSubscriptions(ctx context.Context, subscri
 	return next.SubmitBeaconCommitteeSubscriptions(ctx, subscriptions)
 }
 
+// SyncCommitteeSelections exchanges partial sync committee selections for aggregated sync committee selections.
+func (s *Sleepy) SyncCommitteeSelections(ctx context.Context,
+	opts *api.SyncCommitteeSelectionsOpts,
+) (
+	*api.Response[[]*apiv1.SyncCommitteeSelection],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.SyncCommitteeSelectionsProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.SyncCommitteeSelections(ctx, opts)
+}
+
+// BeaconCommitteeSelections exchanges partial beacon committee selections for aggregated beacon committee selections.
+func (s *Sleepy) BeaconCommitteeSelections(ctx context.Context,
+	opts *api.BeaconCommitteeSelectionsOpts,
+) (
+	*api.Response[[]*apiv1.BeaconCommitteeSelection],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.BeaconCommitteeSelectionsProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.BeaconCommitteeSelections(ctx, opts)
+}
+
 // SubmitProposalPreparations submits proposal preparations.
 func (s *Sleepy) SubmitProposalPreparations(ctx context.Context, preparations []*apiv1.ProposalPreparation) error {
 	s.sleep(ctx)
@@ -355,6 +458,22 @@ func (s *Sleepy) BeaconState(ctx context.Context, opts *api.BeaconStateOpts) (*a
 	return next.BeaconState(ctx, opts)
 }
 
+// DepositSnapshot provides the EIP-4881 snapshot of the finalized deposit tree.
+func (s *Sleepy) DepositSnapshot(ctx context.Context,
+	opts *api.DepositSnapshotOpts,
+) (
+	*api.Response[*apiv1.DepositSnapshot],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.DepositSnapshotProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.DepositSnapshot(ctx, opts)
+}
+
 // Events feeds requested events with the given topics to the supplied handler.
 func (s *Sleepy) Events(ctx context.Context, topics []string, handler consensusclient.EventHandlerFunc) error {
 	s.sleep(ctx)
@@ -366,6 +485,22 @@ func (s *Sleepy) Events(ctx context.Context, topics []string, handler consensusc
 	return next.Events(ctx, topics, handler)
 }
 
+// ExpectedWithdrawals provides the withdrawals expected in the payload of a proposal.
+func (s *Sleepy) ExpectedWithdrawals(ctx context.Context,
+	opts *api.ExpectedWithdrawalsOpts,
+) (
+	*api.Response[[]*capella.Withdrawal],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.ExpectedWithdrawalsProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.ExpectedWithdrawals(ctx, opts)
+}
+
 // Finality provides the finality given a state ID.
 func (s *Sleepy) Finality(ctx context.Context, opts *api.FinalityOpts) (*api.Response[*apiv1.Finality], error) {
 	s.sleep(ctx)
@@ -426,6 +561,54 @@ func (s *Sleepy) NodeSyncing(ctx context.Context, opts *api.NodeSyncingOpts) (*a
 	return next.NodeSyncing(ctx, opts)
 }
 
+// NodeHealth provides the health of the node.
+func (s *Sleepy) NodeHealth(ctx context.Context,
+	opts *api.NodeHealthOpts,
+) (
+	*api.Response[apiv1.NodeHealth],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.NodeHealthProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.NodeHealth(ctx, opts)
+}
+
+// NodeIdentity provides the identity of the node.
+func (s *Sleepy) NodeIdentity(ctx context.Context,
+	opts *api.NodeIdentityOpts,
+) (
+	*api.Response[*apiv1.NodeIdentity],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.NodeIdentityProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.NodeIdentity(ctx, opts)
+}
+
+// NodePeerCount provides the number of peers of the node in each connection state.
+func (s *Sleepy) NodePeerCount(ctx context.Context,
+	opts *api.NodePeerCountOpts,
+) (
+	*api.Response[*apiv1.PeerCount],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.NodePeerCountProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.NodePeerCount(ctx, opts)
+}
+
 // NodePeers provides the peers of the node.
 func (s *Sleepy) NodePeers(ctx context.Context, opts *api.NodePeersOpts) (*api.Response[[]*apiv1.Peer], error) {
 	s.sleep(ctx)
@@ -480,6 +663,70 @@ func (s *Sleepy) ValidatorBalances(ctx context.Context,
 	return next.ValidatorBalances(ctx, opts)
 }
 
+// ValidatorLiveness provides the liveness of validators for the given options.
+func (s *Sleepy) ValidatorLiveness(ctx context.Context,
+	opts *api.ValidatorLivenessOpts,
+) (
+	*api.Response[[]*apiv1.ValidatorLiveness],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.ValidatorLivenessProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.ValidatorLiveness(ctx, opts)
+}
+
+// AttestationRewards provides the attestation rewards for the given options.
+func (s *Sleepy) AttestationRewards(ctx context.Context,
+	opts *api.AttestationRewardsOpts,
+) (
+	*api.Response[*apiv1.AttestationRewards],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.AttestationRewardsProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.AttestationRewards(ctx, opts)
+}
+
+// BlockRewards provides the block rewards for the given options.
+func (s *Sleepy) BlockRewards(ctx context.Context,
+	opts *api.BlockRewardsOpts,
+) (
+	*api.Response[*apiv1.BlockRewards],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.BlockRewardsProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.BlockRewards(ctx, opts)
+}
+
+// SyncCommitteeRewards provides the sync committee rewards for the given options.
+func (s *Sleepy) SyncCommitteeRewards(ctx context.Context,
+	opts *api.SyncCommitteeRewardsOpts,
+) (
+	*api.Response[[]*apiv1.SyncCommitteeReward],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.SyncCommitteeRewardsProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.SyncCommitteeRewards(ctx, opts)
+}
+
 // Validators provides the validators, with their balance and status, for a given state.
 func (s *Sleepy) Validators(ctx context.Context,
 	opts *api.ValidatorsOpts,
@@ -518,6 +765,54 @@ func (s *Sleepy) VoluntaryExitPool(ctx context.Context, opts *api.VoluntaryExitP
 	return next.VoluntaryExitPool(ctx, opts)
 }
 
+// BLSToExecutionChangePool obtains the BLS to execution change pool.
+func (s *Sleepy) BLSToExecutionChangePool(ctx context.Context,
+	opts *api.BLSToExecutionChangePoolOpts,
+) (
+	*api.Response[[]*capella.SignedBLSToExecutionChange],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.BLSToExecutionChangePoolProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.BLSToExecutionChangePool(ctx, opts)
+}
+
+// ProposerSlashingPool obtains the proposer slashing pool.
+func (s *Sleepy) ProposerSlashingPool(ctx context.Context,
+	opts *api.ProposerSlashingPoolOpts,
+) (
+	*api.Response[[]*phase0.ProposerSlashing],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.ProposerSlashingPoolProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.ProposerSlashingPool(ctx, opts)
+}
+
+// AttesterSlashingPool obtains the attester slashing pool.
+func (s *Sleepy) AttesterSlashingPool(ctx context.Context,
+	opts *api.AttesterSlashingPoolOpts,
+) (
+	*api.Response[[]*phase0.AttesterSlashing],
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.AttesterSlashingPoolProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+
+	return next.AttesterSlashingPool(ctx, opts)
+}
+
 // Domain provides a domain for a given domain type at a given epoch.
 func (s *Sleepy) Domain(ctx context.Context, domainType phase0.DomainType, epoch phase0.Epoch) (phase0.Domain, error) {
 	s.sleep(ctx)
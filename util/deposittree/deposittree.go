@@ -0,0 +1,246 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package deposittree provides an implementation of the EIP-4881 deposit tree.
+package deposittree
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// DepositTree is a deposit tree as defined in EIP-4881.
+// It holds the deposit contract's Merkle tree, with finalized subtrees
+// compressed to their roots.
+type DepositTree struct {
+	mutex                   sync.RWMutex
+	tree                    node
+	depositCount            uint64
+	finalizedBlockHash      phase0.Hash32
+	finalizedBlockHeight    uint64
+	finalizedBlockAvailable bool
+}
+
+// New creates a new empty deposit tree.
+func New() *DepositTree {
+	return &DepositTree{
+		tree: &zeroNode{level: depth},
+	}
+}
+
+// NewFromSnapshot creates a deposit tree from an EIP-4881 snapshot.
+func NewFromSnapshot(snapshot *apiv1.DepositSnapshot) (*DepositTree, error) {
+	if snapshot == nil {
+		return nil, errors.New("no snapshot specified")
+	}
+
+	root, err := snapshotRoot(snapshot)
+	if err != nil {
+		return nil, err
+	}
+	if root != snapshot.DepositRoot {
+		return nil, fmt.Errorf("snapshot deposit root %#x does not match calculated root %#x", snapshot.DepositRoot, root)
+	}
+
+	tree, err := fromSnapshotParts(snapshot.Finalized, snapshot.DepositCount, depth)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DepositTree{
+		tree:                    tree,
+		depositCount:            snapshot.DepositCount,
+		finalizedBlockHash:      snapshot.ExecutionBlockHash,
+		finalizedBlockHeight:    snapshot.ExecutionBlockHeight,
+		finalizedBlockAvailable: true,
+	}, nil
+}
+
+// AddDeposit adds a deposit to the tree.
+func (t *DepositTree) AddDeposit(data *phase0.DepositData) error {
+	if data == nil {
+		return errors.New("no deposit data specified")
+	}
+
+	leaf, err := data.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "failed to calculate hash tree root of deposit data")
+	}
+
+	return t.PushLeaf(leaf)
+}
+
+// PushLeaf adds the hash tree root of a deposit to the tree.
+func (t *DepositTree) PushLeaf(leaf phase0.Root) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.depositCount >= uint64(1)<<depth {
+		return errors.New("deposit tree is full")
+	}
+
+	tree, err := t.tree.pushLeaf(leaf, depth)
+	if err != nil {
+		return err
+	}
+	t.tree = tree
+	t.depositCount++
+
+	return nil
+}
+
+// DepositCount returns the number of deposits in the tree.
+func (t *DepositTree) DepositCount() uint64 {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.depositCount
+}
+
+// Root returns the deposit root, as returned by the deposit contract's get_deposit_root().
+func (t *DepositTree) Root() phase0.Root {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return mixInLength(t.tree.root(), t.depositCount)
+}
+
+// Proof returns the proof for the deposit at the given index, in the form
+// required by phase0.Deposit.
+// The proof is against the root of the tree with its current deposit count,
+// so the tree should contain exactly the number of deposits in the eth1 data
+// against which the proof will be verified.
+func (t *DepositTree) Proof(index uint64) ([][]byte, error) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	if index >= t.depositCount {
+		return nil, fmt.Errorf("deposit %d not in tree", index)
+	}
+	finalizedDeposits, _ := t.tree.finalized(nil)
+	if index < finalizedDeposits {
+		return nil, fmt.Errorf("deposit %d has been finalized", index)
+	}
+
+	_, branch, err := generateProof(t.tree, index, depth)
+	if err != nil {
+		return nil, err
+	}
+
+	proof := make([][]byte, 0, depth+1)
+	for i := range branch {
+		proof = append(proof, branch[i][:])
+	}
+	length := lengthRoot(t.depositCount)
+	proof = append(proof, length[:])
+
+	return proof, nil
+}
+
+// Finalize finalizes the tree up to the deposit count in the supplied eth1
+// data, which is from the execution block at the given height.
+func (t *DepositTree) Finalize(eth1Data *phase0.ETH1Data, executionBlockHeight uint64) error {
+	if eth1Data == nil {
+		return errors.New("no eth1 data specified")
+	}
+	if len(eth1Data.BlockHash) != phase0.Hash32Length {
+		return fmt.Errorf("incorrect length %d for block hash", len(eth1Data.BlockHash))
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if eth1Data.DepositCount > t.depositCount {
+		return fmt.Errorf("cannot finalize %d deposits; tree only contains %d", eth1Data.DepositCount, t.depositCount)
+	}
+
+	if eth1Data.DepositCount > 0 {
+		tree, err := t.tree.finalize(eth1Data.DepositCount, depth)
+		if err != nil {
+			return err
+		}
+		t.tree = tree
+	}
+	copy(t.finalizedBlockHash[:], eth1Data.BlockHash)
+	t.finalizedBlockHeight = executionBlockHeight
+	t.finalizedBlockAvailable = true
+
+	return nil
+}
+
+// Snapshot returns an EIP-4881 snapshot of the finalized portion of the tree.
+func (t *DepositTree) Snapshot() (*apiv1.DepositSnapshot, error) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	if !t.finalizedBlockAvailable {
+		return nil, errors.New("deposit tree has not been finalized")
+	}
+
+	depositCount, finalized := t.tree.finalized(make([]phase0.Root, 0))
+	snapshot := &apiv1.DepositSnapshot{
+		Finalized:            finalized,
+		DepositCount:         depositCount,
+		ExecutionBlockHash:   t.finalizedBlockHash,
+		ExecutionBlockHeight: t.finalizedBlockHeight,
+	}
+	root, err := snapshotRoot(snapshot)
+	if err != nil {
+		return nil, err
+	}
+	snapshot.DepositRoot = root
+
+	return snapshot, nil
+}
+
+// snapshotRoot calculates the deposit root of a snapshot.
+func snapshotRoot(snapshot *apiv1.DepositSnapshot) (phase0.Root, error) {
+	size := snapshot.DepositCount
+	index := len(snapshot.Finalized)
+	root := zeroHashes[0]
+	for level := 0; level < depth; level++ {
+		if size&1 == 1 {
+			if index == 0 {
+				return phase0.Root{}, errors.New("insufficient finalized roots in snapshot")
+			}
+			index--
+			root = hashConcat(snapshot.Finalized[index], root)
+		} else {
+			root = hashConcat(root, zeroHashes[level])
+		}
+		size >>= 1
+	}
+	if index != 0 {
+		return phase0.Root{}, errors.New("too many finalized roots in snapshot")
+	}
+
+	return mixInLength(root, snapshot.DepositCount), nil
+}
+
+// mixInLength mixes the deposit count in to a root.
+func mixInLength(root phase0.Root, length uint64) phase0.Root {
+	return hashConcat(root, lengthRoot(length))
+}
+
+// lengthRoot returns the deposit count as a little-endian 32-byte value.
+func lengthRoot(length uint64) phase0.Root {
+	var res phase0.Root
+	binary.LittleEndian.PutUint64(res[:], length)
+
+	return res
+}
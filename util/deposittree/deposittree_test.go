@@ -0,0 +1,204 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deposittree_test
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/deposittree"
+	"github.com/stretchr/testify/require"
+)
+
+func hash(left []byte, right []byte) []byte {
+	res := sha256.Sum256(append(append([]byte{}, left...), right...))
+
+	return res[:]
+}
+
+// naiveRoot calculates the deposit root by building the full tree.
+func naiveRoot(leaves []phase0.Root) phase0.Root {
+	layer := make([][]byte, len(leaves))
+	for i := range leaves {
+		layer[i] = leaves[i][:]
+	}
+	zero := make([]byte, 32)
+	for level := 0; level < 32; level++ {
+		if len(layer)%2 == 1 {
+			layer = append(layer, zero)
+		}
+		next := make([][]byte, len(layer)/2)
+		for i := range next {
+			next[i] = hash(layer[2*i], layer[2*i+1])
+		}
+		layer = next
+		zero = hash(zero, zero)
+	}
+	root := zero
+	if len(layer) > 0 {
+		root = layer[0]
+	}
+	length := make([]byte, 32)
+	binary.LittleEndian.PutUint64(length, uint64(len(leaves)))
+
+	return phase0.Root(hash(root, length))
+}
+
+// verifyProof verifies a deposit proof as per is_valid_merkle_branch().
+func verifyProof(leaf phase0.Root, proof [][]byte, index uint64, root phase0.Root) bool {
+	value := leaf[:]
+	for i := range proof {
+		if (index>>i)&0x1 == 1 {
+			value = hash(proof[i], value)
+		} else {
+			value = hash(value, proof[i])
+		}
+	}
+
+	return phase0.Root(value) == root
+}
+
+func leaves(n int) []phase0.Root {
+	res := make([]phase0.Root, n)
+	for i := range res {
+		res[i] = sha256.Sum256([]byte{byte(i), byte(i >> 8)})
+	}
+
+	return res
+}
+
+func TestEmpty(t *testing.T) {
+	tree := deposittree.New()
+	root := tree.Root()
+	require.Equal(t, "d70a234731285c6804c2a4f56711ddb8c82c99740f207854891028af34e27e5e", hex.EncodeToString(root[:]))
+	require.Equal(t, uint64(0), tree.DepositCount())
+
+	_, err := tree.Proof(0)
+	require.EqualError(t, err, "deposit 0 not in tree")
+
+	_, err = tree.Snapshot()
+	require.EqualError(t, err, "deposit tree has not been finalized")
+}
+
+func TestRootAndProofs(t *testing.T) {
+	for _, n := range []int{1, 2, 3, 7, 8, 9, 33} {
+		tree := deposittree.New()
+		data := leaves(n)
+		for i := range data {
+			require.NoError(t, tree.PushLeaf(data[i]))
+		}
+		require.Equal(t, uint64(n), tree.DepositCount())
+		root := tree.Root()
+		require.Equal(t, naiveRoot(data), root)
+
+		for i := range data {
+			proof, err := tree.Proof(uint64(i))
+			require.NoError(t, err)
+			require.Len(t, proof, 33)
+			require.True(t, verifyProof(data[i], proof, uint64(i), root), "proof %d of %d invalid", i, n)
+		}
+	}
+}
+
+func TestAddDeposit(t *testing.T) {
+	data := &phase0.DepositData{
+		PublicKey:             phase0.BLSPubKey{0x01},
+		WithdrawalCredentials: make([]byte, 32),
+		Amount:                32000000000,
+		Signature:             phase0.BLSSignature{0x02},
+	}
+	leaf, err := data.HashTreeRoot()
+	require.NoError(t, err)
+
+	tree := deposittree.New()
+	require.NoError(t, tree.AddDeposit(data))
+	require.Equal(t, naiveRoot([]phase0.Root{leaf}), tree.Root())
+
+	require.EqualError(t, tree.AddDeposit(nil), "no deposit data specified")
+}
+
+func TestFinalizeAndSnapshot(t *testing.T) {
+	data := leaves(20)
+	for _, finalize := range []uint64{0, 1, 5, 8, 13, 16} {
+		tree := deposittree.New()
+		for i := 0; i < 16; i++ {
+			require.NoError(t, tree.PushLeaf(data[i]))
+		}
+		require.NoError(t, tree.Finalize(&phase0.ETH1Data{
+			DepositRoot:  tree.Root(),
+			DepositCount: finalize,
+			BlockHash:    make([]byte, 32),
+		}, 1234))
+		require.Equal(t, naiveRoot(data[:16]), tree.Root())
+
+		snapshot, err := tree.Snapshot()
+		require.NoError(t, err)
+		require.Equal(t, finalize, snapshot.DepositCount)
+		require.Equal(t, uint64(1234), snapshot.ExecutionBlockHeight)
+		require.Equal(t, naiveRoot(data[:finalize]), snapshot.DepositRoot)
+
+		restored, err := deposittree.NewFromSnapshot(snapshot)
+		require.NoError(t, err)
+		require.Equal(t, snapshot.DepositRoot, restored.Root())
+		for i := finalize; i < uint64(len(data)); i++ {
+			require.NoError(t, restored.PushLeaf(data[i]))
+		}
+		root := restored.Root()
+		require.Equal(t, naiveRoot(data), root)
+
+		if finalize > 0 {
+			_, err := restored.Proof(finalize - 1)
+			require.Error(t, err)
+		}
+		for i := finalize; i < uint64(len(data)); i++ {
+			proof, err := restored.Proof(i)
+			require.NoError(t, err)
+			require.True(t, verifyProof(data[i], proof, i, root), "proof %d invalid after finalizing %d", i, finalize)
+		}
+	}
+}
+
+func TestFinalizeErrors(t *testing.T) {
+	tree := deposittree.New()
+	require.NoError(t, tree.PushLeaf(phase0.Root{0x01}))
+
+	require.EqualError(t, tree.Finalize(nil, 0), "no eth1 data specified")
+	require.EqualError(t, tree.Finalize(&phase0.ETH1Data{DepositCount: 1}, 0), "incorrect length 0 for block hash")
+	require.EqualError(t, tree.Finalize(&phase0.ETH1Data{DepositCount: 2, BlockHash: make([]byte, 32)}, 0), "cannot finalize 2 deposits; tree only contains 1")
+}
+
+func TestNewFromSnapshotErrors(t *testing.T) {
+	_, err := deposittree.NewFromSnapshot(nil)
+	require.EqualError(t, err, "no snapshot specified")
+
+	tree := deposittree.New()
+	for _, leaf := range leaves(3) {
+		require.NoError(t, tree.PushLeaf(leaf))
+	}
+	require.NoError(t, tree.Finalize(&phase0.ETH1Data{DepositCount: 3, BlockHash: make([]byte, 32)}, 1))
+	snapshot, err := tree.Snapshot()
+	require.NoError(t, err)
+	require.Len(t, snapshot.Finalized, 2)
+
+	snapshot.DepositRoot = phase0.Root{}
+	_, err = deposittree.NewFromSnapshot(snapshot)
+	require.ErrorContains(t, err, "does not match calculated root")
+
+	snapshot.Finalized = snapshot.Finalized[1:]
+	_, err = deposittree.NewFromSnapshot(snapshot)
+	require.EqualError(t, err, "insufficient finalized roots in snapshot")
+}
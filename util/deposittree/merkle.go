@@ -0,0 +1,281 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deposittree
+
+import (
+	"crypto/sha256"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// depth is the depth of the deposit contract's Merkle tree.
+const depth = 32
+
+// zeroHashes are the roots of empty subtrees at each level.
+var zeroHashes [depth + 1]phase0.Root
+
+func init() {
+	for i := 1; i <= depth; i++ {
+		zeroHashes[i] = hashConcat(zeroHashes[i-1], zeroHashes[i-1])
+	}
+}
+
+// hashConcat returns the hash of the concatenation of two roots.
+func hashConcat(left phase0.Root, right phase0.Root) phase0.Root {
+	data := make([]byte, 0, 64)
+	data = append(data, left[:]...)
+	data = append(data, right[:]...)
+
+	return sha256.Sum256(data)
+}
+
+// node is a node in the sparse Merkle tree defined by EIP-4881.
+type node interface {
+	// root returns the root of the subtree.
+	root() phase0.Root
+	// isFull returns true if the subtree has no space for further leaves.
+	isFull() bool
+	// pushLeaf adds a leaf to the subtree, returning the updated subtree.
+	pushLeaf(leaf phase0.Root, level uint64) (node, error)
+	// finalize finalizes the first deposits of the subtree, returning the updated subtree.
+	finalize(deposits uint64, level uint64) (node, error)
+	// finalized appends the finalized roots of the subtree, returning the number of finalized deposits.
+	finalized(roots []phase0.Root) (uint64, []phase0.Root)
+}
+
+// finalizedNode is a subtree in which all deposits have been finalized.
+type finalizedNode struct {
+	deposits uint64
+	hash     phase0.Root
+}
+
+func (n *finalizedNode) root() phase0.Root {
+	return n.hash
+}
+
+func (*finalizedNode) isFull() bool {
+	return true
+}
+
+func (*finalizedNode) pushLeaf(_ phase0.Root, _ uint64) (node, error) {
+	return nil, errors.New("cannot push leaf to finalized subtree")
+}
+
+func (n *finalizedNode) finalize(_ uint64, _ uint64) (node, error) {
+	return n, nil
+}
+
+func (n *finalizedNode) finalized(roots []phase0.Root) (uint64, []phase0.Root) {
+	return n.deposits, append(roots, n.hash)
+}
+
+// leafNode is a single deposit.
+type leafNode struct {
+	hash phase0.Root
+}
+
+func (n *leafNode) root() phase0.Root {
+	return n.hash
+}
+
+func (*leafNode) isFull() bool {
+	return true
+}
+
+func (*leafNode) pushLeaf(_ phase0.Root, _ uint64) (node, error) {
+	return nil, errors.New("cannot push leaf to leaf")
+}
+
+func (n *leafNode) finalize(_ uint64, _ uint64) (node, error) {
+	return &finalizedNode{
+		deposits: 1,
+		hash:     n.hash,
+	}, nil
+}
+
+func (*leafNode) finalized(roots []phase0.Root) (uint64, []phase0.Root) {
+	return 0, roots
+}
+
+// innerNode is a subtree with two children.
+type innerNode struct {
+	left  node
+	right node
+}
+
+func (n *innerNode) root() phase0.Root {
+	return hashConcat(n.left.root(), n.right.root())
+}
+
+func (n *innerNode) isFull() bool {
+	return n.right.isFull()
+}
+
+func (n *innerNode) pushLeaf(leaf phase0.Root, level uint64) (node, error) {
+	var err error
+	if !n.left.isFull() {
+		n.left, err = n.left.pushLeaf(leaf, level-1)
+	} else {
+		n.right, err = n.right.pushLeaf(leaf, level-1)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return n, nil
+}
+
+func (n *innerNode) finalize(deposits uint64, level uint64) (node, error) {
+	capacity := uint64(1) << level
+	if capacity <= deposits {
+		return &finalizedNode{
+			deposits: capacity,
+			hash:     n.root(),
+		}, nil
+	}
+
+	var err error
+	n.left, err = n.left.finalize(deposits, level-1)
+	if err != nil {
+		return nil, err
+	}
+	if deposits > capacity/2 {
+		n.right, err = n.right.finalize(deposits-capacity/2, level-1)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return n, nil
+}
+
+func (n *innerNode) finalized(roots []phase0.Root) (uint64, []phase0.Root) {
+	leftDeposits, roots := n.left.finalized(roots)
+	rightDeposits, roots := n.right.finalized(roots)
+
+	return leftDeposits + rightDeposits, roots
+}
+
+// zeroNode is an empty subtree.
+type zeroNode struct {
+	level uint64
+}
+
+func (n *zeroNode) root() phase0.Root {
+	return zeroHashes[n.level]
+}
+
+func (*zeroNode) isFull() bool {
+	return false
+}
+
+func (*zeroNode) pushLeaf(leaf phase0.Root, level uint64) (node, error) {
+	return create([]phase0.Root{leaf}, level), nil
+}
+
+func (*zeroNode) finalize(_ uint64, _ uint64) (node, error) {
+	return nil, errors.New("cannot finalize empty subtree")
+}
+
+func (*zeroNode) finalized(roots []phase0.Root) (uint64, []phase0.Root) {
+	return 0, roots
+}
+
+// create creates a subtree from the given leaves.
+func create(leaves []phase0.Root, level uint64) node {
+	if len(leaves) == 0 {
+		return &zeroNode{level: level}
+	}
+	if level == 0 {
+		return &leafNode{hash: leaves[0]}
+	}
+
+	split := uint64(1) << (level - 1)
+	if split > uint64(len(leaves)) {
+		split = uint64(len(leaves))
+	}
+
+	return &innerNode{
+		left:  create(leaves[:split], level-1),
+		right: create(leaves[split:], level-1),
+	}
+}
+
+// fromSnapshotParts creates a subtree from the finalized roots of a snapshot.
+func fromSnapshotParts(finalized []phase0.Root, deposits uint64, level uint64) (node, error) {
+	if len(finalized) == 0 || deposits == 0 {
+		return &zeroNode{level: level}, nil
+	}
+	if deposits == uint64(1)<<level {
+		return &finalizedNode{
+			deposits: deposits,
+			hash:     finalized[0],
+		}, nil
+	}
+	if level == 0 {
+		return nil, errors.New("too many deposits for tree")
+	}
+
+	leftCapacity := uint64(1) << (level - 1)
+	if deposits <= leftCapacity {
+		left, err := fromSnapshotParts(finalized, deposits, level-1)
+		if err != nil {
+			return nil, err
+		}
+
+		return &innerNode{
+			left:  left,
+			right: &zeroNode{level: level - 1},
+		}, nil
+	}
+
+	right, err := fromSnapshotParts(finalized[1:], deposits-leftCapacity, level-1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &innerNode{
+		left: &finalizedNode{
+			deposits: leftCapacity,
+			hash:     finalized[0],
+		},
+		right: right,
+	}, nil
+}
+
+// generateProof generates the Merkle branch for the leaf at the given index.
+func generateProof(tree node, index uint64, level uint64) (phase0.Root, []phase0.Root, error) {
+	proof := make([]phase0.Root, level)
+	current := tree
+	for level > 0 {
+		inner, isInner := current.(*innerNode)
+		if !isInner {
+			return phase0.Root{}, nil, errors.New("leaf not available in tree")
+		}
+		if (index>>(level-1))&0x1 == 1 {
+			proof[level-1] = inner.left.root()
+			current = inner.right
+		} else {
+			proof[level-1] = inner.right.root()
+			current = inner.left
+		}
+		level--
+	}
+	if _, isLeaf := current.(*leafNode); !isLeaf {
+		return phase0.Root{}, nil, errors.New("leaf not available in tree")
+	}
+
+	return current.root(), proof, nil
+}
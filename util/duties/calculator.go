@@ -0,0 +1,355 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package duties provides local calculation of beacon committees, proposer
+// duties and sync committees from a beacon state, without requiring a beacon node.
+package duties
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// Calculator calculates duties from a beacon state.
+type Calculator struct {
+	state      *spec.VersionedBeaconState
+	config     *config
+	slot       phase0.Slot
+	epoch      phase0.Epoch
+	validators []*phase0.Validator
+	randaoMix  []phase0.Root
+
+	// shuffledMu protects shuffled.
+	shuffledMu sync.Mutex
+	// shuffled holds the shuffled active validator indices by epoch.
+	shuffled map[phase0.Epoch][]phase0.ValidatorIndex
+}
+
+// New creates a new duties calculator for the given state.
+// The spec is that returned by the Spec() provider.
+func New(state *spec.VersionedBeaconState, specValues map[string]any) (*Calculator, error) {
+	if state == nil {
+		return nil, errors.New("no state supplied")
+	}
+	config, err := newConfig(specValues, state.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	slot, err := state.Slot()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain slot")
+	}
+	validators, err := state.Validators()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain validators")
+	}
+	randaoMixes, err := state.RANDAOMixes()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain RANDAO mixes")
+	}
+	if uint64(len(randaoMixes)) != config.epochsPerHistoricalVector {
+		return nil, fmt.Errorf("state has %d RANDAO mixes but expected %d", len(randaoMixes), config.epochsPerHistoricalVector)
+	}
+
+	return &Calculator{
+		state:      state,
+		config:     config,
+		slot:       slot,
+		epoch:      phase0.Epoch(uint64(slot) / config.slotsPerEpoch),
+		validators: validators,
+		randaoMix:  randaoMixes,
+		shuffled:   make(map[phase0.Epoch][]phase0.ValidatorIndex),
+	}, nil
+}
+
+// BeaconCommittees returns the beacon committees for the given epoch.
+// The epoch must be no later than the epoch after that of the state.
+func (c *Calculator) BeaconCommittees(epoch phase0.Epoch) ([]*apiv1.BeaconCommittee, error) {
+	shuffled, err := c.shuffledIndices(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	committeesPerSlot := c.committeeCountPerSlot(uint64(len(shuffled)))
+	count := committeesPerSlot * c.config.slotsPerEpoch
+	startSlot := phase0.Slot(uint64(epoch) * c.config.slotsPerEpoch)
+	res := make([]*apiv1.BeaconCommittee, 0, count)
+	for i := uint64(0); i < c.config.slotsPerEpoch; i++ {
+		for j := uint64(0); j < committeesPerSlot; j++ {
+			index := i*committeesPerSlot + j
+			start := uint64(len(shuffled)) * index / count
+			end := uint64(len(shuffled)) * (index + 1) / count
+			validators := make([]phase0.ValidatorIndex, end-start)
+			copy(validators, shuffled[start:end])
+			res = append(res, &apiv1.BeaconCommittee{
+				Slot:       startSlot + phase0.Slot(i),
+				Index:      phase0.CommitteeIndex(j),
+				Validators: validators,
+			})
+		}
+	}
+
+	return res, nil
+}
+
+// ProposerDuties returns the proposer duties for the given epoch.
+// The epoch must be that of the state, as proposer selection depends on
+// effective balances which can change at each epoch transition.
+func (c *Calculator) ProposerDuties(epoch phase0.Epoch) ([]*apiv1.ProposerDuty, error) {
+	if epoch != c.epoch {
+		return nil, fmt.Errorf("proposer duties can only be calculated for the epoch of the state (%d)", c.epoch)
+	}
+
+	indices := c.activeIndices(epoch)
+	if len(indices) == 0 {
+		return nil, errors.New("no active validators")
+	}
+	epochSeed, err := c.seed(epoch, c.config.domainBeaconProposer)
+	if err != nil {
+		return nil, err
+	}
+
+	startSlot := phase0.Slot(uint64(epoch) * c.config.slotsPerEpoch)
+	res := make([]*apiv1.ProposerDuty, 0, c.config.slotsPerEpoch)
+	buf := make([]byte, 40)
+	copy(buf, epochSeed[:])
+	for i := uint64(0); i < c.config.slotsPerEpoch; i++ {
+		slot := startSlot + phase0.Slot(i)
+		binary.LittleEndian.PutUint64(buf[32:], uint64(slot))
+		index := c.proposerIndex(indices, sha256.Sum256(buf))
+		res = append(res, &apiv1.ProposerDuty{
+			PubKey:         c.validators[index].PublicKey,
+			Slot:           slot,
+			ValidatorIndex: index,
+		})
+	}
+
+	return res, nil
+}
+
+// SyncCommittee returns the sync committee for the given epoch, as held in
+// the state.  The epoch must be in the sync committee period of the state,
+// or the period following it.
+func (c *Calculator) SyncCommittee(epoch phase0.Epoch) (*apiv1.SyncCommittee, error) {
+	if c.state.Version == spec.DataVersionPhase0 {
+		return nil, errors.New("sync committees not supported by phase0 state")
+	}
+
+	statePeriod := uint64(c.epoch) / c.config.epochsPerSyncCommitteePeriod
+	period := uint64(epoch) / c.config.epochsPerSyncCommitteePeriod
+
+	var pubKeys []phase0.BLSPubKey
+	switch period {
+	case statePeriod:
+		syncCommittee, err := c.state.CurrentSyncCommittee()
+		if err != nil {
+			return nil, err
+		}
+		if syncCommittee == nil {
+			return nil, errors.New("no current sync committee in state")
+		}
+		pubKeys = syncCommittee.Pubkeys
+	case statePeriod + 1:
+		syncCommittee, err := c.state.NextSyncCommittee()
+		if err != nil {
+			return nil, err
+		}
+		if syncCommittee == nil {
+			return nil, errors.New("no next sync committee in state")
+		}
+		pubKeys = syncCommittee.Pubkeys
+	default:
+		return nil, fmt.Errorf("sync committee for epoch %d not available in state", epoch)
+	}
+
+	indices := make(map[phase0.BLSPubKey]phase0.ValidatorIndex, len(c.validators))
+	for i := range c.validators {
+		indices[c.validators[i].PublicKey] = phase0.ValidatorIndex(i)
+	}
+	validators := make([]phase0.ValidatorIndex, len(pubKeys))
+	for i := range pubKeys {
+		index, exists := indices[pubKeys[i]]
+		if !exists {
+			return nil, fmt.Errorf("sync committee member %#x not found in validators", pubKeys[i])
+		}
+		validators[i] = index
+	}
+
+	return c.syncCommittee(validators), nil
+}
+
+// SelectSyncCommittee selects a sync committee, as per get_next_sync_committee_indices()
+// in the specification.  The epoch is that used for selection, which is the first
+// epoch of the sync committee period before that in which the committee serves.
+// Selection uses the effective balances in the state, so will only match the
+// selection made by the chain if the state is for the epoch prior to that given.
+func (c *Calculator) SelectSyncCommittee(epoch phase0.Epoch) (*apiv1.SyncCommittee, error) {
+	if c.state.Version == spec.DataVersionPhase0 {
+		return nil, errors.New("sync committees not supported by phase0 state")
+	}
+
+	indices := c.activeIndices(epoch)
+	if len(indices) == 0 {
+		return nil, errors.New("no active validators")
+	}
+	seed, err := c.seed(epoch, c.config.domainSyncCommittee)
+	if err != nil {
+		return nil, err
+	}
+
+	total := uint64(len(indices))
+	validators := make([]phase0.ValidatorIndex, 0, c.config.syncCommitteeSize)
+	buf := make([]byte, 40)
+	copy(buf, seed[:])
+	var randomBytes [32]byte
+	for i := uint64(0); uint64(len(validators)) < c.config.syncCommitteeSize; i++ {
+		candidate := indices[computeShuffledIndex(i%total, total, seed, c.config.shuffleRoundCount)]
+		if c.eligible(candidate, c.randomValue(buf, &randomBytes, i)) {
+			validators = append(validators, candidate)
+		}
+	}
+
+	return c.syncCommittee(validators), nil
+}
+
+// syncCommittee creates a sync committee from its validators, splitting
+// them in to subnet aggregates.
+func (c *Calculator) syncCommittee(validators []phase0.ValidatorIndex) *apiv1.SyncCommittee {
+	subnetSize := uint64(len(validators)) / c.config.syncCommitteeSubnetCount
+	aggregates := make([][]phase0.ValidatorIndex, c.config.syncCommitteeSubnetCount)
+	for i := range aggregates {
+		aggregates[i] = validators[uint64(i)*subnetSize : uint64(i+1)*subnetSize]
+	}
+
+	return &apiv1.SyncCommittee{
+		Validators:          validators,
+		ValidatorAggregates: aggregates,
+	}
+}
+
+// proposerIndex selects the proposer, as per compute_proposer_index() in the specification.
+func (c *Calculator) proposerIndex(indices []phase0.ValidatorIndex, seed phase0.Root) phase0.ValidatorIndex {
+	total := uint64(len(indices))
+	buf := make([]byte, 40)
+	copy(buf, seed[:])
+	var randomBytes [32]byte
+	for i := uint64(0); ; i++ {
+		candidate := indices[computeShuffledIndex(i%total, total, seed, c.config.shuffleRoundCount)]
+		if c.eligible(candidate, c.randomValue(buf, &randomBytes, i)) {
+			return candidate
+		}
+	}
+}
+
+// randomValue returns the random value for the given iteration of a
+// selection loop.  buf holds the seed followed by space for the hash
+// counter, and randomBytes holds the current hash, which is recalculated
+// as required.
+func (c *Calculator) randomValue(buf []byte, randomBytes *[32]byte, i uint64) uint64 {
+	valuesPerHash := 32 / c.config.randomValueSize
+	offset := (i % valuesPerHash) * c.config.randomValueSize
+	if offset == 0 {
+		binary.LittleEndian.PutUint64(buf[32:], i/valuesPerHash)
+		*randomBytes = sha256.Sum256(buf)
+	}
+	if c.config.randomValueSize == 2 {
+		return uint64(binary.LittleEndian.Uint16(randomBytes[offset:]))
+	}
+
+	return uint64(randomBytes[offset])
+}
+
+// eligible returns true if the candidate is selected given the random value,
+// with the chance of selection proportional to its effective balance.
+func (c *Calculator) eligible(candidate phase0.ValidatorIndex, randomValue uint64) bool {
+	effectiveBalance := uint64(c.validators[candidate].EffectiveBalance)
+	maxRandomValue := uint64(1)<<(8*c.config.randomValueSize) - 1
+
+	return effectiveBalance*maxRandomValue >= uint64(c.config.maxEffectiveBalance)*randomValue
+}
+
+// shuffledIndices returns the active validator indices for the epoch,
+// shuffled for committee assignment.
+func (c *Calculator) shuffledIndices(epoch phase0.Epoch) ([]phase0.ValidatorIndex, error) {
+	c.shuffledMu.Lock()
+	defer c.shuffledMu.Unlock()
+
+	if shuffled, exists := c.shuffled[epoch]; exists {
+		return shuffled, nil
+	}
+
+	seed, err := c.seed(epoch, c.config.domainBeaconAttester)
+	if err != nil {
+		return nil, err
+	}
+	shuffled := c.activeIndices(epoch)
+	shuffleList(shuffled, seed, c.config.shuffleRoundCount)
+	c.shuffled[epoch] = shuffled
+
+	return shuffled, nil
+}
+
+// activeIndices returns the indices of the validators active at the given epoch.
+func (c *Calculator) activeIndices(epoch phase0.Epoch) []phase0.ValidatorIndex {
+	res := make([]phase0.ValidatorIndex, 0, len(c.validators))
+	for i, validator := range c.validators {
+		if validator.ActivationEpoch <= epoch && epoch < validator.ExitEpoch {
+			res = append(res, phase0.ValidatorIndex(i))
+		}
+	}
+
+	return res
+}
+
+// committeeCountPerSlot returns the number of committees in each slot.
+func (c *Calculator) committeeCountPerSlot(activeValidators uint64) uint64 {
+	count := activeValidators / c.config.slotsPerEpoch / c.config.targetCommitteeSize
+	if count > c.config.maxCommitteesPerSlot {
+		count = c.config.maxCommitteesPerSlot
+	}
+	if count == 0 {
+		count = 1
+	}
+
+	return count
+}
+
+// seed returns the seed for the given epoch and domain, as per get_seed() in the specification.
+func (c *Calculator) seed(epoch phase0.Epoch, domainType phase0.DomainType) (phase0.Root, error) {
+	if uint64(epoch) > uint64(c.epoch)+c.config.minSeedLookahead {
+		return phase0.Root{}, fmt.Errorf("epoch %d too far in the future for state", epoch)
+	}
+	// The mix used is that of epoch-minSeedLookahead-1, which must still be held
+	// in the state's RANDAO mixes and not overwritten by that of the state's epoch.
+	if uint64(epoch)+c.config.epochsPerHistoricalVector <= uint64(c.epoch)+c.config.minSeedLookahead+1 {
+		return phase0.Root{}, fmt.Errorf("epoch %d too far in the past for state", epoch)
+	}
+
+	mixEpoch := uint64(epoch) + c.config.epochsPerHistoricalVector - c.config.minSeedLookahead - 1
+	mix := c.randaoMix[mixEpoch%c.config.epochsPerHistoricalVector]
+
+	buf := make([]byte, 44)
+	copy(buf, domainType[:])
+	binary.LittleEndian.PutUint64(buf[4:], uint64(epoch))
+	copy(buf[12:], mix[:])
+
+	return sha256.Sum256(buf), nil
+}
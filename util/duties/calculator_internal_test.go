@@ -0,0 +1,410 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package duties
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func testSpec() map[string]any {
+	return map[string]any{
+		"SLOTS_PER_EPOCH":                  uint64(8),
+		"SHUFFLE_ROUND_COUNT":              uint64(10),
+		"TARGET_COMMITTEE_SIZE":            uint64(4),
+		"MAX_COMMITTEES_PER_SLOT":          uint64(4),
+		"MIN_SEED_LOOKAHEAD":               uint64(1),
+		"EPOCHS_PER_HISTORICAL_VECTOR":     uint64(64),
+		"EPOCHS_PER_SYNC_COMMITTEE_PERIOD": uint64(8),
+		"SYNC_COMMITTEE_SIZE":              uint64(32),
+		"SYNC_COMMITTEE_SUBNET_COUNT":      uint64(4),
+		"MAX_EFFECTIVE_BALANCE":            uint64(32000000000),
+		"DOMAIN_BEACON_PROPOSER":           phase0.DomainType{0x00, 0x00, 0x00, 0x00},
+		"DOMAIN_BEACON_ATTESTER":           phase0.DomainType{0x01, 0x00, 0x00, 0x00},
+		"DOMAIN_SYNC_COMMITTEE":            phase0.DomainType{0x07, 0x00, 0x00, 0x00},
+	}
+}
+
+func testState(t *testing.T, numValidators int, slot phase0.Slot) *spec.VersionedBeaconState {
+	t.Helper()
+
+	validators := make([]*phase0.Validator, numValidators)
+	for i := range validators {
+		validators[i] = &phase0.Validator{
+			PublicKey:        phase0.BLSPubKey{byte(i), byte(i >> 8), 0x01},
+			EffectiveBalance: phase0.Gwei(16000000000 + uint64(i%17)*1000000000),
+			ActivationEpoch:  0,
+			ExitEpoch:        0xffffffffffffffff,
+		}
+	}
+	// Some validators that are not active.
+	validators[3].ActivationEpoch = 100
+	validators[5].ExitEpoch = 1
+
+	randaoMixes := make([]phase0.Root, 64)
+	for i := range randaoMixes {
+		randaoMixes[i] = phase0.Root{byte(i), 0xaa}
+	}
+
+	currentSyncCommittee := &altair.SyncCommittee{Pubkeys: make([]phase0.BLSPubKey, 32)}
+	nextSyncCommittee := &altair.SyncCommittee{Pubkeys: make([]phase0.BLSPubKey, 32)}
+	for i := range currentSyncCommittee.Pubkeys {
+		currentSyncCommittee.Pubkeys[i] = validators[(i*2)%numValidators].PublicKey
+		nextSyncCommittee.Pubkeys[i] = validators[(i*2+1)%numValidators].PublicKey
+	}
+
+	return &spec.VersionedBeaconState{
+		Version: spec.DataVersionDeneb,
+		Deneb: &deneb.BeaconState{
+			Slot:                 slot,
+			Validators:           validators,
+			RANDAOMixes:          randaoMixes,
+			CurrentSyncCommittee: currentSyncCommittee,
+			NextSyncCommittee:    nextSyncCommittee,
+		},
+	}
+}
+
+// testElectraState returns the state from testState() as an Electra state.
+func testElectraState(t *testing.T, numValidators int, slot phase0.Slot) *spec.VersionedBeaconState {
+	t.Helper()
+
+	state := testState(t, numValidators, slot)
+
+	return &spec.VersionedBeaconState{
+		Version: spec.DataVersionElectra,
+		Electra: &electra.BeaconState{
+			Slot:                 state.Deneb.Slot,
+			Validators:           state.Deneb.Validators,
+			RANDAOMixes:          state.Deneb.RANDAOMixes,
+			CurrentSyncCommittee: state.Deneb.CurrentSyncCommittee,
+			NextSyncCommittee:    state.Deneb.NextSyncCommittee,
+		},
+	}
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name  string
+		state *spec.VersionedBeaconState
+		spec  map[string]any
+		err   string
+	}{
+		{
+			name: "StateNil",
+			spec: testSpec(),
+			err:  "no state supplied",
+		},
+		{
+			name:  "SpecNil",
+			state: testState(t, 10, 0),
+			err:   "no spec supplied",
+		},
+		{
+			name:  "SpecMissing",
+			state: testState(t, 10, 0),
+			spec:  map[string]any{},
+			err:   "SLOTS_PER_EPOCH not found in spec",
+		},
+		{
+			name:  "StateEmpty",
+			state: &spec.VersionedBeaconState{Version: spec.DataVersionDeneb},
+			spec:  testSpec(),
+			err:   "failed to obtain slot: no Deneb state",
+		},
+		{
+			name:  "Good",
+			state: testState(t, 10, 0),
+			spec:  testSpec(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := New(test.state, test.spec)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBeaconCommittees(t *testing.T) {
+	for _, numValidators := range []int{10, 100, 300} {
+		state := testState(t, numValidators, 20)
+		calculator, err := New(state, testSpec())
+		require.NoError(t, err)
+
+		for _, epoch := range []phase0.Epoch{1, 2, 3} {
+			committees, err := calculator.BeaconCommittees(epoch)
+			require.NoError(t, err)
+
+			// Calculate committees as per the specification.
+			active := calculator.activeIndices(epoch)
+			seed, err := calculator.seed(epoch, calculator.config.domainBeaconAttester)
+			require.NoError(t, err)
+			committeesPerSlot := calculator.committeeCountPerSlot(uint64(len(active)))
+			count := committeesPerSlot * 8
+			require.Len(t, committees, int(count))
+			for i, committee := range committees {
+				require.Equal(t, phase0.Slot(uint64(epoch)*8+uint64(i)/committeesPerSlot), committee.Slot)
+				require.Equal(t, phase0.CommitteeIndex(uint64(i)%committeesPerSlot), committee.Index)
+				start := uint64(len(active)) * uint64(i) / count
+				end := uint64(len(active)) * uint64(i+1) / count
+				expected := make([]phase0.ValidatorIndex, 0)
+				for j := start; j < end; j++ {
+					expected = append(expected, active[computeShuffledIndex(j, uint64(len(active)), seed, 10)])
+				}
+				require.Equal(t, expected, committee.Validators)
+			}
+		}
+	}
+}
+
+func TestBeaconCommitteesEpochs(t *testing.T) {
+	calculator, err := New(testState(t, 100, 8*70), testSpec())
+	require.NoError(t, err)
+
+	_, err = calculator.BeaconCommittees(72)
+	require.EqualError(t, err, "epoch 72 too far in the future for state")
+	_, err = calculator.BeaconCommittees(71)
+	require.NoError(t, err)
+	// Epoch 8 requires the mix for epoch 6, which has been overwritten by that of epoch 70.
+	_, err = calculator.BeaconCommittees(8)
+	require.EqualError(t, err, "epoch 8 too far in the past for state")
+	_, err = calculator.BeaconCommittees(9)
+	require.NoError(t, err)
+}
+
+func TestProposerDuties(t *testing.T) {
+	state := testState(t, 100, 20)
+	calculator, err := New(state, testSpec())
+	require.NoError(t, err)
+
+	_, err = calculator.ProposerDuties(3)
+	require.EqualError(t, err, "proposer duties can only be calculated for the epoch of the state (2)")
+
+	duties, err := calculator.ProposerDuties(2)
+	require.NoError(t, err)
+	require.Len(t, duties, 8)
+	active := make(map[phase0.ValidatorIndex]struct{})
+	for _, index := range calculator.activeIndices(2) {
+		active[index] = struct{}{}
+	}
+	for i, duty := range duties {
+		require.Equal(t, phase0.Slot(16+i), duty.Slot)
+		require.Contains(t, active, duty.ValidatorIndex)
+		require.Equal(t, state.Deneb.Validators[duty.ValidatorIndex].PublicKey, duty.PubKey)
+	}
+
+	// Calculation is deterministic.
+	duties2, err := calculator.ProposerDuties(2)
+	require.NoError(t, err)
+	require.Equal(t, duties, duties2)
+}
+
+// TestProposerDutiesVectors checks against values generated by a direct
+// transcription of get_seed() and compute_proposer_index() from the specification.
+func TestProposerDutiesVectors(t *testing.T) {
+	electraSpec := testSpec()
+	electraSpec["MAX_EFFECTIVE_BALANCE_ELECTRA"] = uint64(2048000000000)
+
+	tests := []struct {
+		name     string
+		state    *spec.VersionedBeaconState
+		spec     map[string]any
+		expected []phase0.ValidatorIndex
+	}{
+		{
+			name:     "Deneb",
+			state:    testState(t, 100, 20),
+			spec:     testSpec(),
+			expected: []phase0.ValidatorIndex{43, 32, 58, 73, 44, 15, 30, 88},
+		},
+		{
+			name:     "Electra",
+			state:    testElectraState(t, 100, 20),
+			spec:     electraSpec,
+			expected: []phase0.ValidatorIndex{32, 26, 46, 83, 27, 89, 66, 78},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			calculator, err := New(test.state, test.spec)
+			require.NoError(t, err)
+			duties, err := calculator.ProposerDuties(2)
+			require.NoError(t, err)
+			require.Len(t, duties, len(test.expected))
+			for i := range duties {
+				require.Equal(t, test.expected[i], duties[i].ValidatorIndex)
+			}
+		})
+	}
+}
+
+func TestProposerDutiesBalance(t *testing.T) {
+	state := testState(t, 100, 20)
+	// All validators have the maximum effective balance, so the first
+	// candidate is always selected.
+	for i := range state.Deneb.Validators {
+		state.Deneb.Validators[i].EffectiveBalance = 32000000000
+	}
+	calculator, err := New(state, testSpec())
+	require.NoError(t, err)
+
+	duties, err := calculator.ProposerDuties(2)
+	require.NoError(t, err)
+	active := calculator.activeIndices(2)
+	epochSeed, err := calculator.seed(2, calculator.config.domainBeaconProposer)
+	require.NoError(t, err)
+	for _, duty := range duties {
+		seed := sha256.Sum256(binary.LittleEndian.AppendUint64(epochSeed[:], uint64(duty.Slot)))
+		require.Equal(t, active[computeShuffledIndex(0, uint64(len(active)), seed, 10)], duty.ValidatorIndex)
+	}
+}
+
+func TestSyncCommittee(t *testing.T) {
+	state := testState(t, 100, 20)
+	calculator, err := New(state, testSpec())
+	require.NoError(t, err)
+
+	current, err := calculator.SyncCommittee(7)
+	require.NoError(t, err)
+	require.Len(t, current.Validators, 32)
+	for i := range current.Validators {
+		require.Equal(t, phase0.ValidatorIndex(i*2), current.Validators[i])
+	}
+	require.Len(t, current.ValidatorAggregates, 4)
+	for i := range current.ValidatorAggregates {
+		require.Equal(t, current.Validators[i*8:(i+1)*8], current.ValidatorAggregates[i])
+	}
+
+	next, err := calculator.SyncCommittee(8)
+	require.NoError(t, err)
+	require.Equal(t, phase0.ValidatorIndex(1), next.Validators[0])
+
+	_, err = calculator.SyncCommittee(16)
+	require.EqualError(t, err, "sync committee for epoch 16 not available in state")
+
+	state.Deneb.CurrentSyncCommittee.Pubkeys[0] = phase0.BLSPubKey{0xff}
+	_, err = calculator.SyncCommittee(0)
+	require.Error(t, err)
+
+	state.Deneb.CurrentSyncCommittee = nil
+	_, err = calculator.SyncCommittee(0)
+	require.EqualError(t, err, "no current sync committee in state")
+	state.Deneb.NextSyncCommittee = nil
+	_, err = calculator.SyncCommittee(8)
+	require.EqualError(t, err, "no next sync committee in state")
+}
+
+func TestSelectSyncCommittee(t *testing.T) {
+	state := testState(t, 100, 20)
+	calculator, err := New(state, testSpec())
+	require.NoError(t, err)
+
+	syncCommittee, err := calculator.SelectSyncCommittee(2)
+	require.NoError(t, err)
+	require.Len(t, syncCommittee.Validators, 32)
+	require.Len(t, syncCommittee.ValidatorAggregates, 4)
+	active := make(map[phase0.ValidatorIndex]struct{})
+	for _, index := range calculator.activeIndices(2) {
+		active[index] = struct{}{}
+	}
+	for _, index := range syncCommittee.Validators {
+		require.Contains(t, active, index)
+	}
+
+	// Selection can repeat validators if there are fewer than the committee size.
+	calculator, err = New(testState(t, 10, 20), testSpec())
+	require.NoError(t, err)
+	syncCommittee, err = calculator.SelectSyncCommittee(2)
+	require.NoError(t, err)
+	require.Len(t, syncCommittee.Validators, 32)
+}
+
+// TestSelectSyncCommitteeVectors checks against values generated by a direct
+// transcription of get_next_sync_committee_indices() from the specification.
+func TestSelectSyncCommitteeVectors(t *testing.T) {
+	electraSpec := testSpec()
+	electraSpec["MAX_EFFECTIVE_BALANCE_ELECTRA"] = uint64(2048000000000)
+
+	tests := []struct {
+		name     string
+		state    *spec.VersionedBeaconState
+		spec     map[string]any
+		expected []phase0.ValidatorIndex
+	}{
+		{
+			name:  "Deneb",
+			state: testState(t, 100, 20),
+			spec:  testSpec(),
+			expected: []phase0.ValidatorIndex{
+				39, 71, 59, 16, 82, 40, 14, 10, 31, 9, 26, 99, 49, 6, 64, 2,
+				47, 88, 48, 61, 11, 65, 13, 70, 30, 63, 83, 45, 66, 43, 15, 37,
+			},
+		},
+		{
+			name:  "Electra",
+			state: testElectraState(t, 100, 20),
+			spec:  electraSpec,
+			expected: []phase0.ValidatorIndex{
+				27, 23, 61, 30, 15, 67, 49, 34, 41, 14, 18, 89, 42, 49, 96, 1,
+				55, 8, 41, 82, 47, 31, 83, 57, 50, 6, 30, 29, 0, 69, 55, 16,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			calculator, err := New(test.state, test.spec)
+			require.NoError(t, err)
+			syncCommittee, err := calculator.SelectSyncCommittee(2)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, syncCommittee.Validators)
+		})
+	}
+}
+
+func TestPhase0SyncCommittee(t *testing.T) {
+	state := &spec.VersionedBeaconState{
+		Version: spec.DataVersionPhase0,
+		Phase0: &phase0.BeaconState{
+			Validators:  testState(t, 10, 0).Deneb.Validators,
+			RANDAOMixes: make([]phase0.Root, 64),
+		},
+	}
+	calculator, err := New(state, testSpec())
+	require.NoError(t, err)
+
+	_, err = calculator.SyncCommittee(0)
+	require.EqualError(t, err, "sync committees not supported by phase0 state")
+	_, err = calculator.SelectSyncCommittee(0)
+	require.EqualError(t, err, "sync committees not supported by phase0 state")
+
+	committees, err := calculator.BeaconCommittees(0)
+	require.NoError(t, err)
+	require.Len(t, committees, 8)
+}
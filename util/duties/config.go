@@ -0,0 +1,127 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package duties
+
+import (
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// config holds the spec values required to calculate duties.
+type config struct {
+	slotsPerEpoch                uint64
+	shuffleRoundCount            uint64
+	targetCommitteeSize          uint64
+	maxCommitteesPerSlot         uint64
+	minSeedLookahead             uint64
+	epochsPerHistoricalVector    uint64
+	epochsPerSyncCommitteePeriod uint64
+	syncCommitteeSize            uint64
+	syncCommitteeSubnetCount     uint64
+	maxEffectiveBalance          phase0.Gwei
+	randomValueSize              uint64
+	domainBeaconProposer         phase0.DomainType
+	domainBeaconAttester         phase0.DomainType
+	domainSyncCommittee          phase0.DomainType
+}
+
+// newConfig obtains the spec values required to calculate duties for the given version.
+func newConfig(specValues map[string]any, version spec.DataVersion) (*config, error) {
+	if specValues == nil {
+		return nil, errors.New("no spec supplied")
+	}
+
+	var err error
+	c := &config{}
+	for _, value := range []struct {
+		key string
+		dst *uint64
+	}{
+		{key: "SLOTS_PER_EPOCH", dst: &c.slotsPerEpoch},
+		{key: "SHUFFLE_ROUND_COUNT", dst: &c.shuffleRoundCount},
+		{key: "TARGET_COMMITTEE_SIZE", dst: &c.targetCommitteeSize},
+		{key: "MAX_COMMITTEES_PER_SLOT", dst: &c.maxCommitteesPerSlot},
+		{key: "MIN_SEED_LOOKAHEAD", dst: &c.minSeedLookahead},
+		{key: "EPOCHS_PER_HISTORICAL_VECTOR", dst: &c.epochsPerHistoricalVector},
+	} {
+		if *value.dst, err = specValue[uint64](specValues, value.key); err != nil {
+			return nil, err
+		}
+	}
+	if c.domainBeaconProposer, err = specValue[phase0.DomainType](specValues, "DOMAIN_BEACON_PROPOSER"); err != nil {
+		return nil, err
+	}
+	if c.domainBeaconAttester, err = specValue[phase0.DomainType](specValues, "DOMAIN_BEACON_ATTESTER"); err != nil {
+		return nil, err
+	}
+
+	// Electra increases both the maximum effective balance and the size of
+	// the random value used when selecting proposers and sync committees.
+	maxEffectiveBalanceKey := "MAX_EFFECTIVE_BALANCE"
+	c.randomValueSize = 1
+	if version == spec.DataVersionElectra {
+		maxEffectiveBalanceKey = "MAX_EFFECTIVE_BALANCE_ELECTRA"
+		c.randomValueSize = 2
+	}
+	maxEffectiveBalance, err := specValue[uint64](specValues, maxEffectiveBalanceKey)
+	if err != nil {
+		return nil, err
+	}
+	c.maxEffectiveBalance = phase0.Gwei(maxEffectiveBalance)
+
+	if version != spec.DataVersionPhase0 {
+		for _, value := range []struct {
+			key string
+			dst *uint64
+		}{
+			{key: "EPOCHS_PER_SYNC_COMMITTEE_PERIOD", dst: &c.epochsPerSyncCommitteePeriod},
+			{key: "SYNC_COMMITTEE_SIZE", dst: &c.syncCommitteeSize},
+			{key: "SYNC_COMMITTEE_SUBNET_COUNT", dst: &c.syncCommitteeSubnetCount},
+		} {
+			if *value.dst, err = specValue[uint64](specValues, value.key); err != nil {
+				return nil, err
+			}
+		}
+		if c.domainSyncCommittee, err = specValue[phase0.DomainType](specValues, "DOMAIN_SYNC_COMMITTEE"); err != nil {
+			return nil, err
+		}
+		if c.epochsPerSyncCommitteePeriod == 0 || c.syncCommitteeSubnetCount == 0 {
+			return nil, errors.New("invalid sync committee spec values")
+		}
+	}
+
+	if c.slotsPerEpoch == 0 || c.targetCommitteeSize == 0 || c.epochsPerHistoricalVector == 0 {
+		return nil, errors.New("invalid spec values")
+	}
+
+	return c, nil
+}
+
+// specValue obtains a typed value from the spec.
+func specValue[T any](data map[string]any, key string) (T, error) {
+	var res T
+	tmp, exists := data[key]
+	if !exists {
+		return res, fmt.Errorf("%s not found in spec", key)
+	}
+	res, isType := tmp.(T)
+	if !isType {
+		return res, fmt.Errorf("%s of unexpected type", key)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,122 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package duties
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+const (
+	seedSize      = 32
+	roundSize     = 1
+	positionSize  = 4
+	pivotViewSize = seedSize + roundSize
+	totalSize     = seedSize + roundSize + positionSize
+)
+
+// computeShuffledIndex returns the shuffled index for the given index, as per
+// compute_shuffled_index() in the specification.
+func computeShuffledIndex(index uint64, indexCount uint64, seed phase0.Root, rounds uint64) uint64 {
+	buf := make([]byte, totalSize)
+	copy(buf, seed[:])
+	for round := uint64(0); round < rounds; round++ {
+		buf[seedSize] = byte(round)
+		hash := sha256.Sum256(buf[:pivotViewSize])
+		pivot := binary.LittleEndian.Uint64(hash[:8]) % indexCount
+		flip := (pivot + indexCount - index) % indexCount
+		position := index
+		if flip > position {
+			position = flip
+		}
+		binary.LittleEndian.PutUint32(buf[pivotViewSize:], uint32(position/256))
+		source := sha256.Sum256(buf)
+		bit := (source[(position%256)/8] >> (position % 8)) % 2
+		if bit == 1 {
+			index = flip
+		}
+	}
+
+	return index
+}
+
+// shuffleList shuffles the list in place such that the element at position
+// i is the element previously at computeShuffledIndex(i) for all i.  This
+// provides the same result as calling computeShuffledIndex() for each element
+// of the list, but requires far fewer hashes.
+func shuffleList(list []phase0.ValidatorIndex, seed phase0.Root, rounds uint64) {
+	listSize := uint64(len(list))
+	if listSize < 2 || rounds == 0 {
+		return
+	}
+
+	buf := make([]byte, totalSize)
+	copy(buf, seed[:])
+	// Rounds are applied in reverse order to provide the mapping from
+	// shuffled to original position.
+	for r := rounds; r > 0; r-- {
+		buf[seedSize] = byte(r - 1)
+		hash := sha256.Sum256(buf[:pivotViewSize])
+		pivot := binary.LittleEndian.Uint64(hash[:8]) % listSize
+
+		// Swap pairs that mirror around the pivot, for those before the pivot.
+		mirror := (pivot + 1) >> 1
+		binary.LittleEndian.PutUint32(buf[pivotViewSize:], uint32(pivot>>8))
+		source := sha256.Sum256(buf)
+		byteV := source[(pivot&0xff)>>3]
+		for i, j := uint64(0), pivot; i < mirror; i, j = i+1, j-1 {
+			byteV, source = swapOrNot(buf, byteV, i, list, j, source)
+		}
+
+		// Repeat for the pairs that mirror around the pivot after the pivot.
+		mirror = (pivot + listSize + 1) >> 1
+		end := listSize - 1
+		binary.LittleEndian.PutUint32(buf[pivotViewSize:], uint32(end>>8))
+		source = sha256.Sum256(buf)
+		byteV = source[(end&0xff)>>3]
+		for i, j := pivot+1, end; i < mirror; i, j = i+1, j-1 {
+			byteV, source = swapOrNot(buf, byteV, i, list, j, source)
+		}
+	}
+}
+
+// swapOrNot swaps the elements at i and j if the bit for j in the source is
+// set, recalculating the source as required.
+func swapOrNot(buf []byte,
+	byteV byte,
+	i uint64,
+	list []phase0.ValidatorIndex,
+	j uint64,
+	source [32]byte,
+) (
+	byte,
+	[32]byte,
+) {
+	if j&0xff == 0xff {
+		// Moved to a new block of 256 positions, so need a new source.
+		binary.LittleEndian.PutUint32(buf[pivotViewSize:], uint32(j>>8))
+		source = sha256.Sum256(buf)
+	}
+	if j&0x7 == 0x7 {
+		// Moved to a new byte.
+		byteV = source[(j&0xff)>>3]
+	}
+	if (byteV>>(j&0x7))&0x1 == 1 {
+		list[i], list[j] = list[j], list[i]
+	}
+
+	return byteV, source
+}
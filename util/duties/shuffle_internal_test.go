@@ -0,0 +1,81 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package duties
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShuffleList(t *testing.T) {
+	seeds := []phase0.Root{
+		{},
+		{0x01, 0x02, 0x03},
+		{0xff, 0xee, 0xdd, 0xcc, 0xbb, 0xaa, 0x99, 0x88, 0x77, 0x66, 0x55, 0x44, 0x33, 0x22, 0x11, 0x00},
+	}
+	for _, size := range []int{0, 1, 2, 3, 7, 100, 255, 256, 257, 1000} {
+		for _, seed := range seeds {
+			list := make([]phase0.ValidatorIndex, size)
+			for i := range list {
+				list[i] = phase0.ValidatorIndex(i * 3)
+			}
+			shuffled := make([]phase0.ValidatorIndex, size)
+			copy(shuffled, list)
+			shuffleList(shuffled, seed, 90)
+
+			for i := range shuffled {
+				require.Equal(t, list[computeShuffledIndex(uint64(i), uint64(size), seed, 90)], shuffled[i])
+			}
+		}
+	}
+}
+
+// TestComputeShuffledIndexVectors checks against values generated by a direct
+// transcription of compute_shuffled_index() from the specification.
+func TestComputeShuffledIndexVectors(t *testing.T) {
+	seed := phase0.Root(sha256.Sum256([]byte("shuffle")))
+	tests := []struct {
+		name     string
+		count    uint64
+		expected []uint64
+	}{
+		{
+			name:     "Count10",
+			count:    10,
+			expected: []uint64{2, 3, 8, 5, 7, 6, 9, 0, 1, 4},
+		},
+		{
+			name:     "Count1000",
+			count:    1000,
+			expected: []uint64{74, 98, 369, 727, 452, 477, 11, 164, 6, 580},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			list := make([]phase0.ValidatorIndex, test.count)
+			for i := range list {
+				list[i] = phase0.ValidatorIndex(i)
+			}
+			shuffleList(list, seed, 90)
+			for i := range test.expected {
+				require.Equal(t, test.expected[i], computeShuffledIndex(uint64(i), test.count, seed, 90))
+				require.Equal(t, phase0.ValidatorIndex(test.expected[i]), list[i])
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proofs
+
+import (
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/pkg/errors"
+)
+
+// fieldRootsHasher wraps the SSZ hasher to capture the root of each
+// top-level field of a container as it is hashed.
+type fieldRootsHasher struct {
+	*ssz.Hasher
+	depth int
+	roots []phase0.Root
+}
+
+// record captures the most recent root if it belongs to a top-level field.
+func (h *fieldRootsHasher) record() {
+	if h.depth != 1 {
+		return
+	}
+	var root phase0.Root
+	copy(root[:], h.Hash())
+	h.roots = append(h.roots, root)
+}
+
+// Index implements ssz.HashWalker.
+func (h *fieldRootsHasher) Index() int {
+	h.depth++
+
+	return h.Hasher.Index()
+}
+
+// Merkleize implements ssz.HashWalker.
+func (h *fieldRootsHasher) Merkleize(indx int) {
+	h.Hasher.Merkleize(indx)
+	h.depth--
+	h.record()
+}
+
+// MerkleizeWithMixin implements ssz.HashWalker.
+func (h *fieldRootsHasher) MerkleizeWithMixin(indx int, num, limit uint64) {
+	h.Hasher.MerkleizeWithMixin(indx, num, limit)
+	h.depth--
+	h.record()
+}
+
+// PutUint64 implements ssz.HashWalker.
+func (h *fieldRootsHasher) PutUint64(i uint64) {
+	h.Hasher.PutUint64(i)
+	h.record()
+}
+
+// PutUint32 implements ssz.HashWalker.
+func (h *fieldRootsHasher) PutUint32(i uint32) {
+	h.Hasher.PutUint32(i)
+	h.record()
+}
+
+// PutUint16 implements ssz.HashWalker.
+func (h *fieldRootsHasher) PutUint16(i uint16) {
+	h.Hasher.PutUint16(i)
+	h.record()
+}
+
+// PutUint8 implements ssz.HashWalker.
+func (h *fieldRootsHasher) PutUint8(i uint8) {
+	h.Hasher.PutUint8(i)
+	h.record()
+}
+
+// PutBitlist implements ssz.HashWalker.
+func (h *fieldRootsHasher) PutBitlist(bb []byte, maxSize uint64) {
+	h.Hasher.PutBitlist(bb, maxSize)
+	h.record()
+}
+
+// PutBool implements ssz.HashWalker.
+func (h *fieldRootsHasher) PutBool(b bool) {
+	h.Hasher.PutBool(b)
+	h.record()
+}
+
+// PutBytes implements ssz.HashWalker.
+func (h *fieldRootsHasher) PutBytes(b []byte) {
+	h.Hasher.PutBytes(b)
+	h.record()
+}
+
+// stateFieldRoots returns the roots of the top-level fields of the state.
+func stateFieldRoots(state *spec.VersionedBeaconState) ([]phase0.Root, error) {
+	var obj interface {
+		HashTreeRootWith(hh ssz.HashWalker) error
+	}
+	switch state.Version {
+	case spec.DataVersionPhase0:
+		if state.Phase0 == nil {
+			return nil, errors.New("no Phase0 state")
+		}
+		obj = state.Phase0
+	case spec.DataVersionAltair:
+		if state.Altair == nil {
+			return nil, errors.New("no Altair state")
+		}
+		obj = state.Altair
+	case spec.DataVersionBellatrix:
+		if state.Bellatrix == nil {
+			return nil, errors.New("no Bellatrix state")
+		}
+		obj = state.Bellatrix
+	case spec.DataVersionCapella:
+		if state.Capella == nil {
+			return nil, errors.New("no Capella state")
+		}
+		obj = state.Capella
+	case spec.DataVersionDeneb:
+		if state.Deneb == nil {
+			return nil, errors.New("no Deneb state")
+		}
+		obj = state.Deneb
+	case spec.DataVersionElectra:
+		if state.Electra == nil {
+			return nil, errors.New("no Electra state")
+		}
+		obj = state.Electra
+	default:
+		return nil, errors.New("unknown version")
+	}
+
+	hh := &fieldRootsHasher{
+		Hasher: ssz.NewHasher(),
+	}
+	if err := obj.HashTreeRootWith(hh); err != nil {
+		return nil, errors.Wrap(err, "failed to hash state")
+	}
+
+	return hh.roots, nil
+}
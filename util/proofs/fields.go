@@ -0,0 +1,113 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proofs
+
+import (
+	"github.com/attestantio/go-eth2-client/spec"
+)
+
+var phase0StateFields = []string{
+	"genesis_time",
+	"genesis_validators_root",
+	"slot",
+	"fork",
+	"latest_block_header",
+	"block_roots",
+	"state_roots",
+	"historical_roots",
+	"eth1_data",
+	"eth1_data_votes",
+	"eth1_deposit_index",
+	"validators",
+	"balances",
+	"randao_mixes",
+	"slashings",
+	"previous_epoch_attestations",
+	"current_epoch_attestations",
+	"justification_bits",
+	"previous_justified_checkpoint",
+	"current_justified_checkpoint",
+	"finalized_checkpoint",
+}
+
+var altairStateFields = []string{
+	"genesis_time",
+	"genesis_validators_root",
+	"slot",
+	"fork",
+	"latest_block_header",
+	"block_roots",
+	"state_roots",
+	"historical_roots",
+	"eth1_data",
+	"eth1_data_votes",
+	"eth1_deposit_index",
+	"validators",
+	"balances",
+	"randao_mixes",
+	"slashings",
+	"previous_epoch_participation",
+	"current_epoch_participation",
+	"justification_bits",
+	"previous_justified_checkpoint",
+	"current_justified_checkpoint",
+	"finalized_checkpoint",
+	"inactivity_scores",
+	"current_sync_committee",
+	"next_sync_committee",
+}
+
+var bellatrixStateFields = append(append([]string{}, altairStateFields...),
+	"latest_execution_payload_header",
+)
+
+var capellaStateFields = append(append([]string{}, bellatrixStateFields...),
+	"next_withdrawal_index",
+	"next_withdrawal_validator_index",
+	"historical_summaries",
+)
+
+var denebStateFields = capellaStateFields
+
+var electraStateFields = append(append([]string{}, denebStateFields...),
+	"deposit_requests_start_index",
+	"deposit_balance_to_consume",
+	"exit_balance_to_consume",
+	"earliest_exit_epoch",
+	"consolidation_balance_to_consume",
+	"earliest_consolidation_epoch",
+	"pending_balance_deposits",
+	"pending_partial_withdrawals",
+	"pending_consolidations",
+)
+
+// stateFields returns the names of the top-level fields of the state for the given version.
+func stateFields(version spec.DataVersion) []string {
+	switch version {
+	case spec.DataVersionPhase0:
+		return phase0StateFields
+	case spec.DataVersionAltair:
+		return altairStateFields
+	case spec.DataVersionBellatrix:
+		return bellatrixStateFields
+	case spec.DataVersionCapella:
+		return capellaStateFields
+	case spec.DataVersionDeneb:
+		return denebStateFields
+	case spec.DataVersionElectra:
+		return electraStateFields
+	default:
+		return nil
+	}
+}
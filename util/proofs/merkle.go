@@ -0,0 +1,113 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proofs
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// maxDepth is the maximum depth of a subtree for which proofs are generated.
+const maxDepth = 64
+
+// zeroHashes are the roots of empty subtrees at each level.
+var zeroHashes [maxDepth + 1]phase0.Root
+
+func init() {
+	for i := 1; i <= maxDepth; i++ {
+		zeroHashes[i] = hashConcat(zeroHashes[i-1], zeroHashes[i-1])
+	}
+}
+
+// hashConcat returns the hash of the concatenation of two roots.
+func hashConcat(left phase0.Root, right phase0.Root) phase0.Root {
+	data := make([]byte, 0, 64)
+	data = append(data, left[:]...)
+	data = append(data, right[:]...)
+
+	return sha256.Sum256(data)
+}
+
+// lengthRoot returns the chunk mixed in to the root of a list of the given length.
+func lengthRoot(length uint64) phase0.Root {
+	var root phase0.Root
+	binary.LittleEndian.PutUint64(root[:8], length)
+
+	return root
+}
+
+// depthFor returns the depth of a tree with the given number of leaves.
+func depthFor(leaves uint64) uint64 {
+	depth := uint64(0)
+	for (uint64(1) << depth) < leaves {
+		depth++
+	}
+
+	return depth
+}
+
+// tree holds the populated levels of a Merkle tree whose remaining leaves are zero.
+type tree struct {
+	depth  uint64
+	levels [][]phase0.Root
+}
+
+// newTree creates a tree of the given depth from the given leaves.
+func newTree(leaves []phase0.Root, depth uint64) *tree {
+	levels := make([][]phase0.Root, depth+1)
+	levels[0] = leaves
+	for level := uint64(0); level < depth; level++ {
+		nodes := levels[level]
+		parents := make([]phase0.Root, (len(nodes)+1)/2)
+		for i := range parents {
+			right := zeroHashes[level]
+			if 2*i+1 < len(nodes) {
+				right = nodes[2*i+1]
+			}
+			parents[i] = hashConcat(nodes[2*i], right)
+		}
+		levels[level+1] = parents
+	}
+
+	return &tree{
+		depth:  depth,
+		levels: levels,
+	}
+}
+
+// root returns the root of the tree.
+func (t *tree) root() phase0.Root {
+	if len(t.levels[t.depth]) == 0 {
+		return zeroHashes[t.depth]
+	}
+
+	return t.levels[t.depth][0]
+}
+
+// branch returns the branch for the leaf at the given index, from the leaf upwards.
+func (t *tree) branch(index uint64) []phase0.Root {
+	branch := make([]phase0.Root, t.depth)
+	for level := uint64(0); level < t.depth; level++ {
+		sibling := (index >> level) ^ 1
+		if sibling < uint64(len(t.levels[level])) {
+			branch[level] = t.levels[level][sibling]
+		} else {
+			branch[level] = zeroHashes[level]
+		}
+	}
+
+	return branch
+}
@@ -0,0 +1,141 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proofs
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// Proof is a Merkle proof of a single leaf of an SSZ object.
+type Proof struct {
+	// GeneralizedIndex is the generalized index of the leaf within the object.
+	GeneralizedIndex uint64
+	// Leaf is the value of the leaf.
+	Leaf phase0.Root
+	// Branch is the list of sibling nodes, ordered from the leaf upwards.
+	Branch []phase0.Root
+}
+
+// proofJSON is the JSON representation of the struct.
+type proofJSON struct {
+	GeneralizedIndex string   `json:"generalized_index"`
+	Leaf             string   `json:"leaf"`
+	Branch           []string `json:"branch"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p *Proof) MarshalJSON() ([]byte, error) {
+	branch := make([]string, len(p.Branch))
+	for i := range p.Branch {
+		branch[i] = fmt.Sprintf("%#x", p.Branch[i])
+	}
+
+	return json.Marshal(&proofJSON{
+		GeneralizedIndex: fmt.Sprintf("%d", p.GeneralizedIndex),
+		Leaf:             fmt.Sprintf("%#x", p.Leaf),
+		Branch:           branch,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *Proof) UnmarshalJSON(input []byte) error {
+	var data proofJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.GeneralizedIndex == "" {
+		return errors.New("generalized index missing")
+	}
+	generalizedIndex, err := strconv.ParseUint(data.GeneralizedIndex, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for generalized index")
+	}
+	p.GeneralizedIndex = generalizedIndex
+	if data.Leaf == "" {
+		return errors.New("leaf missing")
+	}
+	if err := unmarshalRoot(data.Leaf, &p.Leaf); err != nil {
+		return errors.Wrap(err, "invalid value for leaf")
+	}
+	if data.Branch == nil {
+		return errors.New("branch missing")
+	}
+	p.Branch = make([]phase0.Root, len(data.Branch))
+	for i := range data.Branch {
+		if err := unmarshalRoot(data.Branch[i], &p.Branch[i]); err != nil {
+			return errors.Wrapf(err, "invalid value for branch %d", i)
+		}
+	}
+
+	return nil
+}
+
+func unmarshalRoot(input string, root *phase0.Root) error {
+	if !strings.HasPrefix(input, "0x") {
+		return errors.New("missing 0x prefix")
+	}
+
+	return root.UnmarshalJSON([]byte(fmt.Sprintf("%q", input)))
+}
+
+// String returns a string version of the structure.
+func (p *Proof) String() string {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
+
+// VerifyProof returns true if the proof is valid for the given root.
+func VerifyProof(root phase0.Root, proof *Proof) (bool, error) {
+	if proof == nil {
+		return false, errors.New("no proof supplied")
+	}
+	if proof.GeneralizedIndex == 0 {
+		return false, errors.New("invalid generalized index")
+	}
+	if uint64(len(proof.Branch)) != depthOf(proof.GeneralizedIndex) {
+		return false, fmt.Errorf("branch length %d incorrect for generalized index %d", len(proof.Branch), proof.GeneralizedIndex)
+	}
+
+	value := proof.Leaf
+	for i := range proof.Branch {
+		if (proof.GeneralizedIndex>>i)&1 == 1 {
+			value = hashConcat(proof.Branch[i], value)
+		} else {
+			value = hashConcat(value, proof.Branch[i])
+		}
+	}
+
+	return value == root, nil
+}
+
+// depthOf returns the depth of the node at the given generalized index.
+func depthOf(gindex uint64) uint64 {
+	depth := uint64(0)
+	for ; gindex > 1; gindex >>= 1 {
+		depth++
+	}
+
+	return depth
+}
@@ -0,0 +1,304 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package proofs provides SSZ Merkle proofs for fields of the beacon state.
+package proofs
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+const (
+	// validatorRegistryDepth is the depth of the tree for the validator registry
+	// list, as defined by VALIDATOR_REGISTRY_LIMIT.
+	validatorRegistryDepth = 40
+	// balancesDepth is the depth of the tree for the balances list, which packs
+	// four balances in to each chunk.
+	balancesDepth = validatorRegistryDepth - 2
+)
+
+// StateProver generates Merkle proofs for a beacon state.
+type StateProver struct {
+	state      *spec.VersionedBeaconState
+	fieldNames []string
+	fields     *tree
+
+	mutex      sync.Mutex
+	validators *tree
+	balances   *tree
+	blockRoots *tree
+	stateRoots *tree
+}
+
+// NewStateProver creates a new prover for the given state.
+func NewStateProver(state *spec.VersionedBeaconState) (*StateProver, error) {
+	if state == nil {
+		return nil, errors.New("no state supplied")
+	}
+
+	fieldNames := stateFields(state.Version)
+	if fieldNames == nil {
+		return nil, errors.New("unknown version")
+	}
+	fieldRoots, err := stateFieldRoots(state)
+	if err != nil {
+		return nil, err
+	}
+	if len(fieldRoots) != len(fieldNames) {
+		return nil, fmt.Errorf("obtained %d field roots but expected %d", len(fieldRoots), len(fieldNames))
+	}
+
+	return &StateProver{
+		state:      state,
+		fieldNames: fieldNames,
+		fields:     newTree(fieldRoots, depthFor(uint64(len(fieldRoots)))),
+	}, nil
+}
+
+// StateRoot returns the root of the state.
+func (p *StateProver) StateRoot() phase0.Root {
+	return p.fields.root()
+}
+
+// FieldGeneralizedIndex returns the generalized index of the named top-level field of the state.
+func (p *StateProver) FieldGeneralizedIndex(name string) (uint64, error) {
+	for i := range p.fieldNames {
+		if p.fieldNames[i] == name {
+			return (uint64(1) << p.fields.depth) + uint64(i), nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown field %s", name)
+}
+
+// FieldProof returns the proof for the named top-level field of the state.
+func (p *StateProver) FieldProof(name string) (*Proof, error) {
+	gindex, err := p.FieldGeneralizedIndex(name)
+	if err != nil {
+		return nil, err
+	}
+	index := gindex - (uint64(1) << p.fields.depth)
+
+	return &Proof{
+		GeneralizedIndex: gindex,
+		Leaf:             p.fields.levels[0][index],
+		Branch:           p.fields.branch(index),
+	}, nil
+}
+
+// ValidatorProof returns the proof for the validator at the given index.
+func (p *StateProver) ValidatorProof(index phase0.ValidatorIndex) (*Proof, error) {
+	validators, err := p.state.Validators()
+	if err != nil {
+		return nil, err
+	}
+	if uint64(index) >= uint64(len(validators)) {
+		return nil, fmt.Errorf("validator %d not in state", index)
+	}
+
+	p.mutex.Lock()
+	if p.validators == nil {
+		leaves := make([]phase0.Root, len(validators))
+		for i := range validators {
+			leaves[i], err = validators[i].HashTreeRoot()
+			if err != nil {
+				p.mutex.Unlock()
+
+				return nil, errors.Wrapf(err, "failed to hash validator %d", i)
+			}
+		}
+		p.validators = newTree(leaves, validatorRegistryDepth)
+	}
+	validatorsTree := p.validators
+	p.mutex.Unlock()
+
+	return p.listElementProof("validators", validatorsTree, uint64(len(validators)), uint64(index))
+}
+
+// BalanceProof returns the proof for the balance of the validator at the given index.
+// Balances are packed four to a chunk, so the leaf of the proof is the chunk
+// containing the balance; the balance itself is the little-endian uint64 at
+// offset 8*(index%4) of the leaf.
+func (p *StateProver) BalanceProof(index phase0.ValidatorIndex) (*Proof, error) {
+	balances, err := p.state.ValidatorBalances()
+	if err != nil {
+		return nil, err
+	}
+	if uint64(index) >= uint64(len(balances)) {
+		return nil, fmt.Errorf("balance %d not in state", index)
+	}
+
+	p.mutex.Lock()
+	if p.balances == nil {
+		leaves := make([]phase0.Root, (len(balances)+3)/4)
+		for i := range balances {
+			binary.LittleEndian.PutUint64(leaves[i/4][(i%4)*8:], uint64(balances[i]))
+		}
+		p.balances = newTree(leaves, balancesDepth)
+	}
+	balancesTree := p.balances
+	p.mutex.Unlock()
+
+	return p.listElementProof("balances", balancesTree, uint64(len(balances)), uint64(index)/4)
+}
+
+// BlockRootProof returns the proof for the block root at the given slot in
+// the state's historical block roots.  The slot must be before that of the
+// state, and no more than SLOTS_PER_HISTORICAL_ROOT slots before it.
+func (p *StateProver) BlockRootProof(slot phase0.Slot) (*Proof, error) {
+	blockRoots, err := p.state.BlockRoots()
+	if err != nil {
+		return nil, err
+	}
+	index, err := p.historicalIndex(slot, uint64(len(blockRoots)))
+	if err != nil {
+		return nil, err
+	}
+
+	p.mutex.Lock()
+	if p.blockRoots == nil {
+		p.blockRoots = newTree(blockRoots, depthFor(uint64(len(blockRoots))))
+	}
+	blockRootsTree := p.blockRoots
+	p.mutex.Unlock()
+
+	return p.vectorElementProof("block_roots", blockRootsTree, uint64(len(blockRoots)), index)
+}
+
+// StateRootProof returns the proof for the state root at the given slot in
+// the state's historical state roots.  The slot must be before that of the
+// state, and no more than SLOTS_PER_HISTORICAL_ROOT slots before it.
+func (p *StateProver) StateRootProof(slot phase0.Slot) (*Proof, error) {
+	stateRoots, err := p.state.StateRoots()
+	if err != nil {
+		return nil, err
+	}
+	index, err := p.historicalIndex(slot, uint64(len(stateRoots)))
+	if err != nil {
+		return nil, err
+	}
+
+	p.mutex.Lock()
+	if p.stateRoots == nil {
+		p.stateRoots = newTree(stateRoots, depthFor(uint64(len(stateRoots))))
+	}
+	stateRootsTree := p.stateRoots
+	p.mutex.Unlock()
+
+	return p.vectorElementProof("state_roots", stateRootsTree, uint64(len(stateRoots)), index)
+}
+
+// historicalIndex returns the index in a historical roots vector of the given
+// length for the slot, or an error if the vector no longer or does not yet hold
+// the root for the slot.
+func (p *StateProver) historicalIndex(slot phase0.Slot, length uint64) (uint64, error) {
+	stateSlot, err := p.state.Slot()
+	if err != nil {
+		return 0, err
+	}
+	if slot >= stateSlot {
+		return 0, fmt.Errorf("slot %d not before state slot %d", slot, stateSlot)
+	}
+	if uint64(stateSlot-slot) > length {
+		return 0, fmt.Errorf("slot %d too far before state slot %d", slot, stateSlot)
+	}
+
+	return uint64(slot) % length, nil
+}
+
+// FinalizedCheckpointProof returns the proof for the finalized checkpoint.
+func (p *StateProver) FinalizedCheckpointProof() (*Proof, error) {
+	return p.FieldProof("finalized_checkpoint")
+}
+
+// FinalizedRootProof returns the proof for the root of the finalized checkpoint.
+func (p *StateProver) FinalizedRootProof() (*Proof, error) {
+	checkpoint, err := p.state.FinalizedCheckpoint()
+	if err != nil {
+		return nil, err
+	}
+	fieldProof, err := p.FieldProof("finalized_checkpoint")
+	if err != nil {
+		return nil, err
+	}
+
+	var epoch phase0.Root
+	binary.LittleEndian.PutUint64(epoch[:8], uint64(checkpoint.Epoch))
+
+	return &Proof{
+		GeneralizedIndex: fieldProof.GeneralizedIndex*2 + 1,
+		Leaf:             checkpoint.Root,
+		Branch:           append([]phase0.Root{epoch}, fieldProof.Branch...),
+	}, nil
+}
+
+// listElementProof returns the proof for the chunk at the given index of a list field.
+func (p *StateProver) listElementProof(name string,
+	elements *tree,
+	length uint64,
+	index uint64,
+) (
+	*Proof,
+	error,
+) {
+	fieldProof, err := p.FieldProof(name)
+	if err != nil {
+		return nil, err
+	}
+
+	branch := make([]phase0.Root, 0, elements.depth+1+uint64(len(fieldProof.Branch)))
+	branch = append(branch, elements.branch(index)...)
+	branch = append(branch, lengthRoot(length))
+	branch = append(branch, fieldProof.Branch...)
+
+	return &Proof{
+		GeneralizedIndex: ((fieldProof.GeneralizedIndex * 2) << elements.depth) + index,
+		Leaf:             elements.levels[0][index],
+		Branch:           branch,
+	}, nil
+}
+
+// vectorElementProof returns the proof for the element at the given index
+// of a vector field.
+func (p *StateProver) vectorElementProof(name string,
+	elements *tree,
+	length uint64,
+	index uint64,
+) (
+	*Proof,
+	error,
+) {
+	if index >= length {
+		return nil, fmt.Errorf("%s index %d out of range", name, index)
+	}
+	fieldProof, err := p.FieldProof(name)
+	if err != nil {
+		return nil, err
+	}
+
+	branch := make([]phase0.Root, 0, elements.depth+uint64(len(fieldProof.Branch)))
+	branch = append(branch, elements.branch(index)...)
+	branch = append(branch, fieldProof.Branch...)
+
+	return &Proof{
+		GeneralizedIndex: (fieldProof.GeneralizedIndex << elements.depth) + index,
+		Leaf:             elements.levels[0][index],
+		Branch:           branch,
+	}, nil
+}
@@ -0,0 +1,382 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proofs_test
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/proofs"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+)
+
+func roots(n int, seed byte) []phase0.Root {
+	res := make([]phase0.Root, n)
+	for i := range res {
+		res[i] = sha256.Sum256([]byte{seed, byte(i), byte(i >> 8), byte(i >> 16)})
+	}
+
+	return res
+}
+
+func validators(n int) ([]*phase0.Validator, []phase0.Gwei) {
+	validators := make([]*phase0.Validator, n)
+	balances := make([]phase0.Gwei, n)
+	for i := range validators {
+		validators[i] = &phase0.Validator{
+			PublicKey:                  phase0.BLSPubKey{byte(i), byte(i >> 8)},
+			WithdrawalCredentials:      make([]byte, 32),
+			EffectiveBalance:           32000000000,
+			ActivationEligibilityEpoch: phase0.Epoch(i),
+			ActivationEpoch:            phase0.Epoch(i + 1),
+			ExitEpoch:                  0xffffffffffffffff,
+			WithdrawableEpoch:          0xffffffffffffffff,
+		}
+		balances[i] = phase0.Gwei(32000000000 + i)
+	}
+
+	return validators, balances
+}
+
+func phase0State() *spec.VersionedBeaconState {
+	validators, balances := validators(5)
+
+	return &spec.VersionedBeaconState{
+		Version: spec.DataVersionPhase0,
+		Phase0: &phase0.BeaconState{
+			GenesisTime:       1606824023,
+			Slot:              12345,
+			Fork:              &phase0.Fork{},
+			LatestBlockHeader: &phase0.BeaconBlockHeader{},
+			BlockRoots:        roots(8192, 1),
+			StateRoots:        roots(8192, 2),
+			HistoricalRoots:   roots(3, 3),
+			ETH1Data:          &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+			Validators:        validators,
+			Balances:          balances,
+			RANDAOMixes:       roots(65536, 4),
+			Slashings:         make([]phase0.Gwei, 8192),
+			JustificationBits: []byte{0x0f},
+			PreviousJustifiedCheckpoint: &phase0.Checkpoint{
+				Epoch: 383,
+			},
+			CurrentJustifiedCheckpoint: &phase0.Checkpoint{
+				Epoch: 384,
+			},
+			FinalizedCheckpoint: &phase0.Checkpoint{
+				Epoch: 382,
+				Root:  roots(1, 5)[0],
+			},
+		},
+	}
+}
+
+func syncCommittee() *altair.SyncCommittee {
+	return &altair.SyncCommittee{
+		Pubkeys: make([]phase0.BLSPubKey, 512),
+	}
+}
+
+func denebState() *spec.VersionedBeaconState {
+	validators, balances := validators(9)
+
+	return &spec.VersionedBeaconState{
+		Version: spec.DataVersionDeneb,
+		Deneb: &deneb.BeaconState{
+			GenesisTime:                  1606824023,
+			Slot:                         8000000,
+			Fork:                         &phase0.Fork{},
+			LatestBlockHeader:            &phase0.BeaconBlockHeader{},
+			BlockRoots:                   roots(8192, 1),
+			StateRoots:                   roots(8192, 2),
+			ETH1Data:                     &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+			Validators:                   validators,
+			Balances:                     balances,
+			RANDAOMixes:                  roots(65536, 4),
+			Slashings:                    make([]phase0.Gwei, 8192),
+			PreviousEpochParticipation:   make([]altair.ParticipationFlags, 9),
+			CurrentEpochParticipation:    make([]altair.ParticipationFlags, 9),
+			JustificationBits:            []byte{0x0f},
+			PreviousJustifiedCheckpoint:  &phase0.Checkpoint{},
+			CurrentJustifiedCheckpoint:   &phase0.Checkpoint{},
+			FinalizedCheckpoint:          &phase0.Checkpoint{Epoch: 249998, Root: roots(1, 5)[0]},
+			InactivityScores:             make([]uint64, 9),
+			CurrentSyncCommittee:         syncCommittee(),
+			NextSyncCommittee:            syncCommittee(),
+			LatestExecutionPayloadHeader: &deneb.ExecutionPayloadHeader{BaseFeePerGas: uint256.NewInt(7)},
+			NextWithdrawalIndex:          12,
+		},
+	}
+}
+
+func electraState() *spec.VersionedBeaconState {
+	validators, balances := validators(2)
+
+	return &spec.VersionedBeaconState{
+		Version: spec.DataVersionElectra,
+		Electra: &electra.BeaconState{
+			Slot:                         9000000,
+			Fork:                         &phase0.Fork{},
+			LatestBlockHeader:            &phase0.BeaconBlockHeader{},
+			BlockRoots:                   roots(8192, 1),
+			StateRoots:                   roots(8192, 2),
+			ETH1Data:                     &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+			Validators:                   validators,
+			Balances:                     balances,
+			RANDAOMixes:                  roots(65536, 4),
+			Slashings:                    make([]phase0.Gwei, 8192),
+			PreviousEpochParticipation:   make([]altair.ParticipationFlags, 2),
+			CurrentEpochParticipation:    make([]altair.ParticipationFlags, 2),
+			JustificationBits:            []byte{0x0f},
+			PreviousJustifiedCheckpoint:  &phase0.Checkpoint{},
+			CurrentJustifiedCheckpoint:   &phase0.Checkpoint{},
+			FinalizedCheckpoint:          &phase0.Checkpoint{Epoch: 10, Root: roots(1, 5)[0]},
+			InactivityScores:             make([]uint64, 2),
+			CurrentSyncCommittee:         syncCommittee(),
+			NextSyncCommittee:            syncCommittee(),
+			LatestExecutionPayloadHeader: &electra.ExecutionPayloadHeader{BaseFeePerGas: uint256.NewInt(7)},
+			EarliestExitEpoch:            5,
+		},
+	}
+}
+
+func stateRoot(t *testing.T, state *spec.VersionedBeaconState) phase0.Root {
+	t.Helper()
+
+	var root phase0.Root
+	var err error
+	switch state.Version {
+	case spec.DataVersionPhase0:
+		root, err = state.Phase0.HashTreeRoot()
+	case spec.DataVersionDeneb:
+		root, err = state.Deneb.HashTreeRoot()
+	case spec.DataVersionElectra:
+		root, err = state.Electra.HashTreeRoot()
+	}
+	require.NoError(t, err)
+
+	return root
+}
+
+func TestNewStateProver(t *testing.T) {
+	tests := []struct {
+		name  string
+		state *spec.VersionedBeaconState
+		err   string
+	}{
+		{
+			name: "Nil",
+			err:  "no state supplied",
+		},
+		{
+			name:  "Empty",
+			state: &spec.VersionedBeaconState{Version: spec.DataVersionDeneb},
+			err:   "no Deneb state",
+		},
+		{
+			name:  "UnknownVersion",
+			state: &spec.VersionedBeaconState{Version: 99},
+			err:   "unknown version",
+		},
+		{
+			name:  "Phase0",
+			state: phase0State(),
+		},
+		{
+			name:  "Deneb",
+			state: denebState(),
+		},
+		{
+			name:  "Electra",
+			state: electraState(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			prover, err := proofs.NewStateProver(test.state)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, stateRoot(t, test.state), prover.StateRoot())
+			}
+		})
+	}
+}
+
+func TestProofs(t *testing.T) {
+	for _, state := range []*spec.VersionedBeaconState{phase0State(), denebState(), electraState()} {
+		t.Run(state.Version.String(), func(t *testing.T) {
+			prover, err := proofs.NewStateProver(state)
+			require.NoError(t, err)
+			root := stateRoot(t, state)
+
+			validators, err := state.Validators()
+			require.NoError(t, err)
+			for i := range validators {
+				proof, err := prover.ValidatorProof(phase0.ValidatorIndex(i))
+				require.NoError(t, err)
+				validatorRoot, err := validators[i].HashTreeRoot()
+				require.NoError(t, err)
+				require.Equal(t, phase0.Root(validatorRoot), proof.Leaf)
+				verified, err := proofs.VerifyProof(root, proof)
+				require.NoError(t, err)
+				require.True(t, verified)
+			}
+			_, err = prover.ValidatorProof(phase0.ValidatorIndex(len(validators)))
+			require.Error(t, err)
+
+			balances, err := state.ValidatorBalances()
+			require.NoError(t, err)
+			for i := range balances {
+				proof, err := prover.BalanceProof(phase0.ValidatorIndex(i))
+				require.NoError(t, err)
+				require.Equal(t, uint64(balances[i]), binary.LittleEndian.Uint64(proof.Leaf[(i%4)*8:]))
+				verified, err := proofs.VerifyProof(root, proof)
+				require.NoError(t, err)
+				require.True(t, verified)
+			}
+
+			blockRoots, err := state.BlockRoots()
+			require.NoError(t, err)
+			stateSlot, err := state.Slot()
+			require.NoError(t, err)
+			for _, slot := range []phase0.Slot{stateSlot - 8192, stateSlot - 8191, stateSlot - 100, stateSlot - 1} {
+				proof, err := prover.BlockRootProof(slot)
+				require.NoError(t, err)
+				require.Equal(t, blockRoots[slot%8192], proof.Leaf)
+				verified, err := proofs.VerifyProof(root, proof)
+				require.NoError(t, err)
+				require.True(t, verified)
+
+				proof, err = prover.StateRootProof(slot)
+				require.NoError(t, err)
+				verified, err = proofs.VerifyProof(root, proof)
+				require.NoError(t, err)
+				require.True(t, verified)
+			}
+			// Slots outside of the window held by the state are rejected.
+			for _, slot := range []phase0.Slot{stateSlot - 8193, stateSlot, stateSlot + 1} {
+				_, err = prover.BlockRootProof(slot)
+				require.Error(t, err)
+				_, err = prover.StateRootProof(slot)
+				require.Error(t, err)
+			}
+			_, err = prover.BlockRootProof(stateSlot)
+			require.EqualError(t, err, fmt.Sprintf("slot %d not before state slot %d", stateSlot, stateSlot))
+			_, err = prover.StateRootProof(stateSlot - 8193)
+			require.EqualError(t, err, fmt.Sprintf("slot %d too far before state slot %d", stateSlot-8193, stateSlot))
+
+			proof, err := prover.FinalizedCheckpointProof()
+			require.NoError(t, err)
+			verified, err := proofs.VerifyProof(root, proof)
+			require.NoError(t, err)
+			require.True(t, verified)
+
+			proof, err = prover.FinalizedRootProof()
+			require.NoError(t, err)
+			checkpoint, err := state.FinalizedCheckpoint()
+			require.NoError(t, err)
+			require.Equal(t, checkpoint.Root, proof.Leaf)
+			verified, err = proofs.VerifyProof(root, proof)
+			require.NoError(t, err)
+			require.True(t, verified)
+
+			_, err = prover.FieldProof("unknown")
+			require.EqualError(t, err, "unknown field unknown")
+		})
+	}
+}
+
+func TestGeneralizedIndices(t *testing.T) {
+	prover, err := proofs.NewStateProver(denebState())
+	require.NoError(t, err)
+
+	// Values from the light client specification.
+	proof, err := prover.FinalizedRootProof()
+	require.NoError(t, err)
+	require.Equal(t, uint64(105), proof.GeneralizedIndex)
+	gindex, err := prover.FieldGeneralizedIndex("current_sync_committee")
+	require.NoError(t, err)
+	require.Equal(t, uint64(54), gindex)
+	gindex, err = prover.FieldGeneralizedIndex("next_sync_committee")
+	require.NoError(t, err)
+	require.Equal(t, uint64(55), gindex)
+
+	prover, err = proofs.NewStateProver(electraState())
+	require.NoError(t, err)
+	proof, err = prover.FinalizedRootProof()
+	require.NoError(t, err)
+	require.Equal(t, uint64(169), proof.GeneralizedIndex)
+}
+
+func TestVerifyProof(t *testing.T) {
+	state := denebState()
+	prover, err := proofs.NewStateProver(state)
+	require.NoError(t, err)
+	root := stateRoot(t, state)
+
+	proof, err := prover.ValidatorProof(3)
+	require.NoError(t, err)
+
+	_, err = proofs.VerifyProof(root, nil)
+	require.EqualError(t, err, "no proof supplied")
+
+	tampered := *proof
+	tampered.Leaf[0] ^= 0x01
+	verified, err := proofs.VerifyProof(root, &tampered)
+	require.NoError(t, err)
+	require.False(t, verified)
+
+	tampered = *proof
+	tampered.GeneralizedIndex++
+	verified, err = proofs.VerifyProof(root, &tampered)
+	require.NoError(t, err)
+	require.False(t, verified)
+
+	tampered = *proof
+	tampered.Branch = proof.Branch[1:]
+	_, err = proofs.VerifyProof(root, &tampered)
+	require.Error(t, err)
+
+	verified, err = proofs.VerifyProof(phase0.Root{}, proof)
+	require.NoError(t, err)
+	require.False(t, verified)
+}
+
+func TestProofJSON(t *testing.T) {
+	prover, err := proofs.NewStateProver(denebState())
+	require.NoError(t, err)
+	proof, err := prover.FinalizedRootProof()
+	require.NoError(t, err)
+
+	data, err := json.Marshal(proof)
+	require.NoError(t, err)
+	var res proofs.Proof
+	require.NoError(t, json.Unmarshal(data, &res))
+	require.Equal(t, proof, &res)
+	require.Equal(t, string(data), res.String())
+
+	require.EqualError(t, json.Unmarshal([]byte(`{"leaf":"0x00","branch":[]}`), &res), "generalized index missing")
+}
@@ -0,0 +1,302 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// BeaconBlock returns the signing root for a beacon block.
+func (c *Calculator) BeaconBlock(ctx context.Context, block *spec.VersionedBeaconBlock) (phase0.Root, error) {
+	if block == nil {
+		return phase0.Root{}, errors.New("no block supplied")
+	}
+	slot, err := block.Slot()
+	if err != nil {
+		return phase0.Root{}, errors.Wrap(err, "failed to obtain block slot")
+	}
+	root, err := block.Root()
+	if err != nil {
+		return phase0.Root{}, errors.Wrap(err, "failed to obtain block root")
+	}
+
+	return c.proposerSigningRoot(ctx, slot, root)
+}
+
+// BlindedProposal returns the signing root for a blinded proposal.
+func (c *Calculator) BlindedProposal(ctx context.Context, proposal *api.VersionedBlindedProposal) (phase0.Root, error) {
+	if proposal == nil {
+		return phase0.Root{}, errors.New("no proposal supplied")
+	}
+	slot, err := proposal.Slot()
+	if err != nil {
+		return phase0.Root{}, errors.Wrap(err, "failed to obtain proposal slot")
+	}
+	root, err := proposal.Root()
+	if err != nil {
+		return phase0.Root{}, errors.Wrap(err, "failed to obtain proposal root")
+	}
+
+	return c.proposerSigningRoot(ctx, slot, root)
+}
+
+// BeaconBlockHeader returns the signing root for a beacon block header.
+// This is the same as the signing root for the block from which the header is derived.
+func (c *Calculator) BeaconBlockHeader(ctx context.Context, header *phase0.BeaconBlockHeader) (phase0.Root, error) {
+	if header == nil {
+		return phase0.Root{}, errors.New("no header supplied")
+	}
+	root, err := header.HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Wrap(err, "failed to obtain header root")
+	}
+
+	return c.proposerSigningRoot(ctx, header.Slot, root)
+}
+
+func (c *Calculator) proposerSigningRoot(ctx context.Context, slot phase0.Slot, root phase0.Root) (phase0.Root, error) {
+	domainType, err := c.domainType("DOMAIN_BEACON_PROPOSER")
+	if err != nil {
+		return phase0.Root{}, err
+	}
+
+	return c.SigningRoot(ctx, root, domainType, c.slotToEpoch(slot))
+}
+
+// RANDAOReveal returns the signing root for a RANDAO reveal.
+func (c *Calculator) RANDAOReveal(ctx context.Context, epoch phase0.Epoch) (phase0.Root, error) {
+	domainType, err := c.domainType("DOMAIN_RANDAO")
+	if err != nil {
+		return phase0.Root{}, err
+	}
+
+	return c.SigningRoot(ctx, uint64Root(uint64(epoch)), domainType, epoch)
+}
+
+// AttestationData returns the signing root for attestation data.
+func (c *Calculator) AttestationData(ctx context.Context, data *phase0.AttestationData) (phase0.Root, error) {
+	if data == nil {
+		return phase0.Root{}, errors.New("no attestation data supplied")
+	}
+	if data.Target == nil {
+		return phase0.Root{}, errors.New("no attestation data target supplied")
+	}
+	domainType, err := c.domainType("DOMAIN_BEACON_ATTESTER")
+	if err != nil {
+		return phase0.Root{}, err
+	}
+	root, err := data.HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Wrap(err, "failed to obtain attestation data root")
+	}
+
+	return c.SigningRoot(ctx, root, domainType, data.Target.Epoch)
+}
+
+// SelectionProof returns the signing root for a beacon committee selection proof.
+func (c *Calculator) SelectionProof(ctx context.Context, slot phase0.Slot) (phase0.Root, error) {
+	domainType, err := c.domainType("DOMAIN_SELECTION_PROOF")
+	if err != nil {
+		return phase0.Root{}, err
+	}
+
+	return c.SigningRoot(ctx, uint64Root(uint64(slot)), domainType, c.slotToEpoch(slot))
+}
+
+// AggregateAndProof returns the signing root for an aggregate and proof.
+func (c *Calculator) AggregateAndProof(ctx context.Context, aggregateAndProof *phase0.AggregateAndProof) (phase0.Root, error) {
+	if aggregateAndProof == nil {
+		return phase0.Root{}, errors.New("no aggregate and proof supplied")
+	}
+	if aggregateAndProof.Aggregate == nil || aggregateAndProof.Aggregate.Data == nil {
+		return phase0.Root{}, errors.New("no aggregate data supplied")
+	}
+	root, err := aggregateAndProof.HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Wrap(err, "failed to obtain aggregate and proof root")
+	}
+
+	return c.aggregateAndProofSigningRoot(ctx, aggregateAndProof.Aggregate.Data.Slot, root)
+}
+
+// ElectraAggregateAndProof returns the signing root for an Electra aggregate and proof.
+func (c *Calculator) ElectraAggregateAndProof(ctx context.Context, aggregateAndProof *electra.AggregateAndProof) (phase0.Root, error) {
+	if aggregateAndProof == nil {
+		return phase0.Root{}, errors.New("no aggregate and proof supplied")
+	}
+	if aggregateAndProof.Aggregate == nil || aggregateAndProof.Aggregate.Data == nil {
+		return phase0.Root{}, errors.New("no aggregate data supplied")
+	}
+	root, err := aggregateAndProof.HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Wrap(err, "failed to obtain aggregate and proof root")
+	}
+
+	return c.aggregateAndProofSigningRoot(ctx, aggregateAndProof.Aggregate.Data.Slot, root)
+}
+
+func (c *Calculator) aggregateAndProofSigningRoot(ctx context.Context, slot phase0.Slot, root phase0.Root) (phase0.Root, error) {
+	domainType, err := c.domainType("DOMAIN_AGGREGATE_AND_PROOF")
+	if err != nil {
+		return phase0.Root{}, err
+	}
+
+	return c.SigningRoot(ctx, root, domainType, c.slotToEpoch(slot))
+}
+
+// SyncCommitteeMessage returns the signing root for a sync committee message
+// for the given block root at the given slot.
+func (c *Calculator) SyncCommitteeMessage(ctx context.Context, slot phase0.Slot, blockRoot phase0.Root) (phase0.Root, error) {
+	domainType, err := c.domainType("DOMAIN_SYNC_COMMITTEE")
+	if err != nil {
+		return phase0.Root{}, err
+	}
+
+	return c.SigningRoot(ctx, blockRoot, domainType, c.slotToEpoch(slot))
+}
+
+// SyncCommitteeSelectionProof returns the signing root for a sync committee selection proof.
+func (c *Calculator) SyncCommitteeSelectionProof(ctx context.Context,
+	slot phase0.Slot,
+	subcommitteeIndex uint64,
+) (
+	phase0.Root,
+	error,
+) {
+	domainType, err := c.domainType("DOMAIN_SYNC_COMMITTEE_SELECTION_PROOF")
+	if err != nil {
+		return phase0.Root{}, err
+	}
+	selectionData := &altair.SyncAggregatorSelectionData{
+		Slot:              slot,
+		SubcommitteeIndex: subcommitteeIndex,
+	}
+	root, err := selectionData.HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Wrap(err, "failed to obtain selection data root")
+	}
+
+	return c.SigningRoot(ctx, root, domainType, c.slotToEpoch(slot))
+}
+
+// ContributionAndProof returns the signing root for a sync committee contribution and proof.
+func (c *Calculator) ContributionAndProof(ctx context.Context, contributionAndProof *altair.ContributionAndProof) (phase0.Root, error) {
+	if contributionAndProof == nil {
+		return phase0.Root{}, errors.New("no contribution and proof supplied")
+	}
+	if contributionAndProof.Contribution == nil {
+		return phase0.Root{}, errors.New("no contribution supplied")
+	}
+	domainType, err := c.domainType("DOMAIN_CONTRIBUTION_AND_PROOF")
+	if err != nil {
+		return phase0.Root{}, err
+	}
+	root, err := contributionAndProof.HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Wrap(err, "failed to obtain contribution and proof root")
+	}
+
+	return c.SigningRoot(ctx, root, domainType, c.slotToEpoch(contributionAndProof.Contribution.Slot))
+}
+
+// VoluntaryExit returns the signing root for a voluntary exit.
+// The epoch is that of the chain at which the exit will be verified.  From
+// the Deneb fork epoch voluntary exits are signed with the Capella fork
+// version regardless of their epoch, as per EIP-7044.
+func (c *Calculator) VoluntaryExit(ctx context.Context,
+	exit *phase0.VoluntaryExit,
+	epoch phase0.Epoch,
+) (
+	phase0.Root,
+	error,
+) {
+	if exit == nil {
+		return phase0.Root{}, errors.New("no voluntary exit supplied")
+	}
+	domainType, err := c.domainType("DOMAIN_VOLUNTARY_EXIT")
+	if err != nil {
+		return phase0.Root{}, err
+	}
+	root, err := exit.HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Wrap(err, "failed to obtain voluntary exit root")
+	}
+
+	denebForkEpoch, err := specValue[uint64](c.spec, "DENEB_FORK_EPOCH")
+	if err != nil || uint64(epoch) < denebForkEpoch {
+		// Deneb not scheduled or not yet reached.
+		return c.SigningRoot(ctx, root, domainType, exit.Epoch)
+	}
+
+	capellaForkVersion, err := specValue[phase0.Version](c.spec, "CAPELLA_FORK_VERSION")
+	if err != nil {
+		return phase0.Root{}, err
+	}
+	forkData := &phase0.ForkData{
+		CurrentVersion:        capellaForkVersion,
+		GenesisValidatorsRoot: c.genesisValidatorsRoot,
+	}
+	forkDataRoot, err := forkData.HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Wrap(err, "failed to calculate fork data root")
+	}
+	var domain phase0.Domain
+	copy(domain[:], domainType[:])
+	copy(domain[4:], forkDataRoot[:])
+
+	return signingRoot(root, domain)
+}
+
+// BLSToExecutionChange returns the signing root for a BLS to execution change.
+func (c *Calculator) BLSToExecutionChange(ctx context.Context, change *capella.BLSToExecutionChange) (phase0.Root, error) {
+	if change == nil {
+		return phase0.Root{}, errors.New("no BLS to execution change supplied")
+	}
+	domainType, err := c.domainType("DOMAIN_BLS_TO_EXECUTION_CHANGE")
+	if err != nil {
+		return phase0.Root{}, err
+	}
+	root, err := change.HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Wrap(err, "failed to obtain BLS to execution change root")
+	}
+
+	return c.genesisSigningRoot(ctx, root, domainType)
+}
+
+// ValidatorRegistration returns the signing root for a validator registration.
+func (c *Calculator) ValidatorRegistration(ctx context.Context, registration *apiv1.ValidatorRegistration) (phase0.Root, error) {
+	if registration == nil {
+		return phase0.Root{}, errors.New("no validator registration supplied")
+	}
+	domainType, err := c.domainType("DOMAIN_APPLICATION_BUILDER")
+	if err != nil {
+		return phase0.Root{}, err
+	}
+	root, err := registration.HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Wrap(err, "failed to obtain validator registration root")
+	}
+
+	return c.genesisSigningRoot(ctx, root, domainType)
+}
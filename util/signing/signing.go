@@ -0,0 +1,153 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package signing provides calculation of signing roots for objects signed by validators.
+package signing
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// Provider is the interface required to calculate signing roots.
+type Provider interface {
+	consensusclient.DomainProvider
+	consensusclient.GenesisProvider
+	consensusclient.SpecProvider
+}
+
+// Calculator calculates signing roots.
+type Calculator struct {
+	provider              Provider
+	spec                  map[string]any
+	slotsPerEpoch         uint64
+	genesisValidatorsRoot phase0.Root
+}
+
+// New creates a new signing root calculator.
+func New(ctx context.Context, provider Provider) (*Calculator, error) {
+	if provider == nil {
+		return nil, errors.New("no provider specified")
+	}
+
+	specResponse, err := provider.Spec(ctx, &api.SpecOpts{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain spec")
+	}
+	genesisResponse, err := provider.Genesis(ctx, &api.GenesisOpts{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain genesis")
+	}
+
+	c := &Calculator{
+		provider:              provider,
+		spec:                  specResponse.Data,
+		genesisValidatorsRoot: genesisResponse.Data.GenesisValidatorsRoot,
+	}
+	if c.slotsPerEpoch, err = specValue[uint64](c.spec, "SLOTS_PER_EPOCH"); err != nil {
+		return nil, err
+	}
+	if c.slotsPerEpoch == 0 {
+		return nil, errors.New("invalid value for SLOTS_PER_EPOCH")
+	}
+
+	return c, nil
+}
+
+// SigningRoot returns the signing root for an object with the given root, for the
+// domain of the given type at the given epoch.
+func (c *Calculator) SigningRoot(ctx context.Context,
+	objectRoot phase0.Root,
+	domainType phase0.DomainType,
+	epoch phase0.Epoch,
+) (
+	phase0.Root,
+	error,
+) {
+	domain, err := c.provider.Domain(ctx, domainType, epoch)
+	if err != nil {
+		return phase0.Root{}, errors.Wrap(err, "failed to obtain domain")
+	}
+
+	return signingRoot(objectRoot, domain)
+}
+
+// genesisSigningRoot returns the signing root for an object with the given root,
+// for the domain of the given type at genesis.
+func (c *Calculator) genesisSigningRoot(ctx context.Context,
+	objectRoot phase0.Root,
+	domainType phase0.DomainType,
+) (
+	phase0.Root,
+	error,
+) {
+	domain, err := c.provider.GenesisDomain(ctx, domainType)
+	if err != nil {
+		return phase0.Root{}, errors.Wrap(err, "failed to obtain domain")
+	}
+
+	return signingRoot(objectRoot, domain)
+}
+
+// domainType obtains the named domain type from the spec.
+func (c *Calculator) domainType(name string) (phase0.DomainType, error) {
+	return specValue[phase0.DomainType](c.spec, name)
+}
+
+// slotToEpoch returns the epoch of the given slot.
+func (c *Calculator) slotToEpoch(slot phase0.Slot) phase0.Epoch {
+	return phase0.Epoch(uint64(slot) / c.slotsPerEpoch)
+}
+
+// signingRoot returns the signing root for the given object root and domain.
+func signingRoot(objectRoot phase0.Root, domain phase0.Domain) (phase0.Root, error) {
+	signingData := &phase0.SigningData{
+		ObjectRoot: objectRoot,
+		Domain:     domain,
+	}
+	root, err := signingData.HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Wrap(err, "failed to calculate signing root")
+	}
+
+	return root, nil
+}
+
+// uint64Root returns the hash tree root of a uint64.
+func uint64Root(val uint64) phase0.Root {
+	var root phase0.Root
+	binary.LittleEndian.PutUint64(root[:], val)
+
+	return root
+}
+
+// specValue obtains a typed value from the spec.
+func specValue[T any](data map[string]any, key string) (T, error) {
+	var res T
+	tmp, exists := data[key]
+	if !exists {
+		return res, fmt.Errorf("%s not found in spec", key)
+	}
+	res, isType := tmp.(T)
+	if !isType {
+		return res, fmt.Errorf("%s of unexpected type", key)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,282 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing_test
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/signing"
+	"github.com/stretchr/testify/require"
+)
+
+// testProvider provides domains that encode the domain type and epoch, to
+// allow tests to confirm that the correct values are used.
+type testProvider struct {
+	spec        map[string]any
+	genesisTime time.Time
+}
+
+func (p *testProvider) Domain(_ context.Context, domainType phase0.DomainType, epoch phase0.Epoch) (phase0.Domain, error) {
+	var domain phase0.Domain
+	copy(domain[:], domainType[:])
+	binary.LittleEndian.PutUint64(domain[4:], uint64(epoch))
+
+	return domain, nil
+}
+
+func (p *testProvider) GenesisDomain(_ context.Context, domainType phase0.DomainType) (phase0.Domain, error) {
+	var domain phase0.Domain
+	copy(domain[:], domainType[:])
+	domain[31] = 0xff
+
+	return domain, nil
+}
+
+func (p *testProvider) Genesis(_ context.Context, _ *api.GenesisOpts) (*api.Response[*apiv1.Genesis], error) {
+	return &api.Response[*apiv1.Genesis]{
+		Data: &apiv1.Genesis{
+			GenesisTime:           p.genesisTime,
+			GenesisValidatorsRoot: phase0.Root{0x01, 0x02},
+		},
+	}, nil
+}
+
+func (p *testProvider) Spec(_ context.Context, _ *api.SpecOpts) (*api.Response[map[string]any], error) {
+	return &api.Response[map[string]any]{
+		Data: p.spec,
+	}, nil
+}
+
+func testSpec() map[string]any {
+	return map[string]any{
+		"SLOTS_PER_EPOCH":                       uint64(32),
+		"SECONDS_PER_SLOT":                      12 * time.Second,
+		"CAPELLA_FORK_VERSION":                  phase0.Version{0x03, 0x00, 0x00, 0x00},
+		"DENEB_FORK_EPOCH":                      uint64(100),
+		"DOMAIN_BEACON_PROPOSER":                phase0.DomainType{0x00, 0x00, 0x00, 0x00},
+		"DOMAIN_BEACON_ATTESTER":                phase0.DomainType{0x01, 0x00, 0x00, 0x00},
+		"DOMAIN_RANDAO":                         phase0.DomainType{0x02, 0x00, 0x00, 0x00},
+		"DOMAIN_VOLUNTARY_EXIT":                 phase0.DomainType{0x04, 0x00, 0x00, 0x00},
+		"DOMAIN_SELECTION_PROOF":                phase0.DomainType{0x05, 0x00, 0x00, 0x00},
+		"DOMAIN_AGGREGATE_AND_PROOF":            phase0.DomainType{0x06, 0x00, 0x00, 0x00},
+		"DOMAIN_SYNC_COMMITTEE":                 phase0.DomainType{0x07, 0x00, 0x00, 0x00},
+		"DOMAIN_SYNC_COMMITTEE_SELECTION_PROOF": phase0.DomainType{0x08, 0x00, 0x00, 0x00},
+		"DOMAIN_CONTRIBUTION_AND_PROOF":         phase0.DomainType{0x09, 0x00, 0x00, 0x00},
+		"DOMAIN_BLS_TO_EXECUTION_CHANGE":        phase0.DomainType{0x0a, 0x00, 0x00, 0x00},
+		"DOMAIN_APPLICATION_BUILDER":            phase0.DomainType{0x00, 0x00, 0x00, 0x01},
+	}
+}
+
+func expectedRoot(t *testing.T, objectRoot phase0.Root, domainType byte, epoch uint64) phase0.Root {
+	t.Helper()
+
+	var domain phase0.Domain
+	domain[0] = domainType
+	binary.LittleEndian.PutUint64(domain[4:], epoch)
+	root, err := (&phase0.SigningData{ObjectRoot: objectRoot, Domain: domain}).HashTreeRoot()
+	require.NoError(t, err)
+
+	return root
+}
+
+func expectedGenesisRoot(t *testing.T, objectRoot phase0.Root, domainType phase0.DomainType) phase0.Root {
+	t.Helper()
+
+	var domain phase0.Domain
+	copy(domain[:], domainType[:])
+	domain[31] = 0xff
+	root, err := (&phase0.SigningData{ObjectRoot: objectRoot, Domain: domain}).HashTreeRoot()
+	require.NoError(t, err)
+
+	return root
+}
+
+func uint64Root(val uint64) phase0.Root {
+	var root phase0.Root
+	binary.LittleEndian.PutUint64(root[:], val)
+
+	return root
+}
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := signing.New(ctx, nil)
+	require.EqualError(t, err, "no provider specified")
+
+	_, err = signing.New(ctx, &testProvider{spec: map[string]any{}})
+	require.EqualError(t, err, "SLOTS_PER_EPOCH not found in spec")
+
+	_, err = signing.New(ctx, &testProvider{spec: testSpec()})
+	require.NoError(t, err)
+}
+
+func TestSigningRoots(t *testing.T) {
+	ctx := context.Background()
+	calculator, err := signing.New(ctx, &testProvider{spec: testSpec(), genesisTime: time.Now()})
+	require.NoError(t, err)
+
+	attestationData := &phase0.AttestationData{
+		Slot:            100,
+		BeaconBlockRoot: phase0.Root{0x01},
+		Source:          &phase0.Checkpoint{Epoch: 1},
+		Target:          &phase0.Checkpoint{Epoch: 3},
+	}
+	attestationDataRoot, err := attestationData.HashTreeRoot()
+	require.NoError(t, err)
+	root, err := calculator.AttestationData(ctx, attestationData)
+	require.NoError(t, err)
+	require.Equal(t, expectedRoot(t, attestationDataRoot, 0x01, 3), root)
+
+	block := &spec.VersionedBeaconBlock{
+		Version: spec.DataVersionPhase0,
+		Phase0: &phase0.BeaconBlock{
+			Slot: 65,
+			Body: &phase0.BeaconBlockBody{
+				ETH1Data: &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+			},
+		},
+	}
+	blockRoot, err := block.Root()
+	require.NoError(t, err)
+	root, err = calculator.BeaconBlock(ctx, block)
+	require.NoError(t, err)
+	require.Equal(t, expectedRoot(t, blockRoot, 0x00, 2), root)
+
+	bodyRoot, err := block.Phase0.Body.HashTreeRoot()
+	require.NoError(t, err)
+	root, err = calculator.BeaconBlockHeader(ctx, &phase0.BeaconBlockHeader{Slot: 65, BodyRoot: bodyRoot})
+	require.NoError(t, err)
+	require.Equal(t, expectedRoot(t, blockRoot, 0x00, 2), root)
+
+	root, err = calculator.RANDAOReveal(ctx, 5)
+	require.NoError(t, err)
+	require.Equal(t, expectedRoot(t, uint64Root(5), 0x02, 5), root)
+
+	root, err = calculator.SelectionProof(ctx, 64)
+	require.NoError(t, err)
+	require.Equal(t, expectedRoot(t, uint64Root(64), 0x05, 2), root)
+
+	aggregateAndProof := &phase0.AggregateAndProof{
+		AggregatorIndex: 1,
+		Aggregate: &phase0.Attestation{
+			AggregationBits: []byte{0x01},
+			Data:            attestationData,
+		},
+	}
+	aggregateAndProofRoot, err := aggregateAndProof.HashTreeRoot()
+	require.NoError(t, err)
+	root, err = calculator.AggregateAndProof(ctx, aggregateAndProof)
+	require.NoError(t, err)
+	require.Equal(t, expectedRoot(t, aggregateAndProofRoot, 0x06, 3), root)
+
+	root, err = calculator.SyncCommitteeMessage(ctx, 96, phase0.Root{0x02})
+	require.NoError(t, err)
+	require.Equal(t, expectedRoot(t, phase0.Root{0x02}, 0x07, 3), root)
+
+	selectionDataRoot, err := (&altair.SyncAggregatorSelectionData{Slot: 96, SubcommitteeIndex: 2}).HashTreeRoot()
+	require.NoError(t, err)
+	root, err = calculator.SyncCommitteeSelectionProof(ctx, 96, 2)
+	require.NoError(t, err)
+	require.Equal(t, expectedRoot(t, selectionDataRoot, 0x08, 3), root)
+
+	contributionAndProof := &altair.ContributionAndProof{
+		AggregatorIndex: 1,
+		Contribution: &altair.SyncCommitteeContribution{
+			Slot:            128,
+			AggregationBits: make([]byte, 16),
+		},
+	}
+	contributionAndProofRoot, err := contributionAndProof.HashTreeRoot()
+	require.NoError(t, err)
+	root, err = calculator.ContributionAndProof(ctx, contributionAndProof)
+	require.NoError(t, err)
+	require.Equal(t, expectedRoot(t, contributionAndProofRoot, 0x09, 4), root)
+
+	change := &capella.BLSToExecutionChange{ValidatorIndex: 1}
+	changeRoot, err := change.HashTreeRoot()
+	require.NoError(t, err)
+	root, err = calculator.BLSToExecutionChange(ctx, change)
+	require.NoError(t, err)
+	require.Equal(t, expectedGenesisRoot(t, changeRoot, phase0.DomainType{0x0a, 0x00, 0x00, 0x00}), root)
+
+	registration := &apiv1.ValidatorRegistration{
+		FeeRecipient: bellatrix.ExecutionAddress{0x01},
+		GasLimit:     30000000,
+		Timestamp:    time.Unix(1700000000, 0),
+	}
+	registrationRoot, err := registration.HashTreeRoot()
+	require.NoError(t, err)
+	root, err = calculator.ValidatorRegistration(ctx, registration)
+	require.NoError(t, err)
+	require.Equal(t, expectedGenesisRoot(t, registrationRoot, phase0.DomainType{0x00, 0x00, 0x00, 0x01}), root)
+}
+
+func TestVoluntaryExit(t *testing.T) {
+	ctx := context.Background()
+	exit := &phase0.VoluntaryExit{Epoch: 10, ValidatorIndex: 1}
+	exitRoot, err := exit.HashTreeRoot()
+	require.NoError(t, err)
+
+	calculator, err := signing.New(ctx, &testProvider{spec: testSpec(), genesisTime: time.Now()})
+	require.NoError(t, err)
+
+	// Pre-Deneb, the domain is that of the exit epoch.
+	root, err := calculator.VoluntaryExit(ctx, exit, 99)
+	require.NoError(t, err)
+	require.Equal(t, expectedRoot(t, exitRoot, 0x04, 10), root)
+
+	// From Deneb, the domain is that of the Capella fork.
+	denebRoot, err := calculator.VoluntaryExit(ctx, exit, 100)
+	require.NoError(t, err)
+	require.NotEqual(t, root, denebRoot)
+	root, err = calculator.VoluntaryExit(ctx, exit, 101)
+	require.NoError(t, err)
+	require.Equal(t, denebRoot, root)
+	forkDataRoot, err := (&phase0.ForkData{
+		CurrentVersion:        phase0.Version{0x03, 0x00, 0x00, 0x00},
+		GenesisValidatorsRoot: phase0.Root{0x01, 0x02},
+	}).HashTreeRoot()
+	require.NoError(t, err)
+	var domain phase0.Domain
+	domain[0] = 0x04
+	copy(domain[4:], forkDataRoot[:28])
+	expected, err := (&phase0.SigningData{ObjectRoot: exitRoot, Domain: domain}).HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, phase0.Root(expected), root)
+}
+
+func TestMissingDomain(t *testing.T) {
+	ctx := context.Background()
+	spec := testSpec()
+	delete(spec, "DOMAIN_SYNC_COMMITTEE")
+	calculator, err := signing.New(ctx, &testProvider{spec: spec})
+	require.NoError(t, err)
+
+	_, err = calculator.SyncCommitteeMessage(ctx, 1, phase0.Root{})
+	require.EqualError(t, err, "DOMAIN_SYNC_COMMITTEE not found in spec")
+
+	_, err = calculator.AttestationData(ctx, &phase0.AttestationData{})
+	require.EqualError(t, err, "no attestation data target supplied")
+}